@@ -0,0 +1,78 @@
+// Command replay rebuilds the world of a game from its command journal and
+// checks it against the checksums in the journal.
+//
+//	replay -session <id> [-until <tick>] [-journal_dir <dir>] [-out world.json]
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/gr4vediggr/stellarlight/internal/database"
+	"github.com/gr4vediggr/stellarlight/internal/game/journal"
+	"github.com/gr4vediggr/stellarlight/internal/game/session"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func main() {
+	sessionFlag := flag.String("session", "", "ID of the session to replay")
+	until := flag.Int("until", 0, "Stop after this tick, 0 to replay the whole journal")
+	journalDir := flag.String("journal_dir", os.Getenv("JOURNAL_DIR"), "Directory of the journals, empty to read them from the database")
+	dbURL := flag.String("db_url", os.Getenv("DATABASE_URL"), "Database connection URL")
+	assetFolder := flag.String("assets", "assets", "Folder of the game assets")
+	out := flag.String("out", "", "File to write the replayed world to as JSON")
+	flag.Parse()
+
+	sessionID, err := uuid.Parse(*sessionFlag)
+	if err != nil {
+		log.Fatalf("Invalid session ID %q: %v", *sessionFlag, err)
+	}
+
+	ctx := context.Background()
+	var j journal.Journal
+	if *journalDir != "" {
+		if j, err = journal.NewFileJournal(*journalDir); err != nil {
+			log.Fatalf("Failed to open journal: %v", err)
+		}
+	} else {
+		pool, err := pgxpool.New(ctx, *dbURL)
+		if err != nil {
+			log.Fatalf("Failed to create connection pool: %v", err)
+		}
+		defer pool.Close()
+		j = database.NewPostgresJournalStore(pool)
+	}
+
+	entries, err := j.Load(ctx, sessionID)
+	if err != nil {
+		log.Fatalf("Failed to load journal: %v", err)
+	}
+
+	assets, err := resource.LoadAssetsFromDirs([]string{*assetFolder})
+	if err != nil {
+		log.Fatalf("Failed to load assets: %v", err)
+	}
+
+	result, replayErr := session.Replay(entries, assets, *until)
+	if result != nil {
+		log.Printf("Replayed %d entries up to tick %d, %d checksums matched", len(entries), result.World.Turn, result.Checksums)
+
+		if *out != "" {
+			data, err := json.MarshalIndent(result.World, "", "  ")
+			if err == nil {
+				err = os.WriteFile(*out, data, 0o644)
+			}
+			if err != nil {
+				log.Fatalf("Failed to write world: %v", err)
+			}
+		}
+	}
+	if replayErr != nil {
+		log.Fatalf("Replay failed: %v", replayErr)
+	}
+}
@@ -2,18 +2,28 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"github.com/gr4vediggr/stellarlight/internal/admin"
 	"github.com/gr4vediggr/stellarlight/internal/auth"
+	"github.com/gr4vediggr/stellarlight/internal/chat"
 	"github.com/gr4vediggr/stellarlight/internal/config"
 	"github.com/gr4vediggr/stellarlight/internal/database"
+	"github.com/gr4vediggr/stellarlight/internal/game/journal"
 	"github.com/gr4vediggr/stellarlight/internal/game/session"
+	"github.com/gr4vediggr/stellarlight/internal/notifications"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
 	"github.com/gr4vediggr/stellarlight/internal/users"
 	"github.com/gr4vediggr/stellarlight/internal/websocket"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -21,6 +31,13 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 )
 
+const (
+	// notificationRetention is how long read notifications are kept
+	notificationRetention = 30 * 24 * time.Hour
+	// checkpointInterval is the time between two checkpoints of all sessions
+	checkpointInterval = 5 * time.Minute
+)
+
 func main() {
 	// Load configuration
 	cfg, err := config.Load()
@@ -43,20 +60,62 @@ func main() {
 	// Initialize repositories and services
 	userRepo := database.NewPostgresUserStore(pool)
 	authService := auth.NewService(userRepo, cfg.JWTSecret)
+	notificationRepo := database.NewPostgresNotificationStore(pool)
+	notificationService := notifications.NewService(notificationRepo)
+
+	// Load game assets
+	assets, err := resource.LoadAssetsFromDirs([]string{cfg.AssetFolder})
+	if err != nil {
+		log.Fatalf("Failed to load assets: %v", err)
+	}
 
 	// Initialize game session manager
-	sessionManager := session.NewSessionManager()
-	// Start cleanup routine for expired sessions
+	sessionRepo := database.NewPostgresSessionStore(pool)
+	sessionManager := session.NewSessionManager(assets, notificationService, sessionRepo)
+
+	// Initialize chat, which delivers messages through the session manager
+	chatRepo := database.NewPostgresChatStore(pool)
+	chatService := chat.NewService(chatRepo, userRepo, sessionManager, notificationService)
+	sessionManager.SetChatService(chatService)
+
+	// Journal the commands of every game so that games can be replayed
+	var commandJournal journal.Journal = database.NewPostgresJournalStore(pool)
+	if cfg.JournalDir != "" {
+		commandJournal, err = journal.NewFileJournal(cfg.JournalDir)
+		if err != nil {
+			log.Fatalf("Failed to open journal: %v", err)
+		}
+	}
+	sessionManager.SetJournal(commandJournal)
+
+	// Resume the games of the last checkpoint
+	if err := sessionManager.LoadSessions(context.Background()); err != nil {
+		log.Fatalf("Failed to load sessions: %v", err)
+	}
+	go func() {
+		ticker := time.NewTicker(checkpointInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := sessionManager.Checkpoint(context.Background()); err != nil {
+				log.Printf("Failed to checkpoint sessions: %v", err)
+			}
+		}
+	}()
+
+	// Start cleanup routine for expired sessions and old notifications
 	go func() {
 		ticker := time.NewTicker(time.Hour)
 		defer ticker.Stop()
 		for range ticker.C {
 			sessionManager.CleanupExpiredSessions()
+			if err := notificationService.Cleanup(context.Background(), notificationRetention); err != nil {
+				log.Printf("Failed to clean up notifications: %v", err)
+			}
 		}
 	}()
 
 	// Initialize WebSocket handler
-	wsHandler := websocket.NewSessionHandler(sessionManager, authService)
+	wsHandler := websocket.NewSessionHandler(sessionManager, authService, notificationService, chatService)
 
 	e := setupHttpServer(cfg)
 
@@ -64,7 +123,10 @@ func main() {
 	setupAuthRoutes(e, authService)
 
 	// Game routes
-	registerGameRoutes(e, sessionManager, authService)
+	registerGameRoutes(e, sessionManager, authService, notificationService)
+
+	// Admin routes
+	registerAdminRoutes(e, sessionManager, authService)
 
 	// WebSocket route
 	e.GET("/ws", wsHandler.HandleWebSocket)
@@ -75,11 +137,26 @@ func main() {
 
 	log.Printf("Using TLS with cert: %s, key: %s", cfg.TLS.CertFile, cfg.TLS.KeyFile)
 
-	if err := e.StartTLS(addr, cfg.TLS.CertFile, cfg.TLS.KeyFile); err != nil {
-		log.Fatalf("Server failed to start: %v", err)
+	// Pause and store every session one last time when the server is stopped
+	go func() {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		<-ctx.Done()
+
+		log.Printf("Shutting down, storing sessions")
+		sessionManager.EnterMaintenance()
+		if err := e.Shutdown(context.Background()); err != nil {
+			log.Printf("Failed to shut down server: %v", err)
+		}
+	}()
 
+	if err := e.StartTLS(addr, cfg.TLS.CertFile, cfg.TLS.KeyFile); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalf("Server failed to start: %v", err)
 	}
 
+	if err := sessionManager.Checkpoint(context.Background()); err != nil {
+		log.Printf("Failed to checkpoint sessions: %v", err)
+	}
 }
 
 type CustomValidator struct {
@@ -159,10 +236,15 @@ func getUserFromContext(c echo.Context, authService *auth.AuthService) (*users.U
 }
 
 // registerGameRoutes registers HTTP routes for game management
-func registerGameRoutes(e *echo.Echo, sessionManager *session.SessionManager, authService *auth.AuthService) {
+func registerGameRoutes(e *echo.Echo, sessionManager *session.SessionManager, authService *auth.AuthService, notificationService *notifications.NotificationService) {
 	gameGroup := e.Group("/api/game")
 	gameGroup.Use(auth.RequireAuth(authService))
 
+	notificationHandler := notifications.NewHandler(notificationService)
+	gameGroup.GET("/notifications", notificationHandler.List)
+	gameGroup.POST("/notifications/read-all", notificationHandler.MarkAllRead)
+	gameGroup.POST("/notifications/:id/read", notificationHandler.MarkRead)
+
 	gameGroup.POST("/create", func(c echo.Context) error {
 		user, err := getUserFromContext(c, authService)
 		if err != nil {
@@ -235,3 +317,21 @@ func registerGameRoutes(e *echo.Echo, sessionManager *session.SessionManager, au
 	})
 
 }
+
+// registerAdminRoutes registers HTTP routes for inspecting and moderating
+// sessions, limited to users with the admin role
+func registerAdminRoutes(e *echo.Echo, sessionManager *session.SessionManager, authService *auth.AuthService) {
+	h := admin.NewHandler(sessionManager)
+
+	adminGroup := e.Group("/api/admin", auth.RequireAuth(authService), auth.RequireAdmin(authService))
+	{
+		adminGroup.GET("/sessions", h.ListSessions)
+		adminGroup.GET("/sessions/:id", h.GetSession)
+		adminGroup.POST("/sessions/:id/end", h.EndSession)
+		adminGroup.POST("/sessions/:id/pause", h.PauseSession)
+		adminGroup.POST("/sessions/:id/resume", h.ResumeSession)
+		adminGroup.POST("/sessions/:id/players/:playerId/kick", h.KickPlayer)
+		adminGroup.POST("/sessions/:id/players/:playerId/ban", h.BanPlayer)
+		adminGroup.POST("/sessions/cleanup", h.CleanupSessions)
+	}
+}
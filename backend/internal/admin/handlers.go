@@ -0,0 +1,136 @@
+package admin
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gr4vediggr/stellarlight/internal/game/session"
+	"github.com/gr4vediggr/stellarlight/internal/users"
+	"github.com/labstack/echo/v4"
+)
+
+// Handler serves the routes operators use to inspect and moderate sessions.
+// Routes must be protected with auth.RequireAuth and auth.RequireAdmin.
+type Handler struct {
+	sessions *session.SessionManager
+}
+
+func NewHandler(sessions *session.SessionManager) *Handler {
+	return &Handler{
+		sessions: sessions,
+	}
+}
+
+// ListSessions returns every session with its state, player counts and tick rate
+func (h *Handler) ListSessions(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"sessions": h.sessions.ListSessions(),
+	})
+}
+
+// GetSession returns a session with its players and a summary of its world
+func (h *Handler) GetSession(c echo.Context) error {
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid session ID"})
+	}
+
+	details, err := h.sessions.InspectSession(sessionID)
+	if err != nil {
+		return errorResponse(c, err)
+	}
+	return c.JSON(http.StatusOK, details)
+}
+
+// EndSession stops the game of a session for good
+func (h *Handler) EndSession(c echo.Context) error {
+	return h.sessionAction(c, "ended", h.sessions.EndSession)
+}
+
+// PauseSession pauses the game of a session
+func (h *Handler) PauseSession(c echo.Context) error {
+	return h.sessionAction(c, "paused", h.sessions.PauseSession)
+}
+
+// ResumeSession resumes the game of a session
+func (h *Handler) ResumeSession(c echo.Context) error {
+	return h.sessionAction(c, "resumed", h.sessions.ResumeSession)
+}
+
+// KickPlayer removes a player from a session
+func (h *Handler) KickPlayer(c echo.Context) error {
+	return h.removePlayer(c, false)
+}
+
+// BanPlayer removes a player from a session for good
+func (h *Handler) BanPlayer(c echo.Context) error {
+	return h.removePlayer(c, true)
+}
+
+// CleanupSessions removes expired sessions right away
+func (h *Handler) CleanupSessions(c echo.Context) error {
+	removed := h.sessions.CleanupExpiredSessions()
+	log.Printf("Admin %s cleaned up %d expired sessions", adminName(c), removed)
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"removed": removed,
+	})
+}
+
+func (h *Handler) sessionAction(c echo.Context, done string, action func(uuid.UUID) error) error {
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid session ID"})
+	}
+
+	if err := action(sessionID); err != nil {
+		return errorResponse(c, err)
+	}
+
+	log.Printf("Admin %s %s session %s", adminName(c), done, sessionID)
+	return c.JSON(http.StatusOK, map[string]string{"message": "Session " + done})
+}
+
+func (h *Handler) removePlayer(c echo.Context, ban bool) error {
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid session ID"})
+	}
+	playerID, err := uuid.Parse(c.Param("playerId"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid player ID"})
+	}
+
+	if err := h.sessions.KickPlayer(sessionID, playerID, ban); err != nil {
+		return errorResponse(c, err)
+	}
+
+	done := "kicked"
+	if ban {
+		done = "banned"
+	}
+	log.Printf("Admin %s %s player %s from session %s", adminName(c), done, playerID, sessionID)
+	return c.JSON(http.StatusOK, map[string]string{"message": "Player " + done})
+}
+
+// errorResponse maps session errors to HTTP statuses
+func errorResponse(c echo.Context, err error) error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound), errors.Is(err, session.ErrPlayerNotInSession):
+		status = http.StatusNotFound
+	case errors.Is(err, session.ErrGameNotActive), errors.Is(err, session.ErrInvalidStateTransition), errors.Is(err, session.ErrMaintenance):
+		status = http.StatusConflict
+	}
+	return c.JSON(status, map[string]string{"error": err.Error()})
+}
+
+// adminName names the admin making the request in the logs
+func adminName(c echo.Context) string {
+	if user, ok := c.Get("user").(*users.User); ok {
+		return user.Email
+	}
+	return "unknown"
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
@@ -31,3 +32,28 @@ func RequireAuth(authService *AuthService) echo.MiddlewareFunc {
 		}
 	}
 }
+
+// RequireAdmin only lets users with the admin role through. It must run after
+// RequireAuth. The role is looked up on every request, so revoking it takes
+// effect right away.
+func RequireAdmin(authService *AuthService) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			userID, ok := c.Get("userID").(uuid.UUID)
+			if !ok {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "User not authenticated"})
+			}
+
+			user, err := authService.GetUserByID(c.Request().Context(), userID)
+			if err != nil {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "User account not found"})
+			}
+			if !user.IsAdmin() {
+				return c.JSON(http.StatusForbidden, map[string]string{"error": "Admin role required"})
+			}
+
+			c.Set("user", user)
+			return next(c)
+		}
+	}
+}
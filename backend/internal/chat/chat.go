@@ -0,0 +1,44 @@
+package chat
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Scope decides who receives a chat message
+type Scope string
+
+const (
+	ScopeGlobal  Scope = "global"  // every connected player
+	ScopeLobby   Scope = "lobby"   // the members of the sender's session
+	ScopePrivate Scope = "private" // a single recipient, in any session
+)
+
+const (
+	// MaxMessageLength is the maximum number of characters in a message
+	MaxMessageLength = 500
+	// HistorySize is the number of messages per scope sent to a player on join
+	HistorySize = 50
+)
+
+var (
+	ErrMessageEmpty      = errors.New("chat message is empty")
+	ErrMessageTooLong    = errors.New("chat message is too long")
+	ErrRateLimited       = errors.New("sending chat messages too fast")
+	ErrRecipientNotFound = errors.New("chat recipient not found")
+	ErrInvalidScope      = errors.New("invalid chat scope")
+)
+
+// Message is a chat message as it is stored
+type Message struct {
+	ID          uuid.UUID
+	Scope       Scope
+	SessionID   *uuid.UUID // set for lobby messages
+	SenderID    uuid.UUID
+	SenderName  string
+	RecipientID *uuid.UUID // set for private messages
+	Text        string
+	CreatedAt   time.Time
+}
@@ -0,0 +1,17 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ChatRepository interface {
+	CreateChatMessage(ctx context.Context, m *Message) (*Message, error)
+
+	// History, newest first
+
+	ListLobbyChatMessages(ctx context.Context, sessionID uuid.UUID, limit int) ([]*Message, error)
+	ListPrivateChatMessages(ctx context.Context, userID uuid.UUID, limit int) ([]*Message, error)
+	ListGlobalChatMessages(ctx context.Context, limit int) ([]*Message, error)
+}
@@ -0,0 +1,48 @@
+package chat
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// RateLimitMessages is the number of messages a player may send per RateLimitWindow
+	RateLimitMessages = 5
+	RateLimitWindow   = 10 * time.Second
+)
+
+// rateLimiter allows a number of messages per sender within a sliding window
+type rateLimiter struct {
+	limit  int
+	window time.Duration
+	sent   map[uuid.UUID][]time.Time
+	mu     sync.Mutex
+}
+
+func newRateLimiter(limit int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		limit:  limit,
+		window: window,
+		sent:   make(map[uuid.UUID][]time.Time),
+	}
+}
+
+// allow records a message sent at now, unless the sender is over the limit
+func (l *rateLimiter) allow(senderID uuid.UUID, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	recent := l.sent[senderID]
+	for len(recent) > 0 && now.Sub(recent[0]) >= l.window {
+		recent = recent[1:]
+	}
+
+	if len(recent) >= l.limit {
+		l.sent[senderID] = recent
+		return false
+	}
+	l.sent[senderID] = append(recent, now)
+	return true
+}
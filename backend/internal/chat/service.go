@@ -0,0 +1,213 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/gr4vediggr/stellarlight/internal/interfaces"
+	"github.com/gr4vediggr/stellarlight/internal/notifications"
+	"github.com/gr4vediggr/stellarlight/internal/users"
+	"github.com/gr4vediggr/stellarlight/pkg/messages"
+)
+
+const (
+	// queueSize is the number of messages waiting to be stored before new ones
+	// are no longer kept in the history
+	queueSize = 256
+	// storeTimeout bounds the time spent storing a single message
+	storeTimeout = 5 * time.Second
+)
+
+// Directory finds the connected clients messages are delivered to
+type Directory interface {
+	interfaces.ClientRegistry
+	GetSessionClients(sessionID uuid.UUID) []interfaces.GameClientInterface
+	GetConnectedClients() []interfaces.GameClientInterface
+}
+
+// ChatService routes chat messages between players and keeps their history.
+// Messages are stored in the background, delivery does not wait on the database.
+type ChatService struct {
+	repo      ChatRepository
+	users     users.UserRepository
+	directory Directory
+	notifier  notifications.Notifier // private messages for offline players, may be nil
+	limiter   *rateLimiter
+	queue     chan *Message
+}
+
+func NewService(repo ChatRepository, userRepo users.UserRepository, directory Directory, notifier notifications.Notifier) *ChatService {
+	s := &ChatService{
+		repo:      repo,
+		users:     userRepo,
+		directory: directory,
+		notifier:  notifier,
+		limiter:   newRateLimiter(RateLimitMessages, RateLimitWindow),
+		queue:     make(chan *Message, queueSize),
+	}
+	go s.run()
+	return s
+}
+
+// HandleCommand sends a chat message from a player in the given session
+func (s *ChatService) HandleCommand(ctx context.Context, sender *users.User, sessionID uuid.UUID, cmd *messages.ChatCommand) error {
+	m := &Message{
+		ID:         uuid.New(),
+		SenderID:   sender.ID,
+		SenderName: sender.DisplayName,
+		CreatedAt:  time.Now(),
+	}
+
+	var recipientID string
+	switch scope := cmd.Scope.(type) {
+	case *messages.ChatCommand_Global:
+		m.Scope = ScopeGlobal
+		m.Text = scope.Global.GetMessage()
+	case *messages.ChatCommand_Lobby:
+		m.Scope = ScopeLobby
+		m.SessionID = &sessionID
+		m.Text = scope.Lobby.GetMessage()
+	case *messages.ChatCommand_Private:
+		m.Scope = ScopePrivate
+		m.Text = scope.Private.GetMessage()
+		recipientID = scope.Private.GetRecipientId()
+	default:
+		return ErrInvalidScope
+	}
+
+	m.Text = strings.TrimSpace(m.Text)
+	if m.Text == "" {
+		return ErrMessageEmpty
+	}
+	if utf8.RuneCountInString(m.Text) > MaxMessageLength {
+		return ErrMessageTooLong
+	}
+	if !s.limiter.allow(sender.ID, m.CreatedAt) {
+		return ErrRateLimited
+	}
+
+	if m.Scope == ScopePrivate {
+		id, err := uuid.Parse(recipientID)
+		if err != nil {
+			return ErrRecipientNotFound
+		}
+		if _, err := s.users.GetUserByID(ctx, id); err != nil {
+			return ErrRecipientNotFound
+		}
+		m.RecipientID = &id
+	}
+
+	s.store(m)
+	s.deliver(m)
+	return nil
+}
+
+// SendHistory sends the recent lobby, private and global messages of a player
+// to their client, oldest first
+func (s *ChatService) SendHistory(ctx context.Context, client interfaces.GameClientInterface, sessionID uuid.UUID) error {
+	lobby, err := s.repo.ListLobbyChatMessages(ctx, sessionID, HistorySize)
+	if err != nil {
+		return err
+	}
+	private, err := s.repo.ListPrivateChatMessages(ctx, client.GetUserID(), HistorySize)
+	if err != nil {
+		return err
+	}
+	global, err := s.repo.ListGlobalChatMessages(ctx, HistorySize)
+	if err != nil {
+		return err
+	}
+
+	history := append(append(lobby, private...), global...)
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].CreatedAt.Before(history[j].CreatedAt)
+	})
+
+	for _, m := range history {
+		client.SendMessage(toServerMessage(m))
+	}
+	return nil
+}
+
+func (s *ChatService) store(m *Message) {
+	select {
+	case s.queue <- m:
+	default:
+		log.Printf("Chat queue full, message %s from %s is not kept", m.ID, m.SenderID)
+	}
+}
+
+func (s *ChatService) run() {
+	for m := range s.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+		if _, err := s.repo.CreateChatMessage(ctx, m); err != nil {
+			log.Printf("Failed to store chat message %s: %v", m.ID, err)
+		}
+		cancel()
+	}
+}
+
+// deliver sends a message to its recipients. Private messages are echoed to
+// the sender, and left in the inbox of recipients who are offline.
+func (s *ChatService) deliver(m *Message) {
+	var recipients []interfaces.GameClientInterface
+	switch m.Scope {
+	case ScopeGlobal:
+		recipients = s.directory.GetConnectedClients()
+	case ScopeLobby:
+		recipients = s.directory.GetSessionClients(*m.SessionID)
+	case ScopePrivate:
+		if client, exists := s.directory.GetClient(m.SenderID); exists {
+			recipients = append(recipients, client)
+		}
+		if *m.RecipientID == m.SenderID {
+			break
+		}
+		if client, exists := s.directory.GetClient(*m.RecipientID); exists {
+			recipients = append(recipients, client)
+		} else if s.notifier != nil {
+			s.notifier.Notify(&notifications.Notification{
+				UserID: *m.RecipientID,
+				Kind:   notifications.KindPrivateChat,
+				Title:  fmt.Sprintf("Message from %s", m.SenderName),
+				Body:   m.Text,
+			})
+		}
+	}
+
+	msg := toServerMessage(m)
+	for _, client := range recipients {
+		client.SendMessage(msg)
+	}
+}
+
+func toServerMessage(m *Message) *messages.ServerMessage {
+	chat := &messages.ChatMessage{
+		SenderId:          m.SenderID.String(),
+		SenderDisplayName: m.SenderName,
+		Timestamp:         m.CreatedAt.UnixMilli(),
+	}
+
+	switch m.Scope {
+	case ScopeGlobal:
+		chat.Scope = &messages.ChatMessage_Global{Global: &messages.GlobalChatMessage{Message: m.Text}}
+	case ScopeLobby:
+		chat.Scope = &messages.ChatMessage_Lobby{Lobby: &messages.LobbyChatMessage{Message: m.Text}}
+	case ScopePrivate:
+		chat.Scope = &messages.ChatMessage_Private{Private: &messages.PrivateChatMessage{
+			RecipientId: m.RecipientID.String(),
+			Message:     m.Text,
+		}}
+	}
+
+	return &messages.ServerMessage{
+		Timestamp: m.CreatedAt.UnixMilli(),
+		Message:   &messages.ServerMessage_ChatMessage{ChatMessage: chat},
+	}
+}
@@ -0,0 +1,172 @@
+package chat_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/chat"
+	"github.com/gr4vediggr/stellarlight/internal/interfaces"
+	"github.com/gr4vediggr/stellarlight/internal/notifications"
+	"github.com/gr4vediggr/stellarlight/internal/users"
+	"github.com/gr4vediggr/stellarlight/pkg/messages"
+)
+
+type memoryRepo struct{}
+
+func (memoryRepo) CreateChatMessage(ctx context.Context, m *chat.Message) (*chat.Message, error) {
+	return m, nil
+}
+func (memoryRepo) ListLobbyChatMessages(ctx context.Context, sessionID uuid.UUID, limit int) ([]*chat.Message, error) {
+	return nil, nil
+}
+func (memoryRepo) ListPrivateChatMessages(ctx context.Context, userID uuid.UUID, limit int) ([]*chat.Message, error) {
+	return nil, nil
+}
+func (memoryRepo) ListGlobalChatMessages(ctx context.Context, limit int) ([]*chat.Message, error) {
+	return nil, nil
+}
+
+// userDirectory knows every user, only GetUserByID is used by the chat service
+type userDirectory struct {
+	users.UserRepository
+	known map[uuid.UUID]*users.User
+}
+
+func (d userDirectory) GetUserByID(ctx context.Context, id uuid.UUID) (*users.User, error) {
+	if user, exists := d.known[id]; exists {
+		return user, nil
+	}
+	return nil, errors.New("no rows")
+}
+
+// sessions maps session IDs to their connected clients
+type sessions map[uuid.UUID][]interfaces.GameClientInterface
+
+func (s sessions) GetClient(playerID uuid.UUID) (interfaces.GameClientInterface, bool) {
+	for _, clients := range s {
+		for _, client := range clients {
+			if client.GetUserID() == playerID {
+				return client, true
+			}
+		}
+	}
+	return nil, false
+}
+func (s sessions) GetSessionClients(sessionID uuid.UUID) []interfaces.GameClientInterface {
+	return s[sessionID]
+}
+func (s sessions) GetConnectedClients() []interfaces.GameClientInterface {
+	var all []interfaces.GameClientInterface
+	for _, clients := range s {
+		all = append(all, clients...)
+	}
+	return all
+}
+
+type recordingClient struct {
+	userID   uuid.UUID
+	received []*messages.ChatMessage
+}
+
+func (c *recordingClient) GetUserID() uuid.UUID { return c.userID }
+func (c *recordingClient) Disconnect()          {}
+func (c *recordingClient) SendMessage(msg *messages.ServerMessage) error {
+	c.received = append(c.received, msg.GetChatMessage())
+	return nil
+}
+
+type inbox struct {
+	mu   sync.Mutex
+	left []*notifications.Notification
+}
+
+func (i *inbox) Notify(n *notifications.Notification) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.left = append(i.left, n)
+}
+
+func TestChatRouting(t *testing.T) {
+	sender := &users.User{ID: uuid.New(), DisplayName: "Sender"}
+	member := &users.User{ID: uuid.New(), DisplayName: "Member"}
+	elsewhere := &users.User{ID: uuid.New(), DisplayName: "Elsewhere"}
+	offline := &users.User{ID: uuid.New(), DisplayName: "Offline"}
+
+	sessionID, otherSessionID := uuid.New(), uuid.New()
+	senderClient := &recordingClient{userID: sender.ID}
+	memberClient := &recordingClient{userID: member.ID}
+	elsewhereClient := &recordingClient{userID: elsewhere.ID}
+	directory := sessions{
+		sessionID:      {senderClient, memberClient},
+		otherSessionID: {elsewhereClient},
+	}
+
+	known := map[uuid.UUID]*users.User{}
+	for _, user := range []*users.User{sender, member, elsewhere, offline} {
+		known[user.ID] = user
+	}
+	notified := &inbox{}
+	service := chat.NewService(memoryRepo{}, userDirectory{known: known}, directory, notified)
+	ctx := context.Background()
+
+	lobby := func(text string) *messages.ChatCommand {
+		return &messages.ChatCommand{Scope: &messages.ChatCommand_Lobby{Lobby: &messages.LobbyChatCommand{Message: text}}}
+	}
+	private := func(recipient, text string) *messages.ChatCommand {
+		return &messages.ChatCommand{Scope: &messages.ChatCommand_Private{Private: &messages.PrivateChatCommand{RecipientId: recipient, Message: text}}}
+	}
+
+	if err := service.HandleCommand(ctx, sender, sessionID, lobby("  hello lobby  ")); err != nil {
+		t.Fatalf("Failed to send lobby message: %v", err)
+	}
+	if len(memberClient.received) != 1 || memberClient.received[0].GetLobby().GetMessage() != "hello lobby" {
+		t.Errorf("Expected the session member to receive the trimmed message, got %v", memberClient.received)
+	}
+	if len(elsewhereClient.received) != 0 {
+		t.Error("Expected lobby chat to stay within the session")
+	}
+
+	if err := service.HandleCommand(ctx, sender, sessionID, private(elsewhere.ID.String(), "psst")); err != nil {
+		t.Fatalf("Failed to send private message: %v", err)
+	}
+	if len(elsewhereClient.received) != 1 || elsewhereClient.received[0].GetPrivate().GetMessage() != "psst" {
+		t.Errorf("Expected the recipient in another session to receive the message, got %v", elsewhereClient.received)
+	}
+	if len(memberClient.received) != 1 || len(senderClient.received) != 2 {
+		t.Error("Expected the private message to reach only the sender and recipient")
+	}
+
+	if err := service.HandleCommand(ctx, sender, sessionID, private(offline.ID.String(), "call me")); err != nil {
+		t.Fatalf("Failed to send private message: %v", err)
+	}
+	if len(notified.left) != 1 || notified.left[0].UserID != offline.ID || notified.left[0].Kind != notifications.KindPrivateChat {
+		t.Errorf("Expected a notification for the offline recipient, got %v", notified.left)
+	}
+
+	if err := service.HandleCommand(ctx, sender, sessionID, private(uuid.NewString(), "hi")); !errors.Is(err, chat.ErrRecipientNotFound) {
+		t.Errorf("Expected unknown recipient to be rejected, got %v", err)
+	}
+	if err := service.HandleCommand(ctx, member, sessionID, lobby("   ")); !errors.Is(err, chat.ErrMessageEmpty) {
+		t.Errorf("Expected empty message to be rejected, got %v", err)
+	}
+	if err := service.HandleCommand(ctx, member, sessionID, lobby(strings.Repeat("a", chat.MaxMessageLength+1))); !errors.Is(err, chat.ErrMessageTooLong) {
+		t.Errorf("Expected long message to be rejected, got %v", err)
+	}
+
+	// The sender has used up their messages for the window
+	var err error
+	for i := 0; i < chat.RateLimitMessages && err == nil; i++ {
+		err = service.HandleCommand(ctx, sender, sessionID, lobby("spam"))
+	}
+	if !errors.Is(err, chat.ErrRateLimited) {
+		t.Errorf("Expected sender to be rate limited, got %v", err)
+	}
+	if err := service.HandleCommand(ctx, member, sessionID, lobby("still here")); err != nil {
+		t.Errorf("Expected other players not to be limited, got %v", err)
+	}
+}
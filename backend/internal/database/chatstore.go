@@ -0,0 +1,105 @@
+package database
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/gr4vediggr/stellarlight/internal/chat"
+	"github.com/gr4vediggr/stellarlight/internal/database/queries"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresChatStore struct {
+	queries *queries.Queries
+}
+
+func NewPostgresChatStore(db *pgxpool.Pool) *PostgresChatStore {
+	return &PostgresChatStore{
+		queries: queries.New(db),
+	}
+}
+
+func (store *PostgresChatStore) CreateChatMessage(ctx context.Context, m *chat.Message) (*chat.Message, error) {
+	result, err := store.queries.CreateChatMessage(ctx, queries.CreateChatMessageParams{
+		ID:          m.ID,
+		Scope:       string(m.Scope),
+		SessionID:   nullableUUID(m.SessionID),
+		SenderID:    m.SenderID,
+		SenderName:  m.SenderName,
+		RecipientID: nullableUUID(m.RecipientID),
+		Message:     m.Text,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toChatMessage(result), nil
+}
+
+func (store *PostgresChatStore) ListLobbyChatMessages(ctx context.Context, sessionID uuid.UUID, limit int) ([]*chat.Message, error) {
+	results, err := store.queries.ListLobbyChatMessages(ctx, queries.ListLobbyChatMessagesParams{
+		SessionID: nullableUUID(&sessionID),
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toChatMessages(results), nil
+}
+
+func (store *PostgresChatStore) ListPrivateChatMessages(ctx context.Context, userID uuid.UUID, limit int) ([]*chat.Message, error) {
+	results, err := store.queries.ListPrivateChatMessages(ctx, queries.ListPrivateChatMessagesParams{
+		UserID: userID,
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toChatMessages(results), nil
+}
+
+func (store *PostgresChatStore) ListGlobalChatMessages(ctx context.Context, limit int) ([]*chat.Message, error) {
+	results, err := store.queries.ListGlobalChatMessages(ctx, int32(limit))
+	if err != nil {
+		return nil, err
+	}
+	return toChatMessages(results), nil
+}
+
+func toChatMessage(result queries.ChatMessage) *chat.Message {
+	return &chat.Message{
+		ID:          result.ID,
+		Scope:       chat.Scope(result.Scope),
+		SessionID:   optionalUUID(result.SessionID),
+		SenderID:    result.SenderID,
+		SenderName:  result.SenderName,
+		RecipientID: optionalUUID(result.RecipientID),
+		Text:        result.Message,
+		CreatedAt:   result.CreatedAt,
+	}
+}
+
+func toChatMessages(results []queries.ChatMessage) []*chat.Message {
+	list := make([]*chat.Message, 0, len(results))
+	for _, result := range results {
+		list = append(list, toChatMessage(result))
+	}
+	return list
+}
+
+// nullableUUID converts an optional ID to a nullable column value
+func nullableUUID(id *uuid.UUID) pgtype.UUID {
+	if id == nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: *id, Valid: true}
+}
+
+// optionalUUID converts a nullable column value to an optional ID
+func optionalUUID(id pgtype.UUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	value := uuid.UUID(id.Bytes)
+	return &value
+}
@@ -0,0 +1,67 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/gr4vediggr/stellarlight/internal/database/queries"
+	"github.com/gr4vediggr/stellarlight/internal/game/journal"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresJournalStore struct {
+	db      *pgxpool.Pool
+	queries *queries.Queries
+}
+
+func NewPostgresJournalStore(db *pgxpool.Pool) *PostgresJournalStore {
+	return &PostgresJournalStore{
+		db:      db,
+		queries: queries.New(db),
+	}
+}
+
+// Append writes a batch of entries in a single transaction
+func (store *PostgresJournalStore) Append(ctx context.Context, sessionID uuid.UUID, entries []*journal.Entry) error {
+	tx, err := store.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	q := store.queries.WithTx(tx)
+
+	for _, entry := range entries {
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		if err := q.CreateJournalEntry(ctx, queries.CreateJournalEntryParams{
+			SessionID: sessionID,
+			Tick:      int32(entry.Tick),
+			Kind:      entry.Kind,
+			Entry:     data,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (store *PostgresJournalStore) Load(ctx context.Context, sessionID uuid.UUID) ([]*journal.Entry, error) {
+	results, err := store.queries.ListJournalEntries(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*journal.Entry, 0, len(results))
+	for _, data := range results {
+		entry := &journal.Entry{}
+		if err := json.Unmarshal(data, entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
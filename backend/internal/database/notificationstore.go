@@ -0,0 +1,101 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gr4vediggr/stellarlight/internal/database/queries"
+	"github.com/gr4vediggr/stellarlight/internal/notifications"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PostgresNotificationStore struct {
+	queries *queries.Queries
+}
+
+func NewPostgresNotificationStore(db *pgxpool.Pool) *PostgresNotificationStore {
+	return &PostgresNotificationStore{
+		queries: queries.New(db),
+	}
+}
+
+func (store *PostgresNotificationStore) CreateNotification(ctx context.Context, n *notifications.Notification) (*notifications.Notification, error) {
+	result, err := store.queries.CreateNotification(ctx, queries.CreateNotificationParams{
+		ID:        n.ID,
+		UserID:    n.UserID,
+		SessionID: nullableUUID(n.SessionID),
+		Kind:      string(n.Kind),
+		Title:     n.Title,
+		Body:      n.Body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toNotification(result), nil
+}
+
+func (store *PostgresNotificationStore) ListNotifications(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*notifications.Notification, error) {
+	results, err := store.queries.ListNotifications(ctx, queries.ListNotificationsParams{
+		UserID: userID,
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toNotifications(results), nil
+}
+
+func (store *PostgresNotificationStore) ListUnreadNotifications(ctx context.Context, userID uuid.UUID, limit int) ([]*notifications.Notification, error) {
+	results, err := store.queries.ListUnreadNotifications(ctx, queries.ListUnreadNotificationsParams{
+		UserID: userID,
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toNotifications(results), nil
+}
+
+func (store *PostgresNotificationStore) CountUnreadNotifications(ctx context.Context, userID uuid.UUID) (int, error) {
+	count, err := store.queries.CountUnreadNotifications(ctx, userID)
+	return int(count), err
+}
+
+func (store *PostgresNotificationStore) MarkNotificationRead(ctx context.Context, id, userID uuid.UUID) (bool, error) {
+	rows, err := store.queries.MarkNotificationRead(ctx, queries.MarkNotificationReadParams{
+		ID:     id,
+		UserID: userID,
+	})
+	return rows > 0, err
+}
+
+func (store *PostgresNotificationStore) MarkAllNotificationsRead(ctx context.Context, userID uuid.UUID) error {
+	return store.queries.MarkAllNotificationsRead(ctx, userID)
+}
+
+func (store *PostgresNotificationStore) DeleteReadNotificationsBefore(ctx context.Context, before time.Time) error {
+	return store.queries.DeleteReadNotificationsBefore(ctx, before)
+}
+
+func toNotification(result queries.Notification) *notifications.Notification {
+	return &notifications.Notification{
+		ID:        result.ID,
+		UserID:    result.UserID,
+		SessionID: optionalUUID(result.SessionID),
+		Kind:      notifications.Kind(result.Kind),
+		Title:     result.Title,
+		Body:      result.Body,
+		Read:      result.Read,
+		CreatedAt: result.CreatedAt,
+	}
+}
+
+func toNotifications(results []queries.Notification) []*notifications.Notification {
+	list := make([]*notifications.Notification, 0, len(results))
+	for _, result := range results {
+		list = append(list, toNotification(result))
+	}
+	return list
+}
@@ -0,0 +1,171 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: chat_messages.sql
+
+package queries
+
+import (
+	"context"
+
+	uuid "github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createChatMessage = `-- name: CreateChatMessage :one
+INSERT INTO chat_messages (id, scope, session_id, sender_id, sender_name, recipient_id, message)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, scope, session_id, sender_id, sender_name, recipient_id, message, created_at
+`
+
+type CreateChatMessageParams struct {
+	ID          uuid.UUID
+	Scope       string
+	SessionID   pgtype.UUID
+	SenderID    uuid.UUID
+	SenderName  string
+	RecipientID pgtype.UUID
+	Message     string
+}
+
+func (q *Queries) CreateChatMessage(ctx context.Context, arg CreateChatMessageParams) (ChatMessage, error) {
+	row := q.db.QueryRow(ctx, createChatMessage,
+		arg.ID,
+		arg.Scope,
+		arg.SessionID,
+		arg.SenderID,
+		arg.SenderName,
+		arg.RecipientID,
+		arg.Message,
+	)
+	var i ChatMessage
+	err := row.Scan(
+		&i.ID,
+		&i.Scope,
+		&i.SessionID,
+		&i.SenderID,
+		&i.SenderName,
+		&i.RecipientID,
+		&i.Message,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listGlobalChatMessages = `-- name: ListGlobalChatMessages :many
+SELECT id, scope, session_id, sender_id, sender_name, recipient_id, message, created_at FROM chat_messages
+WHERE scope = 'global'
+ORDER BY created_at DESC
+LIMIT $1
+`
+
+func (q *Queries) ListGlobalChatMessages(ctx context.Context, limit int32) ([]ChatMessage, error) {
+	rows, err := q.db.Query(ctx, listGlobalChatMessages, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatMessage
+	for rows.Next() {
+		var i ChatMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.Scope,
+			&i.SessionID,
+			&i.SenderID,
+			&i.SenderName,
+			&i.RecipientID,
+			&i.Message,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLobbyChatMessages = `-- name: ListLobbyChatMessages :many
+SELECT id, scope, session_id, sender_id, sender_name, recipient_id, message, created_at FROM chat_messages
+WHERE scope = 'lobby' AND session_id = $1
+ORDER BY created_at DESC
+LIMIT $2
+`
+
+type ListLobbyChatMessagesParams struct {
+	SessionID pgtype.UUID
+	Limit     int32
+}
+
+func (q *Queries) ListLobbyChatMessages(ctx context.Context, arg ListLobbyChatMessagesParams) ([]ChatMessage, error) {
+	rows, err := q.db.Query(ctx, listLobbyChatMessages, arg.SessionID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatMessage
+	for rows.Next() {
+		var i ChatMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.Scope,
+			&i.SessionID,
+			&i.SenderID,
+			&i.SenderName,
+			&i.RecipientID,
+			&i.Message,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPrivateChatMessages = `-- name: ListPrivateChatMessages :many
+SELECT id, scope, session_id, sender_id, sender_name, recipient_id, message, created_at FROM chat_messages
+WHERE scope = 'private' AND (sender_id = $1 OR recipient_id = $1)
+ORDER BY created_at DESC
+LIMIT $2
+`
+
+type ListPrivateChatMessagesParams struct {
+	UserID uuid.UUID
+	Limit  int32
+}
+
+func (q *Queries) ListPrivateChatMessages(ctx context.Context, arg ListPrivateChatMessagesParams) ([]ChatMessage, error) {
+	rows, err := q.db.Query(ctx, listPrivateChatMessages, arg.UserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatMessage
+	for rows.Next() {
+		var i ChatMessage
+		if err := rows.Scan(
+			&i.ID,
+			&i.Scope,
+			&i.SessionID,
+			&i.SenderID,
+			&i.SenderName,
+			&i.RecipientID,
+			&i.Message,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,60 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: command_journal.sql
+
+package queries
+
+import (
+	"context"
+
+	uuid "github.com/google/uuid"
+)
+
+const createJournalEntry = `-- name: CreateJournalEntry :exec
+INSERT INTO command_journal (session_id, tick, kind, entry)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateJournalEntryParams struct {
+	SessionID uuid.UUID
+	Tick      int32
+	Kind      string
+	Entry     []byte
+}
+
+func (q *Queries) CreateJournalEntry(ctx context.Context, arg CreateJournalEntryParams) error {
+	_, err := q.db.Exec(ctx, createJournalEntry,
+		arg.SessionID,
+		arg.Tick,
+		arg.Kind,
+		arg.Entry,
+	)
+	return err
+}
+
+const listJournalEntries = `-- name: ListJournalEntries :many
+SELECT entry FROM command_journal
+WHERE session_id = $1
+ORDER BY seq
+`
+
+func (q *Queries) ListJournalEntries(ctx context.Context, sessionID uuid.UUID) ([][]byte, error) {
+	rows, err := q.db.Query(ctx, listJournalEntries, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items [][]byte
+	for rows.Next() {
+		var entry []byte
+		if err := rows.Scan(&entry); err != nil {
+			return nil, err
+		}
+		items = append(items, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
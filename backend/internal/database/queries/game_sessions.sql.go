@@ -0,0 +1,588 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: game_sessions.sql
+
+package queries
+
+import (
+	"context"
+	"time"
+
+	uuid "github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createBuildItem = `-- name: CreateBuildItem :exec
+INSERT INTO build_items (id, ref, session_id, empire_id, colony_id, empire_position, colony_position, kind, type, quantity, cost, build_time, progress, funded)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+`
+
+type CreateBuildItemParams struct {
+	ID             uuid.UUID
+	Ref            int64
+	SessionID      uuid.UUID
+	EmpireID       uuid.UUID
+	ColonyID       uuid.UUID
+	EmpirePosition int32
+	ColonyPosition int32
+	Kind           string
+	Type           string
+	Quantity       int32
+	Cost           []byte
+	BuildTime      float64
+	Progress       float64
+	Funded         bool
+}
+
+func (q *Queries) CreateBuildItem(ctx context.Context, arg CreateBuildItemParams) error {
+	_, err := q.db.Exec(ctx, createBuildItem,
+		arg.ID,
+		arg.Ref,
+		arg.SessionID,
+		arg.EmpireID,
+		arg.ColonyID,
+		arg.EmpirePosition,
+		arg.ColonyPosition,
+		arg.Kind,
+		arg.Type,
+		arg.Quantity,
+		arg.Cost,
+		arg.BuildTime,
+		arg.Progress,
+		arg.Funded,
+	)
+	return err
+}
+
+const createColony = `-- name: CreateColony :exec
+INSERT INTO colonies (id, ref, session_id, owner, name, system_id, planet_id, population, max_population, industry_slots, growth)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+`
+
+type CreateColonyParams struct {
+	ID            uuid.UUID
+	Ref           int64
+	SessionID     uuid.UUID
+	Owner         uuid.UUID
+	Name          string
+	SystemID      uuid.UUID
+	PlanetID      uuid.UUID
+	Population    int64
+	MaxPopulation int64
+	IndustrySlots int32
+	Growth        float64
+}
+
+func (q *Queries) CreateColony(ctx context.Context, arg CreateColonyParams) error {
+	_, err := q.db.Exec(ctx, createColony,
+		arg.ID,
+		arg.Ref,
+		arg.SessionID,
+		arg.Owner,
+		arg.Name,
+		arg.SystemID,
+		arg.PlanetID,
+		arg.Population,
+		arg.MaxPopulation,
+		arg.IndustrySlots,
+		arg.Growth,
+	)
+	return err
+}
+
+const createEmpire = `-- name: CreateEmpire :exec
+INSERT INTO empires (id, session_id, player_id, name, color, home_system, systems, resources, income, technologies, research, intel)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+`
+
+type CreateEmpireParams struct {
+	ID           uuid.UUID
+	SessionID    uuid.UUID
+	PlayerID     uuid.UUID
+	Name         string
+	Color        string
+	HomeSystem   uuid.UUID
+	Systems      []byte
+	Resources    []byte
+	Income       []byte
+	Technologies []byte
+	Research     []byte
+	Intel        []byte
+}
+
+func (q *Queries) CreateEmpire(ctx context.Context, arg CreateEmpireParams) error {
+	_, err := q.db.Exec(ctx, createEmpire,
+		arg.ID,
+		arg.SessionID,
+		arg.PlayerID,
+		arg.Name,
+		arg.Color,
+		arg.HomeSystem,
+		arg.Systems,
+		arg.Resources,
+		arg.Income,
+		arg.Technologies,
+		arg.Research,
+		arg.Intel,
+	)
+	return err
+}
+
+const createFleet = `-- name: CreateFleet :exec
+INSERT INTO fleets (id, ref, session_id, owner, name, ships, location, destination, arrival_time, path)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+`
+
+type CreateFleetParams struct {
+	ID          uuid.UUID
+	Ref         int64
+	SessionID   uuid.UUID
+	Owner       uuid.UUID
+	Name        string
+	Ships       []byte
+	Location    uuid.UUID
+	Destination pgtype.UUID
+	ArrivalTime pgtype.Float8
+	Path        []byte
+}
+
+func (q *Queries) CreateFleet(ctx context.Context, arg CreateFleetParams) error {
+	_, err := q.db.Exec(ctx, createFleet,
+		arg.ID,
+		arg.Ref,
+		arg.SessionID,
+		arg.Owner,
+		arg.Name,
+		arg.Ships,
+		arg.Location,
+		arg.Destination,
+		arg.ArrivalTime,
+		arg.Path,
+	)
+	return err
+}
+
+const createSessionPlayer = `-- name: CreateSessionPlayer :exec
+INSERT INTO session_players (session_id, user_id, empire_id, color, ready, joined_at, last_seen)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type CreateSessionPlayerParams struct {
+	SessionID uuid.UUID
+	UserID    uuid.UUID
+	EmpireID  uuid.UUID
+	Color     string
+	Ready     bool
+	JoinedAt  time.Time
+	LastSeen  time.Time
+}
+
+func (q *Queries) CreateSessionPlayer(ctx context.Context, arg CreateSessionPlayerParams) error {
+	_, err := q.db.Exec(ctx, createSessionPlayer,
+		arg.SessionID,
+		arg.UserID,
+		arg.EmpireID,
+		arg.Color,
+		arg.Ready,
+		arg.JoinedAt,
+		arg.LastSeen,
+	)
+	return err
+}
+
+const deleteBuildItems = `-- name: DeleteBuildItems :exec
+DELETE FROM build_items WHERE session_id = $1
+`
+
+func (q *Queries) DeleteBuildItems(ctx context.Context, sessionID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteBuildItems, sessionID)
+	return err
+}
+
+const deleteColonies = `-- name: DeleteColonies :exec
+DELETE FROM colonies WHERE session_id = $1
+`
+
+func (q *Queries) DeleteColonies(ctx context.Context, sessionID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteColonies, sessionID)
+	return err
+}
+
+const deleteEmpires = `-- name: DeleteEmpires :exec
+DELETE FROM empires WHERE session_id = $1
+`
+
+func (q *Queries) DeleteEmpires(ctx context.Context, sessionID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteEmpires, sessionID)
+	return err
+}
+
+const deleteFleets = `-- name: DeleteFleets :exec
+DELETE FROM fleets WHERE session_id = $1
+`
+
+func (q *Queries) DeleteFleets(ctx context.Context, sessionID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteFleets, sessionID)
+	return err
+}
+
+const deleteGalaxy = `-- name: DeleteGalaxy :exec
+DELETE FROM galaxies WHERE session_id = $1
+`
+
+func (q *Queries) DeleteGalaxy(ctx context.Context, sessionID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteGalaxy, sessionID)
+	return err
+}
+
+const deleteGameSession = `-- name: DeleteGameSession :exec
+DELETE FROM game_sessions WHERE id = $1
+`
+
+func (q *Queries) DeleteGameSession(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteGameSession, id)
+	return err
+}
+
+const deleteSessionPlayers = `-- name: DeleteSessionPlayers :exec
+DELETE FROM session_players WHERE session_id = $1
+`
+
+func (q *Queries) DeleteSessionPlayers(ctx context.Context, sessionID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteSessionPlayers, sessionID)
+	return err
+}
+
+const getGalaxy = `-- name: GetGalaxy :one
+SELECT session_id, map, systems, battles, refs, turn, game_days, years_per_hour FROM galaxies WHERE session_id = $1
+`
+
+func (q *Queries) GetGalaxy(ctx context.Context, sessionID uuid.UUID) (Galaxy, error) {
+	row := q.db.QueryRow(ctx, getGalaxy, sessionID)
+	var i Galaxy
+	err := row.Scan(
+		&i.SessionID,
+		&i.Map,
+		&i.Systems,
+		&i.Battles,
+		&i.Refs,
+		&i.Turn,
+		&i.GameDays,
+		&i.YearsPerHour,
+	)
+	return i, err
+}
+
+const listBuildItems = `-- name: ListBuildItems :many
+SELECT id, ref, session_id, empire_id, colony_id, empire_position, colony_position, kind, type, quantity, cost, build_time, progress, funded FROM build_items
+WHERE session_id = $1
+ORDER BY empire_position
+`
+
+func (q *Queries) ListBuildItems(ctx context.Context, sessionID uuid.UUID) ([]BuildItem, error) {
+	rows, err := q.db.Query(ctx, listBuildItems, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BuildItem
+	for rows.Next() {
+		var i BuildItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ref,
+			&i.SessionID,
+			&i.EmpireID,
+			&i.ColonyID,
+			&i.EmpirePosition,
+			&i.ColonyPosition,
+			&i.Kind,
+			&i.Type,
+			&i.Quantity,
+			&i.Cost,
+			&i.BuildTime,
+			&i.Progress,
+			&i.Funded,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listColonies = `-- name: ListColonies :many
+SELECT id, ref, session_id, owner, name, system_id, planet_id, population, max_population, industry_slots, growth FROM colonies
+WHERE session_id = $1
+ORDER BY ref
+`
+
+func (q *Queries) ListColonies(ctx context.Context, sessionID uuid.UUID) ([]Colony, error) {
+	rows, err := q.db.Query(ctx, listColonies, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Colony
+	for rows.Next() {
+		var i Colony
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ref,
+			&i.SessionID,
+			&i.Owner,
+			&i.Name,
+			&i.SystemID,
+			&i.PlanetID,
+			&i.Population,
+			&i.MaxPopulation,
+			&i.IndustrySlots,
+			&i.Growth,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEmpires = `-- name: ListEmpires :many
+SELECT id, session_id, player_id, name, color, home_system, systems, resources, income, technologies, research, intel FROM empires WHERE session_id = $1
+`
+
+func (q *Queries) ListEmpires(ctx context.Context, sessionID uuid.UUID) ([]Empire, error) {
+	rows, err := q.db.Query(ctx, listEmpires, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Empire
+	for rows.Next() {
+		var i Empire
+		if err := rows.Scan(
+			&i.ID,
+			&i.SessionID,
+			&i.PlayerID,
+			&i.Name,
+			&i.Color,
+			&i.HomeSystem,
+			&i.Systems,
+			&i.Resources,
+			&i.Income,
+			&i.Technologies,
+			&i.Research,
+			&i.Intel,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFleets = `-- name: ListFleets :many
+SELECT id, ref, session_id, owner, name, ships, location, destination, arrival_time, path FROM fleets
+WHERE session_id = $1
+ORDER BY ref
+`
+
+func (q *Queries) ListFleets(ctx context.Context, sessionID uuid.UUID) ([]Fleet, error) {
+	rows, err := q.db.Query(ctx, listFleets, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Fleet
+	for rows.Next() {
+		var i Fleet
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ref,
+			&i.SessionID,
+			&i.Owner,
+			&i.Name,
+			&i.Ships,
+			&i.Location,
+			&i.Destination,
+			&i.ArrivalTime,
+			&i.Path,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGameSessions = `-- name: ListGameSessions :many
+SELECT id, invite_code, state, host_id, settings, created_at, checkpointed_at, banned, ended_at FROM game_sessions
+ORDER BY created_at
+`
+
+func (q *Queries) ListGameSessions(ctx context.Context) ([]GameSession, error) {
+	rows, err := q.db.Query(ctx, listGameSessions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GameSession
+	for rows.Next() {
+		var i GameSession
+		if err := rows.Scan(
+			&i.ID,
+			&i.InviteCode,
+			&i.State,
+			&i.HostID,
+			&i.Settings,
+			&i.CreatedAt,
+			&i.CheckpointedAt,
+			&i.Banned,
+			&i.EndedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSessionPlayers = `-- name: ListSessionPlayers :many
+SELECT sp.session_id, sp.user_id, sp.empire_id, sp.color, sp.ready, sp.joined_at, sp.last_seen, u.email, u.display_name
+FROM session_players sp
+JOIN users u ON u.id = sp.user_id
+WHERE sp.session_id = $1
+ORDER BY sp.joined_at
+`
+
+type ListSessionPlayersRow struct {
+	SessionID   uuid.UUID
+	UserID      uuid.UUID
+	EmpireID    uuid.UUID
+	Color       string
+	Ready       bool
+	JoinedAt    time.Time
+	LastSeen    time.Time
+	Email       string
+	DisplayName string
+}
+
+func (q *Queries) ListSessionPlayers(ctx context.Context, sessionID uuid.UUID) ([]ListSessionPlayersRow, error) {
+	rows, err := q.db.Query(ctx, listSessionPlayers, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSessionPlayersRow
+	for rows.Next() {
+		var i ListSessionPlayersRow
+		if err := rows.Scan(
+			&i.SessionID,
+			&i.UserID,
+			&i.EmpireID,
+			&i.Color,
+			&i.Ready,
+			&i.JoinedAt,
+			&i.LastSeen,
+			&i.Email,
+			&i.DisplayName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertGalaxy = `-- name: UpsertGalaxy :exec
+INSERT INTO galaxies (session_id, map, systems, battles, refs, turn, game_days, years_per_hour)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (session_id) DO UPDATE
+SET map = EXCLUDED.map,
+    systems = EXCLUDED.systems,
+    battles = EXCLUDED.battles,
+    refs = EXCLUDED.refs,
+    turn = EXCLUDED.turn,
+    game_days = EXCLUDED.game_days,
+    years_per_hour = EXCLUDED.years_per_hour
+`
+
+type UpsertGalaxyParams struct {
+	SessionID    uuid.UUID
+	Map          []byte
+	Systems      []byte
+	Battles      []byte
+	Refs         []byte
+	Turn         int32
+	GameDays     float64
+	YearsPerHour float64
+}
+
+func (q *Queries) UpsertGalaxy(ctx context.Context, arg UpsertGalaxyParams) error {
+	_, err := q.db.Exec(ctx, upsertGalaxy,
+		arg.SessionID,
+		arg.Map,
+		arg.Systems,
+		arg.Battles,
+		arg.Refs,
+		arg.Turn,
+		arg.GameDays,
+		arg.YearsPerHour,
+	)
+	return err
+}
+
+const upsertGameSession = `-- name: UpsertGameSession :exec
+INSERT INTO game_sessions (id, invite_code, state, host_id, settings, created_at, banned, ended_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (id) DO UPDATE
+SET state = EXCLUDED.state,
+    host_id = EXCLUDED.host_id,
+    settings = EXCLUDED.settings,
+    banned = EXCLUDED.banned,
+    ended_at = EXCLUDED.ended_at,
+    checkpointed_at = now()
+`
+
+type UpsertGameSessionParams struct {
+	ID         uuid.UUID
+	InviteCode string
+	State      string
+	HostID     uuid.UUID
+	Settings   []byte
+	CreatedAt  time.Time
+	Banned     []byte
+	EndedAt    pgtype.Timestamptz
+}
+
+func (q *Queries) UpsertGameSession(ctx context.Context, arg UpsertGameSessionParams) error {
+	_, err := q.db.Exec(ctx, upsertGameSession,
+		arg.ID,
+		arg.InviteCode,
+		arg.State,
+		arg.HostID,
+		arg.Settings,
+		arg.CreatedAt,
+		arg.Banned,
+		arg.EndedAt,
+	)
+	return err
+}
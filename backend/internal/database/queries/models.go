@@ -11,6 +11,119 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type BuildItem struct {
+	ID             uuid.UUID
+	Ref            int64
+	SessionID      uuid.UUID
+	EmpireID       uuid.UUID
+	ColonyID       uuid.UUID
+	EmpirePosition int32
+	ColonyPosition int32
+	Kind           string
+	Type           string
+	Quantity       int32
+	Cost           []byte
+	BuildTime      float64
+	Progress       float64
+	Funded         bool
+}
+
+type ChatMessage struct {
+	ID          uuid.UUID
+	Scope       string
+	SessionID   pgtype.UUID
+	SenderID    uuid.UUID
+	SenderName  string
+	RecipientID pgtype.UUID
+	Message     string
+	CreatedAt   time.Time
+}
+
+type Colony struct {
+	ID            uuid.UUID
+	Ref           int64
+	SessionID     uuid.UUID
+	Owner         uuid.UUID
+	Name          string
+	SystemID      uuid.UUID
+	PlanetID      uuid.UUID
+	Population    int64
+	MaxPopulation int64
+	IndustrySlots int32
+	Growth        float64
+}
+
+type CommandJournal struct {
+	Seq       int64
+	SessionID uuid.UUID
+	Tick      int32
+	Kind      string
+	Entry     []byte
+	CreatedAt time.Time
+}
+
+type Empire struct {
+	ID           uuid.UUID
+	SessionID    uuid.UUID
+	PlayerID     uuid.UUID
+	Name         string
+	Color        string
+	HomeSystem   uuid.UUID
+	Systems      []byte
+	Resources    []byte
+	Income       []byte
+	Technologies []byte
+	Research     []byte
+	Intel        []byte
+}
+
+type Fleet struct {
+	ID          uuid.UUID
+	Ref         int64
+	SessionID   uuid.UUID
+	Owner       uuid.UUID
+	Name        string
+	Ships       []byte
+	Location    uuid.UUID
+	Destination pgtype.UUID
+	ArrivalTime pgtype.Float8
+	Path        []byte
+}
+
+type Galaxy struct {
+	SessionID    uuid.UUID
+	Map          []byte
+	Systems      []byte
+	Battles      []byte
+	Refs         []byte
+	Turn         int32
+	GameDays     float64
+	YearsPerHour float64
+}
+
+type GameSession struct {
+	ID             uuid.UUID
+	InviteCode     string
+	State          string
+	HostID         uuid.UUID
+	Settings       []byte
+	CreatedAt      time.Time
+	CheckpointedAt time.Time
+	Banned         []byte
+	EndedAt        pgtype.Timestamptz
+}
+
+type Notification struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	SessionID pgtype.UUID
+	Kind      string
+	Title     string
+	Body      string
+	Read      bool
+	CreatedAt time.Time
+}
+
 type RefreshToken struct {
 	ID        uuid.UUID
 	UserID    uuid.UUID
@@ -20,6 +133,16 @@ type RefreshToken struct {
 	CreatedAt time.Time
 }
 
+type SessionPlayer struct {
+	SessionID uuid.UUID
+	UserID    uuid.UUID
+	EmpireID  uuid.UUID
+	Color     string
+	Ready     bool
+	JoinedAt  time.Time
+	LastSeen  time.Time
+}
+
 type User struct {
 	ID          uuid.UUID
 	Email       string
@@ -28,4 +151,5 @@ type User struct {
 	CreatedAt   pgtype.Timestamptz
 	UpdatedAt   pgtype.Timestamptz
 	UserStatus  pgtype.Text
+	Role        string
 }
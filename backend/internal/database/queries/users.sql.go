@@ -14,7 +14,7 @@ import (
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (id, email, display_name, password)
 VALUES ($1, $2, $3, $4)
-RETURNING id, email, display_name, password, created_at, updated_at, user_status
+RETURNING id, email, display_name, password, created_at, updated_at, user_status, role
 `
 
 type CreateUserParams struct {
@@ -40,12 +40,13 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.UserStatus,
+		&i.Role,
 	)
 	return i, err
 }
 
 const deleteUser = `-- name: DeleteUser :one
-UPDATE users SET user_status = 'inactive', updated_at = now() WHERE id = $1 RETURNING id, email, display_name, password, created_at, updated_at, user_status
+UPDATE users SET user_status = 'inactive', updated_at = now() WHERE id = $1 RETURNING id, email, display_name, password, created_at, updated_at, user_status, role
 `
 
 func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) (User, error) {
@@ -59,12 +60,13 @@ func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) (User, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.UserStatus,
+		&i.Role,
 	)
 	return i, err
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, display_name, password, created_at, updated_at, user_status FROM users WHERE email = $1 LIMIT 1
+SELECT id, email, display_name, password, created_at, updated_at, user_status, role FROM users WHERE email = $1 LIMIT 1
 `
 
 func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
@@ -78,12 +80,13 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.UserStatus,
+		&i.Role,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, email, display_name, password, created_at, updated_at, user_status FROM users WHERE id = $1 LIMIT 1
+SELECT id, email, display_name, password, created_at, updated_at, user_status, role FROM users WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
@@ -97,6 +100,7 @@ func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.UserStatus,
+		&i.Role,
 	)
 	return i, err
 }
@@ -113,7 +117,7 @@ func (q *Queries) PurgeInactiveUsers(ctx context.Context) error {
 const updateUser = `-- name: UpdateUser :one
 UPDATE users
 SET display_name = $2, password = $3, updated_at = now()
-WHERE id = $1 RETURNING id, email, display_name, password, created_at, updated_at, user_status
+WHERE id = $1 RETURNING id, email, display_name, password, created_at, updated_at, user_status, role
 `
 
 type UpdateUserParams struct {
@@ -133,6 +137,7 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.UserStatus,
+		&i.Role,
 	)
 	return i, err
 }
@@ -0,0 +1,467 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/gr4vediggr/stellarlight/internal/database/queries"
+	"github.com/gr4vediggr/stellarlight/internal/domain/galaxy"
+	"github.com/gr4vediggr/stellarlight/internal/game/session"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/internal/users"
+	"github.com/gr4vediggr/stellarlight/pkg/messages"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type PostgresSessionStore struct {
+	db      *pgxpool.Pool
+	queries *queries.Queries
+}
+
+func NewPostgresSessionStore(db *pgxpool.Pool) *PostgresSessionStore {
+	return &PostgresSessionStore{
+		db:      db,
+		queries: queries.New(db),
+	}
+}
+
+// SaveSession replaces the stored state of a session in a single transaction
+func (store *PostgresSessionStore) SaveSession(ctx context.Context, record *session.SessionRecord) error {
+	settings, err := protojson.Marshal(record.Settings)
+	if err != nil {
+		return err
+	}
+	banned, err := json.Marshal(record.Banned)
+	if err != nil {
+		return err
+	}
+
+	tx, err := store.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	q := store.queries.WithTx(tx)
+
+	if err := q.UpsertGameSession(ctx, queries.UpsertGameSessionParams{
+		ID:         record.ID,
+		InviteCode: record.InviteCode,
+		State:      string(record.State),
+		HostID:     record.HostID,
+		Settings:   settings,
+		CreatedAt:  record.CreatedAt,
+		Banned:     banned,
+		EndedAt:    pgtype.Timestamptz{Time: record.EndedAt, Valid: !record.EndedAt.IsZero()},
+	}); err != nil {
+		return err
+	}
+
+	if err := q.DeleteSessionPlayers(ctx, record.ID); err != nil {
+		return err
+	}
+	for _, player := range record.Players {
+		if err := q.CreateSessionPlayer(ctx, queries.CreateSessionPlayerParams{
+			SessionID: record.ID,
+			UserID:    player.User.ID,
+			EmpireID:  player.EmpireID,
+			Color:     player.Color,
+			Ready:     player.Ready,
+			JoinedAt:  player.JoinedAt,
+			LastSeen:  player.LastSeen,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := deleteWorld(ctx, q, record.ID); err != nil {
+		return err
+	}
+	if record.World != nil {
+		if err := saveWorld(ctx, q, record.ID, record.World); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// LoadSessions returns every stored session with its players and world
+func (store *PostgresSessionStore) LoadSessions(ctx context.Context) ([]*session.SessionRecord, error) {
+	results, err := store.queries.ListGameSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*session.SessionRecord, 0, len(results))
+	for _, result := range results {
+		record := &session.SessionRecord{
+			ID:         result.ID,
+			InviteCode: result.InviteCode,
+			State:      session.GameSessionState(result.State),
+			HostID:     result.HostID,
+			CreatedAt:  result.CreatedAt,
+			EndedAt:    result.EndedAt.Time,
+			Settings:   &messages.GalaxyGenerateSettings{},
+		}
+		if err := protojson.Unmarshal(result.Settings, record.Settings); err != nil {
+			return nil, fmt.Errorf("session %s: %w", result.ID, err)
+		}
+		if err := json.Unmarshal(result.Banned, &record.Banned); err != nil {
+			return nil, fmt.Errorf("session %s: %w", result.ID, err)
+		}
+
+		players, err := store.queries.ListSessionPlayers(ctx, result.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, player := range players {
+			record.Players = append(record.Players, &types.Player{
+				User: &users.User{
+					ID:          player.UserID,
+					Email:       player.Email,
+					DisplayName: player.DisplayName,
+				},
+				EmpireID: player.EmpireID,
+				Color:    player.Color,
+				Ready:    player.Ready,
+				JoinedAt: player.JoinedAt,
+				LastSeen: player.LastSeen,
+			})
+		}
+
+		record.World, err = loadWorld(ctx, store.queries, result.ID)
+		if err != nil {
+			return nil, fmt.Errorf("session %s: %w", result.ID, err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func (store *PostgresSessionStore) DeleteSession(ctx context.Context, id uuid.UUID) error {
+	return store.queries.DeleteGameSession(ctx, id)
+}
+
+func deleteWorld(ctx context.Context, q *queries.Queries, sessionID uuid.UUID) error {
+	for _, del := range []func(context.Context, uuid.UUID) error{
+		q.DeleteBuildItems,
+		q.DeleteFleets,
+		q.DeleteColonies,
+		q.DeleteEmpires,
+		q.DeleteGalaxy,
+	} {
+		if err := del(ctx, sessionID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func saveWorld(ctx context.Context, q *queries.Queries, sessionID uuid.UUID, world *types.WorldState) error {
+	enc := &jsonEncoder{}
+	galaxyParams := queries.UpsertGalaxyParams{
+		SessionID:    sessionID,
+		Map:          enc.encode(world.Galaxy.Map),
+		Systems:      enc.encode(world.Galaxy.Systems),
+		Battles:      enc.encode(world.Battles),
+		Refs:         enc.encode(world.IDs),
+		Turn:         int32(world.Turn),
+		GameDays:     world.Clock.Days,
+		YearsPerHour: world.Clock.YearsPerHour,
+	}
+	if enc.err != nil {
+		return enc.err
+	}
+	if err := q.UpsertGalaxy(ctx, galaxyParams); err != nil {
+		return err
+	}
+
+	for _, colony := range world.Colonies {
+		if err := q.CreateColony(ctx, queries.CreateColonyParams{
+			ID:            colony.ID,
+			Ref:           int64(colony.Ref),
+			SessionID:     sessionID,
+			Owner:         colony.Owner,
+			Name:          colony.Name,
+			SystemID:      colony.SystemID,
+			PlanetID:      colony.PlanetID,
+			Population:    colony.Population,
+			MaxPopulation: colony.MaxPopulation,
+			IndustrySlots: int32(colony.IndustrySlots),
+			Growth:        colony.Growth,
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, empire := range world.Empires {
+		params := queries.CreateEmpireParams{
+			ID:           empire.ID,
+			SessionID:    sessionID,
+			PlayerID:     empire.PlayerID,
+			Name:         empire.Name,
+			Color:        empire.Color,
+			HomeSystem:   empire.HomeSystem,
+			Systems:      enc.encode(empire.Systems),
+			Resources:    enc.encode(empire.Resources),
+			Income:       enc.encode(empire.Income),
+			Technologies: enc.encode(empire.Technologies),
+			Research:     enc.encode(empire.Research),
+			Intel:        enc.encode(empire.Intel),
+		}
+		if enc.err != nil {
+			return enc.err
+		}
+		if err := q.CreateEmpire(ctx, params); err != nil {
+			return err
+		}
+
+		for _, fleet := range empire.TotalFleets {
+			params := queries.CreateFleetParams{
+				ID:          fleet.ID,
+				Ref:         int64(fleet.Ref),
+				SessionID:   sessionID,
+				Owner:       fleet.Owner,
+				Name:        fleet.Name,
+				Ships:       enc.encode(fleet.Ships),
+				Location:    fleet.Location,
+				Destination: nullableUUID(fleet.Destination),
+				Path:        enc.encode(fleet.Path),
+			}
+			if fleet.ArrivalTime != nil {
+				params.ArrivalTime = pgtype.Float8{Float64: *fleet.ArrivalTime, Valid: true}
+			}
+			if enc.err != nil {
+				return enc.err
+			}
+			if err := q.CreateFleet(ctx, params); err != nil {
+				return err
+			}
+		}
+
+		for position, item := range empire.BuildQueue {
+			colonyPosition := 0
+			if colony, exists := world.Colonies[item.ColonyID]; exists {
+				colonyPosition = types.IndexOfBuildItem(colony.BuildQueue, item.ID)
+			}
+			params := queries.CreateBuildItemParams{
+				ID:             item.ID,
+				Ref:            int64(item.Ref),
+				SessionID:      sessionID,
+				EmpireID:       empire.ID,
+				ColonyID:       item.ColonyID,
+				EmpirePosition: int32(position),
+				ColonyPosition: int32(colonyPosition),
+				Kind:           string(item.Kind),
+				Type:           item.Type,
+				Quantity:       int32(item.Quantity),
+				Cost:           enc.encode(item.Cost),
+				BuildTime:      item.BuildTime,
+				Progress:       item.Progress,
+				Funded:         item.Funded,
+			}
+			if enc.err != nil {
+				return enc.err
+			}
+			if err := q.CreateBuildItem(ctx, params); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// loadWorld rebuilds the world of a session, nil if the game has not started
+func loadWorld(ctx context.Context, q *queries.Queries, sessionID uuid.UUID) (*types.WorldState, error) {
+	stored, err := q.GetGalaxy(ctx, sessionID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	world := types.NewWorldState()
+	world.Turn = int(stored.Turn)
+	world.Clock = types.GameClock{Days: stored.GameDays, YearsPerHour: stored.YearsPerHour}
+
+	dec := &jsonDecoder{}
+	galaxyMap := &galaxy.Galaxy{}
+	dec.decode(stored.Map, galaxyMap)
+	dec.decode(stored.Systems, &world.Galaxy.Systems)
+	dec.decode(stored.Battles, &world.Battles)
+	dec.decode(stored.Refs, world.IDs)
+	if dec.err != nil {
+		return nil, dec.err
+	}
+	galaxyMap.BuildAdjacencyList()
+	world.Galaxy.Map = galaxyMap
+
+	colonies, err := q.ListColonies(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range colonies {
+		world.Colonies[c.ID] = &types.Colony{
+			ID:            c.ID,
+			Ref:           uint64(c.Ref),
+			Name:          c.Name,
+			Owner:         c.Owner,
+			SystemID:      c.SystemID,
+			PlanetID:      c.PlanetID,
+			Population:    c.Population,
+			MaxPopulation: c.MaxPopulation,
+			IndustrySlots: int(c.IndustrySlots),
+			Growth:        c.Growth,
+			BuildQueue:    make([]*types.BuildItem, 0),
+		}
+	}
+
+	empires, err := q.ListEmpires(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*types.EmpireState, len(empires))
+	for _, e := range empires {
+		empire := types.NewEmpireState(e.PlayerID, e.Name)
+		empire.ID = e.ID
+		empire.Color = e.Color
+		empire.HomeSystem = e.HomeSystem
+		dec.decode(e.Systems, &empire.Systems)
+		dec.decode(e.Resources, &empire.Resources)
+		dec.decode(e.Income, &empire.Income)
+		dec.decode(e.Technologies, &empire.Technologies)
+		dec.decode(e.Research, &empire.Research)
+		dec.decode(e.Intel, &empire.Intel)
+		if dec.err != nil {
+			return nil, dec.err
+		}
+
+		world.Empires[empire.PlayerID] = empire
+		byID[empire.ID] = empire
+	}
+
+	for _, colony := range sortedColonies(world) {
+		if empire, exists := byID[colony.Owner]; exists {
+			empire.Colonies = append(empire.Colonies, colony.ID)
+		}
+	}
+
+	fleets, err := q.ListFleets(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fleets {
+		empire, exists := byID[f.Owner]
+		if !exists {
+			continue
+		}
+		fleet := &types.Fleet{
+			ID:          f.ID,
+			Ref:         uint64(f.Ref),
+			Name:        f.Name,
+			Owner:       f.Owner,
+			Location:    f.Location,
+			Destination: optionalUUID(f.Destination),
+		}
+		if f.ArrivalTime.Valid {
+			arrival := f.ArrivalTime.Float64
+			fleet.ArrivalTime = &arrival
+		}
+		dec.decode(f.Ships, &fleet.Ships)
+		dec.decode(f.Path, &fleet.Path)
+		if dec.err != nil {
+			return nil, dec.err
+		}
+		empire.TotalFleets[fleet.ID] = fleet
+	}
+
+	items, err := q.ListBuildItems(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	colonyPositions := make(map[uuid.UUID]int, len(items))
+	for _, i := range items {
+		empire, exists := byID[i.EmpireID]
+		if !exists {
+			continue
+		}
+		item := &types.BuildItem{
+			ID:        i.ID,
+			Ref:       uint64(i.Ref),
+			Kind:      types.BuildKind(i.Kind),
+			Type:      i.Type,
+			ColonyID:  i.ColonyID,
+			Quantity:  int(i.Quantity),
+			BuildTime: i.BuildTime,
+			Progress:  i.Progress,
+			Funded:    i.Funded,
+		}
+		dec.decode(i.Cost, &item.Cost)
+		if dec.err != nil {
+			return nil, dec.err
+		}
+		empire.BuildQueue = append(empire.BuildQueue, item)
+
+		if colony, exists := world.Colonies[item.ColonyID]; exists {
+			colony.BuildQueue = append(colony.BuildQueue, item)
+			colonyPositions[item.ID] = int(i.ColonyPosition)
+		}
+	}
+	for _, colony := range world.Colonies {
+		sort.SliceStable(colony.BuildQueue, func(a, b int) bool {
+			return colonyPositions[colony.BuildQueue[a].ID] < colonyPositions[colony.BuildQueue[b].ID]
+		})
+	}
+
+	world.Relink()
+	return world, nil
+}
+
+// sortedColonies returns the colonies of a world in reference order
+func sortedColonies(world *types.WorldState) []*types.Colony {
+	colonies := make([]*types.Colony, 0, len(world.Colonies))
+	for _, colony := range world.Colonies {
+		colonies = append(colonies, colony)
+	}
+	sort.Slice(colonies, func(i, j int) bool {
+		return colonies[i].Ref < colonies[j].Ref
+	})
+	return colonies
+}
+
+// jsonEncoder encodes several values, keeping the first error
+type jsonEncoder struct {
+	err error
+}
+
+func (e *jsonEncoder) encode(v any) []byte {
+	if e.err != nil {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	e.err = err
+	return data
+}
+
+// jsonDecoder decodes several values, keeping the first error
+type jsonDecoder struct {
+	err error
+}
+
+func (d *jsonDecoder) decode(data []byte, v any) {
+	if d.err != nil {
+		return
+	}
+	d.err = json.Unmarshal(data, v)
+}
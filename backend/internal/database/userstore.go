@@ -29,6 +29,7 @@ func (store *PostgresUserStore) GetUserByEmail(ctx context.Context, email string
 		ID:          result.ID,
 		Email:       result.Email,
 		DisplayName: result.DisplayName,
+		Role:        result.Role,
 		Password:    result.Password,
 		CreatedAt:   result.CreatedAt.Time,
 		UpdatedAt:   result.UpdatedAt.Time,
@@ -45,6 +46,7 @@ func (store *PostgresUserStore) GetUserByID(ctx context.Context, id uuid.UUID) (
 		ID:          result.ID,
 		Email:       result.Email,
 		DisplayName: result.DisplayName,
+		Role:        result.Role,
 		Password:    result.Password,
 		CreatedAt:   result.CreatedAt.Time,
 		UpdatedAt:   result.UpdatedAt.Time,
@@ -65,6 +67,7 @@ func (store *PostgresUserStore) CreateUser(ctx context.Context, user *users.User
 		ID:          result.ID,
 		Email:       result.Email,
 		DisplayName: result.DisplayName,
+		Role:        result.Role,
 		CreatedAt:   result.CreatedAt.Time,
 		UpdatedAt:   result.UpdatedAt.Time,
 	}, nil
@@ -83,6 +86,7 @@ func (store *PostgresUserStore) UpdateUser(ctx context.Context, user *users.User
 		ID:          result.ID,
 		Email:       result.Email,
 		DisplayName: result.DisplayName,
+		Role:        result.Role,
 		CreatedAt:   result.CreatedAt.Time,
 		UpdatedAt:   result.UpdatedAt.Time,
 	}, nil
@@ -97,6 +101,7 @@ func (store *PostgresUserStore) DeleteUser(ctx context.Context, id uuid.UUID) (*
 		ID:          result.ID,
 		Email:       result.Email,
 		DisplayName: result.DisplayName,
+		Role:        result.Role,
 		CreatedAt:   result.CreatedAt.Time,
 		UpdatedAt:   result.UpdatedAt.Time,
 	}, nil
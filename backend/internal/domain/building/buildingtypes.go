@@ -0,0 +1,14 @@
+package building
+
+import "github.com/gr4vediggr/stellarlight/internal/domain/empire"
+
+type BuildingType struct {
+	ID          uint32           `json:"id"`          // Unique identifier for the building type
+	Key         string           `json:"key"`         // Key used in commands and colonies (e.g., shipyard)
+	Name        string           `json:"name"`        // Name of the building type
+	Description string           `json:"description"` // Description of the building type
+	Cost        empire.Resources `json:"cost"`        // Resources needed to build or upgrade one level
+	BuildTime   float64          `json:"buildTime"`   // Time to build one level in in-game days
+	MaxLevel    int              `json:"maxLevel"`    // Highest level the building can be upgraded to
+	Production  empire.Resources `json:"production"`  // Resources produced per level and economy update
+}
@@ -1 +1,9 @@
-package empire
\ No newline at end of file
+package empire
+
+// Resources is an amount of empire resources, as used for costs and upkeep in assets
+type Resources struct {
+	Credits  int64 `json:"credits"`
+	Minerals int64 `json:"minerals"`
+	Energy   int64 `json:"energy"`
+	Research int64 `json:"research"`
+}
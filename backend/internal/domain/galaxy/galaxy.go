@@ -2,6 +2,7 @@ package galaxy
 
 import (
 	"log"
+	"math"
 
 	"github.com/google/uuid"
 	"github.com/gr4vediggr/stellarlight/internal/utils"
@@ -17,7 +18,14 @@ type Galaxy struct {
 
 func (g *Galaxy) heuristicFunc(from, to uuid.UUID) float64 {
 	if g.HeuristicMap == nil {
-		g.BuildHeuristicMap()
+		// Hyperlane lengths are straight-line distances, so the straight-line
+		// distance never overestimates and avoids building the full map.
+		a, okA := g.StarSystems[from]
+		b, okB := g.StarSystems[to]
+		if okA && okB {
+			return a.Distance(b)
+		}
+		return 999999
 	}
 	if distances, ok := g.HeuristicMap[from]; ok {
 		if distance, ok := distances[to]; ok {
@@ -45,6 +53,10 @@ func (s *StarSystem) DistanceSquared(other *StarSystem) float64 {
 		((s.LocationY - other.LocationY) * (s.LocationY - other.LocationY))
 }
 
+func (s *StarSystem) Distance(other *StarSystem) float64 {
+	return math.Sqrt(s.DistanceSquared(other))
+}
+
 type Star struct {
 	ID        uuid.UUID `json:"id"`        // Unique identifier for the star
 	Name      string    `json:"name"`      // Name of the star
@@ -73,11 +85,15 @@ func NewGalaxy(name string) *Galaxy {
 	}
 }
 
+// AddStarSystem adds a system to the galaxy, assigning an ID if it does not have one yet
 func (g *Galaxy) AddStarSystem(system *StarSystem) {
-	system.ID = uuid.New()
+	if system.ID == uuid.Nil {
+		system.ID = uuid.New()
+	}
 	g.StarSystems[system.ID] = system
 }
 
+// BuildAdjacencyList builds the hyperlane graph, weighted by hyperlane length
 func (g *Galaxy) BuildAdjacencyList() {
 
 	g.Adjacency = make(map[uuid.UUID]map[uuid.UUID]float64)
@@ -86,7 +102,7 @@ func (g *Galaxy) BuildAdjacencyList() {
 	}
 	for _, system := range g.StarSystems {
 		for _, connectedID := range system.ConnectedSystems {
-			distance := system.DistanceSquared(g.StarSystems[connectedID])
+			distance := system.Distance(g.StarSystems[connectedID])
 			g.Adjacency[system.ID][connectedID] = distance
 			g.Adjacency[connectedID][system.ID] = distance
 		}
@@ -109,6 +125,12 @@ func (g *Galaxy) BuildHeuristicMap() {
 	log.Println("Heuristic map built with", len(g.HeuristicMap), "entries")
 }
 
+// HyperlaneLength returns the length of the hyperlane between two connected systems
+func (g *Galaxy) HyperlaneLength(from, to uuid.UUID) (float64, bool) {
+	length, ok := g.Adjacency[from][to]
+	return length, ok
+}
+
 func (g *Galaxy) FindPath(startID, endID uuid.UUID) []uuid.UUID {
 
 	return utils.FindPath(g.Adjacency, startID, endID, g.heuristicFunc)
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"image/color"
 	"strings"
+
+	"github.com/gr4vediggr/stellarlight/internal/domain/empire"
 )
 
 type StarType struct {
@@ -24,15 +26,17 @@ func (st *StarType) GetChoiceWeight() float64 {
 }
 
 type PlanetType struct {
-	ID          uint32  `json:"id"`          // Unique identifier for the planet type
-	Name        string  `json:"name"`        // Name of the planet type
-	Color       Color   `json:"color"`       // Color of the planet type
-	Description string  `json:"description"` // Description of the planet type
-	MinSize     float64 `json:"minSize"`     // Minimum size of the planet
-	MaxSize     float64 `json:"maxSize"`     // Maximum size of the planet
-	Chance      float64 `json:"chance"`      // Chance of this planet type appearing
-	MoonChance  float64 `json:"moonChance"`  // Chance of moons orbiting this planet type
-	MaxMoons    int     `json:"maxMoons"`    // Maximum number of moons that can orbit this planet type
+	ID           uint32           `json:"id"`           // Unique identifier for the planet type
+	Name         string           `json:"name"`         // Name of the planet type
+	Color        Color            `json:"color"`        // Color of the planet type
+	Description  string           `json:"description"`  // Description of the planet type
+	MinSize      float64          `json:"minSize"`      // Minimum size of the planet
+	MaxSize      float64          `json:"maxSize"`      // Maximum size of the planet
+	Chance       float64          `json:"chance"`       // Chance of this planet type appearing
+	Yield        empire.Resources `json:"yield"`        // Resources produced per economy update in an owned system
+	Habitability float64          `json:"habitability"` // How well the planet supports a population, 0 means it cannot be colonised
+	MoonChance   float64          `json:"moonChance"`   // Chance of moons orbiting this planet type
+	MaxMoons     int              `json:"maxMoons"`     // Maximum number of moons that can orbit this planet type
 }
 
 func (pt *PlanetType) GetChoiceWeight() float64 {
@@ -0,0 +1,18 @@
+package ship
+
+import "github.com/gr4vediggr/stellarlight/internal/domain/empire"
+
+type ShipType struct {
+	ID          uint32           `json:"id"`          // Unique identifier for the ship type
+	Key         string           `json:"key"`         // Key used in commands and fleets (e.g., fighter)
+	Name        string           `json:"name"`        // Name of the ship type
+	Description string           `json:"description"` // Description of the ship type
+	Cost        empire.Resources `json:"cost"`        // Resources needed to build one ship
+	BuildTime   float64          `json:"buildTime"`   // Time to build one ship in in-game days
+	Hull        float64          `json:"hull"`        // Damage a ship can take before it is destroyed
+	Attack      float64          `json:"attack"`      // Damage a ship deals per battle round
+	Speed       float64          `json:"speed"`       // Travel speed in distance units per in-game day
+	Upkeep      empire.Resources `json:"upkeep"`      // Resources consumed per economy update
+	Colonizer   bool             `json:"colonizer"`   // Whether the ship can found a colony
+	SensorRange int              `json:"sensorRange"` // Hyperlane hops the ship sees beyond its own system
+}
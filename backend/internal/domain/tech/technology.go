@@ -0,0 +1,34 @@
+package tech
+
+// Effect types of a technology
+const (
+	EffectShipStat       = "ship_stat"       // Modifies a stat of all ships or of one ship type
+	EffectUnlockBuilding = "unlock_building" // Allows a building type to be constructed
+	EffectTravelSpeed    = "travel_speed"    // Modifies how fast fleets cross hyperlanes
+)
+
+// Ship stats that can be modified by a ship_stat effect
+const (
+	StatHull   = "hull"
+	StatAttack = "attack"
+	StatSpeed  = "speed"
+)
+
+type Technology struct {
+	ID            uint32   `json:"id"`            // Unique identifier for the technology
+	Key           string   `json:"key"`           // Key used in commands and empires (e.g., improved_hulls)
+	Name          string   `json:"name"`          // Name of the technology
+	Description   string   `json:"description"`   // Description of the technology
+	Category      string   `json:"category"`      // Category shown in the tech tree (e.g., engineering)
+	Cost          int64    `json:"cost"`          // Research points needed to complete the technology
+	Prerequisites []string `json:"prerequisites"` // Keys of technologies that must be researched first
+	Effects       []Effect `json:"effects"`       // Effects applied once the technology is researched
+}
+
+type Effect struct {
+	Type     string  `json:"type"`               // One of the Effect constants
+	Stat     string  `json:"stat,omitempty"`     // Ship stat modified by a ship_stat effect
+	ShipType string  `json:"shipType,omitempty"` // Ship type modified by a ship_stat effect, all ships if empty
+	Building string  `json:"building,omitempty"` // Building type unlocked by an unlock_building effect
+	Modifier float64 `json:"modifier,omitempty"` // Relative bonus, 0.1 is +10%
+}
@@ -0,0 +1,281 @@
+package engine
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/systems"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/pkg/messages"
+)
+
+// CommandError is returned when a game command cannot be translated into an
+// engine event. The code is sent to the player as the errorCode of an ErrorMessage.
+type CommandError struct {
+	Code    string
+	Message string
+	Context string // name of the rejected command
+}
+
+func (e *CommandError) Error() string {
+	if e.Context == "" {
+		return e.Message
+	}
+	return e.Context + ": " + e.Message
+}
+
+func newCommandError(code, format string, args ...interface{}) *CommandError {
+	return &CommandError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// translateCommand turns a protobuf game command into a typed engine event.
+// Numeric IDs are resolved through the world's ID registry and the player must
+// own every fleet, colony and build item the command refers to.
+func (e *GameEngine) translateCommand(playerID uuid.UUID, gc *messages.GameCommand) (events.GameEvent, error) {
+	if gc == nil || gc.Action == nil {
+		return nil, newCommandError(systems.ErrCodeInvalidCommand, "%v", ErrUnknownGameCommand)
+	}
+
+	e.worldState.AcquireLock()
+	defer e.worldState.ReleaseLock()
+
+	empire, exists := e.worldState.Empires[playerID]
+	if !exists {
+		return nil, newCommandError(systems.ErrCodeNoEmpire, "player has no empire in this game")
+	}
+
+	base := types.BaseEvent{
+		SessionID: e.sessionID,
+		Timestamp: time.Now().UnixNano(),
+	}
+
+	var event events.GameEvent
+	var err *CommandError
+	var context string
+
+	switch action := gc.Action.(type) {
+	case *messages.GameCommand_MoveFleet:
+		context = "move_fleet"
+		event, err = e.translateMoveFleet(base, empire, action.MoveFleet)
+	case *messages.GameCommand_QueueFleetConstruction:
+		context = "queue_fleet_construction"
+		event, err = e.translateQueueFleetConstruction(base, empire, action.QueueFleetConstruction)
+	case *messages.GameCommand_QueueConstruction:
+		context = "queue_construction"
+		event, err = e.translateQueueConstruction(base, empire, action.QueueConstruction)
+	case *messages.GameCommand_ReorderBuildQueue:
+		context = "reorder_build_queue"
+		event, err = e.translateReorderBuildQueue(base, empire, action.ReorderBuildQueue)
+	case *messages.GameCommand_CancelBuildItem:
+		context = "cancel_build_item"
+		event, err = e.translateCancelBuildItem(base, empire, action.CancelBuildItem)
+	case *messages.GameCommand_ColonizePlanet:
+		context = "colonize_planet"
+		event, err = e.translateColonizePlanet(base, empire, action.ColonizePlanet)
+	case *messages.GameCommand_QueueResearch:
+		context = "queue_research"
+		event, err = e.translateQueueResearch(base, empire, action.QueueResearch)
+	default:
+		err = newCommandError(systems.ErrCodeInvalidCommand, "%v", ErrUnknownGameCommand)
+	}
+
+	if err != nil {
+		err.Context = context
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *GameEngine) translateMoveFleet(base types.BaseEvent, empire *types.EmpireState, cmd *messages.MoveFleetCommand) (events.GameEvent, *CommandError) {
+	fleet, err := e.resolveFleet(empire, cmd.GetFleetId())
+	if err != nil {
+		return nil, err
+	}
+
+	systemID, exists := e.worldState.IDs.Resolve(cmd.GetDestinationStarId())
+	if _, known := e.worldState.Galaxy.GetSystem(systemID); !exists || !known {
+		return nil, newCommandError(systems.ErrCodeUnknownSystem, "unknown star system %d", cmd.GetDestinationStarId())
+	}
+
+	base.Type = "fleet_move_command"
+	return &types.FleetMoveCommandEvent{
+		BaseEvent:      base,
+		PlayerID:       empire.PlayerID,
+		FleetID:        fleet.ID,
+		TargetSystemID: systemID,
+	}, nil
+}
+
+func (e *GameEngine) translateQueueFleetConstruction(base types.BaseEvent, empire *types.EmpireState, cmd *messages.QueueFleetConstructionCommand) (events.GameEvent, *CommandError) {
+	colony, err := e.resolveColony(empire, cmd.GetColonyId())
+	if err != nil {
+		return nil, err
+	}
+
+	quantity, err := buildQuantity(cmd.GetQuantity())
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.GetShipType() == "" {
+		return nil, newCommandError(systems.ErrCodeInvalidCommand, "ship type is required")
+	}
+
+	base.Type = "build_ship_command"
+	return &types.BuildShipCommandEvent{
+		BaseEvent: base,
+		PlayerID:  empire.PlayerID,
+		ColonyID:  colony.ID,
+		ShipType:  cmd.GetShipType(),
+		Quantity:  quantity,
+	}, nil
+}
+
+func (e *GameEngine) translateQueueConstruction(base types.BaseEvent, empire *types.EmpireState, cmd *messages.QueueConstructionCommand) (events.GameEvent, *CommandError) {
+	colony, err := e.resolveColony(empire, cmd.GetColonyId())
+	if err != nil {
+		return nil, err
+	}
+
+	quantity, err := buildQuantity(cmd.GetQuantity())
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.GetBuildingType() == "" {
+		return nil, newCommandError(systems.ErrCodeInvalidCommand, "building type is required")
+	}
+
+	base.Type = "build_building_command"
+	return &types.BuildBuildingCommandEvent{
+		BaseEvent:    base,
+		PlayerID:     empire.PlayerID,
+		ColonyID:     colony.ID,
+		BuildingType: cmd.GetBuildingType(),
+		Quantity:     quantity,
+	}, nil
+}
+
+func (e *GameEngine) translateReorderBuildQueue(base types.BaseEvent, empire *types.EmpireState, cmd *messages.ReorderBuildQueueCommand) (events.GameEvent, *CommandError) {
+	item, err := e.resolveBuildItem(empire, cmd.GetItemId())
+	if err != nil {
+		return nil, err
+	}
+
+	base.Type = "reorder_build_queue_command"
+	return &types.ReorderBuildQueueCommandEvent{
+		BaseEvent:   base,
+		PlayerID:    empire.PlayerID,
+		ItemID:      item.ID,
+		Position:    int(cmd.GetPosition()),
+		EmpireQueue: cmd.GetEmpireQueue(),
+	}, nil
+}
+
+func (e *GameEngine) translateCancelBuildItem(base types.BaseEvent, empire *types.EmpireState, cmd *messages.CancelBuildItemCommand) (events.GameEvent, *CommandError) {
+	item, err := e.resolveBuildItem(empire, cmd.GetItemId())
+	if err != nil {
+		return nil, err
+	}
+
+	base.Type = "cancel_build_command"
+	return &types.CancelBuildCommandEvent{
+		BaseEvent: base,
+		PlayerID:  empire.PlayerID,
+		ItemID:    item.ID,
+	}, nil
+}
+
+func (e *GameEngine) translateColonizePlanet(base types.BaseEvent, empire *types.EmpireState, cmd *messages.ColonizePlanetCommand) (events.GameEvent, *CommandError) {
+	fleet, err := e.resolveFleet(empire, cmd.GetFleetId())
+	if err != nil {
+		return nil, err
+	}
+
+	planetID, exists := e.worldState.IDs.Resolve(cmd.GetPlanetId())
+	if _, _, known := e.worldState.Galaxy.FindPlanet(planetID); !exists || !known {
+		return nil, newCommandError(systems.ErrCodeUnknownPlanet, "unknown planet %d", cmd.GetPlanetId())
+	}
+
+	base.Type = "colonize_command"
+	return &types.ColonizeCommandEvent{
+		BaseEvent: base,
+		PlayerID:  empire.PlayerID,
+		FleetID:   fleet.ID,
+		PlanetID:  planetID,
+	}, nil
+}
+
+func (e *GameEngine) translateQueueResearch(base types.BaseEvent, empire *types.EmpireState, cmd *messages.QueueResearchCommand) (events.GameEvent, *CommandError) {
+	if cmd.GetTechnology() == "" {
+		return nil, newCommandError(systems.ErrCodeInvalidCommand, "technology is required")
+	}
+
+	base.Type = "research_command"
+	return &types.ResearchCommandEvent{
+		BaseEvent:  base,
+		PlayerID:   empire.PlayerID,
+		Technology: cmd.GetTechnology(),
+		Remove:     cmd.GetRemove(),
+	}, nil
+}
+
+// resolveFleet looks up a fleet by reference. Must be called with the world lock held.
+func (e *GameEngine) resolveFleet(empire *types.EmpireState, ref uint64) (*types.Fleet, *CommandError) {
+	id, exists := e.worldState.IDs.Resolve(ref)
+	if !exists {
+		return nil, newCommandError(systems.ErrCodeUnknownFleet, "unknown fleet %d", ref)
+	}
+
+	// Fleets of other empires are reported as unknown so that references
+	// cannot be probed for fleets hidden by the fog of war
+	fleet, owned := empire.TotalFleets[id]
+	if !owned {
+		return nil, newCommandError(systems.ErrCodeUnknownFleet, "unknown fleet %d", ref)
+	}
+	return fleet, nil
+}
+
+// resolveColony looks up a colony by reference. Must be called with the world lock held.
+func (e *GameEngine) resolveColony(empire *types.EmpireState, ref uint64) (*types.Colony, *CommandError) {
+	id, exists := e.worldState.IDs.Resolve(ref)
+	if !exists {
+		return nil, newCommandError(systems.ErrCodeUnknownColony, "unknown colony %d", ref)
+	}
+
+	// Colonies of other empires are reported as unknown, like their fleets
+	colony, exists := e.worldState.Colonies[id]
+	if !exists || colony.Owner != empire.ID {
+		return nil, newCommandError(systems.ErrCodeUnknownColony, "unknown colony %d", ref)
+	}
+	return colony, nil
+}
+
+// resolveBuildItem looks up a queued build item by reference. Must be called with the world lock held.
+func (e *GameEngine) resolveBuildItem(empire *types.EmpireState, ref uint64) (*types.BuildItem, *CommandError) {
+	id, exists := e.worldState.IDs.Resolve(ref)
+	if !exists {
+		return nil, newCommandError(systems.ErrCodeUnknownBuildItem, "unknown build item %d", ref)
+	}
+
+	// Items of other empires are reported as unknown, like their fleets
+	i := types.IndexOfBuildItem(empire.BuildQueue, id)
+	if i < 0 {
+		return nil, newCommandError(systems.ErrCodeUnknownBuildItem, "unknown build item %d", ref)
+	}
+	return empire.BuildQueue[i], nil
+}
+
+// buildQuantity validates the quantity of a construction command, 0 means a single unit
+func buildQuantity(quantity uint32) (int, *CommandError) {
+	if quantity == 0 {
+		return 1, nil
+	}
+	if quantity > systems.MaxBuildQuantity {
+		return 0, newCommandError(systems.ErrCodeInvalidQuantity, "quantity must be between 1 and %d", systems.MaxBuildQuantity)
+	}
+	return int(quantity), nil
+}
@@ -0,0 +1,505 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/journal"
+	"github.com/gr4vediggr/stellarlight/internal/game/systems"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/internal/interfaces"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
+)
+
+// DefaultTickRate is the interval between two game ticks (10 TPS)
+const DefaultTickRate = 100 * time.Millisecond
+
+// commandBufferSize is the number of game commands that can be queued between two ticks
+const commandBufferSize = 256
+
+// ChecksumInterval is the number of ticks between two world checksums in the journal
+const ChecksumInterval = 600
+
+var (
+	ErrEngineNotRunning   = errors.New("game engine is not running")
+	ErrCommandQueueFull   = errors.New("game command queue is full")
+	ErrUnknownGameCommand = errors.New("unknown game command")
+	ErrInvalidGameSpeed   = errors.New("invalid game speed")
+)
+
+// GameEngine runs the simulation of a single game session. It owns the world
+// state and the event bus, and drives all registered game systems through a
+// fixed-rate tick loop.
+type GameEngine struct {
+	sessionID  uuid.UUID
+	worldState *types.WorldState
+	eventBus   *events.EventBus
+	systems    []types.GameSystem
+
+	tickRate time.Duration
+	commands chan *events.ClientCommandWrapper
+	resyncs  chan *types.ResyncRequestedEvent // players waiting to catch up with their update stream
+	clock    chan *types.ClockChangedEvent    // pauses and speed changes waiting for the next tick
+
+	pauseReason string            // why the clock is paused, only used by the tick loop
+	journal     *journal.Recorder // may be nil
+
+	running bool
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+}
+
+// NewGameEngine creates a game engine for the given session. The client
+// registry is used to push updates to connected players.
+func NewGameEngine(sessionID uuid.UUID, worldState *types.WorldState, assets *resource.Assets, clients interfaces.ClientRegistry) *GameEngine {
+	eventBus := events.NewEventBus()
+
+	e := &GameEngine{
+		sessionID:  sessionID,
+		worldState: worldState,
+		eventBus:   eventBus,
+		tickRate:   DefaultTickRate,
+		commands:   make(chan *events.ClientCommandWrapper, commandBufferSize),
+		resyncs:    make(chan *types.ResyncRequestedEvent, commandBufferSize),
+		clock:      make(chan *types.ClockChangedEvent, commandBufferSize),
+	}
+
+	e.RegisterSystem(systems.NewEconomySystem(eventBus, worldState, assets))
+	e.RegisterSystem(systems.NewConstructionSystem(eventBus, worldState, assets))
+	e.RegisterSystem(systems.NewColonySystem(eventBus, worldState, assets))
+	e.RegisterSystem(systems.NewResearchSystem(eventBus, worldState, assets))
+	e.RegisterSystem(systems.NewCombatSystem(eventBus, worldState, assets))
+	e.RegisterSystem(systems.NewBattleSystem(eventBus, worldState, assets))
+	e.RegisterSystem(systems.NewVisionSystem(eventBus, worldState, assets))
+	e.RegisterSystem(systems.NewClientUpdateSystem(eventBus, worldState, clients))
+
+	return e
+}
+
+// RegisterSystem adds a game system to the engine. Systems must be registered
+// before the game is started.
+func (e *GameEngine) RegisterSystem(system types.GameSystem) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.systems = append(e.systems, system)
+}
+
+// WorldState returns the world state simulated by the engine
+func (e *GameEngine) WorldState() *types.WorldState {
+	return e.worldState
+}
+
+// EventBus returns the event bus used by the engine and its systems
+func (e *GameEngine) EventBus() *events.EventBus {
+	return e.eventBus
+}
+
+// SetTickRate changes the interval between ticks. Only effective before StartGame.
+func (e *GameEngine) SetTickRate(rate time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if rate > 0 {
+		e.tickRate = rate
+	}
+}
+
+// TickRate returns the interval between ticks
+func (e *GameEngine) TickRate() time.Duration {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.tickRate
+}
+
+// SetJournal records the commands and clock changes applied by the engine and
+// checksums of the world, so that the game can be replayed. Only effective
+// before StartGame.
+func (e *GameEngine) SetJournal(recorder *journal.Recorder) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.journal = recorder
+}
+
+// IsRunning reports whether the tick loop is active
+func (e *GameEngine) IsRunning() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.running
+}
+
+// StartGame initializes all systems and starts the tick loop
+func (e *GameEngine) StartGame() {
+	if e.start() {
+		e.wg.Add(1)
+		go e.run()
+	}
+}
+
+// StartReplay initializes all systems like StartGame, but without the tick
+// loop. Ticks are simulated by calling Step.
+func (e *GameEngine) StartReplay() {
+	e.start()
+}
+
+// start initializes all systems. It reports whether the engine was stopped.
+func (e *GameEngine) start() bool {
+	e.mu.Lock()
+	if e.running {
+		e.mu.Unlock()
+		return false
+	}
+
+	for _, system := range e.systems {
+		if err := system.Initialize(); err != nil {
+			log.Printf("Failed to initialize %s: %v", system.GetName(), err)
+		}
+	}
+
+	e.ctx, e.cancel = context.WithCancel(context.Background())
+	e.running = true
+	e.mu.Unlock()
+
+	e.eventBus.Publish(&types.GameStartedEvent{
+		BaseEvent: types.BaseEvent{
+			SessionID: e.sessionID,
+			Type:      string(events.EventTypeGameStarted),
+			Timestamp: time.Now().UnixNano(),
+		},
+	})
+	return true
+}
+
+// Stop halts the tick loop and shuts down all systems
+func (e *GameEngine) Stop() {
+	e.mu.Lock()
+	if !e.running {
+		e.mu.Unlock()
+		return
+	}
+	e.running = false
+	e.cancel()
+	e.mu.Unlock()
+
+	e.wg.Wait()
+	e.recordChecksum()
+
+	// Shutdown in reverse registration order
+	for i := len(e.systems) - 1; i >= 0; i-- {
+		if err := e.systems[i].Shutdown(); err != nil {
+			log.Printf("Failed to shut down %s: %v", e.systems[i].GetName(), err)
+		}
+	}
+}
+
+// ProcessGameCommand queues a game command. Commands are applied at the start
+// of the next tick so that they never interleave with system updates.
+func (e *GameEngine) ProcessGameCommand(cmd *events.ClientCommandWrapper) error {
+	if !e.IsRunning() {
+		return ErrEngineNotRunning
+	}
+
+	select {
+	case e.commands <- cmd:
+		return nil
+	default:
+		return ErrCommandQueueFull
+	}
+}
+
+// RequestResync brings a player's client up to date with its update stream.
+// The messages after lastSequence are replayed if they are still buffered,
+// otherwise a full snapshot is sent. Like commands, resyncs are handled
+// between two ticks so that they line up with the sequence numbers.
+func (e *GameEngine) RequestResync(playerID uuid.UUID, lastSequence uint64) error {
+	if !e.IsRunning() {
+		return ErrEngineNotRunning
+	}
+
+	resync := &types.ResyncRequestedEvent{
+		BaseEvent: types.BaseEvent{
+			SessionID: e.sessionID,
+			Type:      "resync_requested",
+		},
+		PlayerID:     playerID,
+		LastSequence: lastSequence,
+	}
+
+	select {
+	case e.resyncs <- resync:
+		return nil
+	default:
+		return ErrCommandQueueFull
+	}
+}
+
+// Pause freezes the game clock. While paused no ticks are simulated, but
+// commands and resyncs are still handled so players can give orders. The
+// reason is passed on to the clients.
+func (e *GameEngine) Pause(reason string) error {
+	return e.changeClock(&types.ClockChangedEvent{Paused: true, Reason: reason})
+}
+
+// Resume starts the game clock again after a pause
+func (e *GameEngine) Resume() error {
+	return e.changeClock(&types.ClockChangedEvent{Paused: false})
+}
+
+// SetSpeed changes the rate of the game clock in in-game years per real hour
+func (e *GameEngine) SetSpeed(yearsPerHour float64) error {
+	if yearsPerHour <= 0 {
+		return ErrInvalidGameSpeed
+	}
+	return e.changeClock(&types.ClockChangedEvent{YearsPerHour: yearsPerHour})
+}
+
+// changeClock queues a change of the game clock. Like commands, changes are
+// applied between two ticks. A change without a speed keeps the current one,
+// a speed change keeps the game paused or running.
+func (e *GameEngine) changeClock(change *types.ClockChangedEvent) error {
+	if !e.IsRunning() {
+		return ErrEngineNotRunning
+	}
+
+	change.SessionID = e.sessionID
+	change.Type = string(events.EventTypeClockChanged)
+
+	select {
+	case e.clock <- change:
+		return nil
+	default:
+		return ErrCommandQueueFull
+	}
+}
+
+// Step simulates a single tick of a game started with StartReplay
+func (e *GameEngine) Step() {
+	e.step(e.tickRate)
+}
+
+// run simulates a tick every tick rate. Every tick moves the game clock on by
+// the tick rate, even if the ticker fell behind, so that a replay of the
+// game simulates exactly the same ticks.
+func (e *GameEngine) run() {
+	defer e.wg.Done()
+
+	ticker := time.NewTicker(e.tickRate)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-e.ctx.Done():
+			return
+		case <-ticker.C:
+			e.step(e.tickRate)
+		}
+	}
+}
+
+// step advances the simulation by a single tick. The game clock moves on by
+// delta at the rate of the session. Nothing is simulated while the game is
+// paused.
+func (e *GameEngine) step(delta time.Duration) {
+	e.drainClockChanges()
+	e.drainCommands()
+	e.drainResyncs()
+
+	e.worldState.AcquireLock()
+	if e.worldState.Clock.Paused {
+		e.worldState.ReleaseLock()
+		return
+	}
+	e.worldState.Turn++
+	tick := e.worldState.Turn
+	days := e.worldState.Clock.Advance(delta)
+	now := e.worldState.Clock.Days
+	e.worldState.ReleaseLock()
+
+	e.eventBus.Publish(&types.GameTickEvent{
+		BaseEvent: types.BaseEvent{
+			SessionID: e.sessionID,
+			Type:      string(events.EventTypeGameTick),
+			Timestamp: time.Now().UnixNano(),
+		},
+		Tick:      tick,
+		DeltaTime: delta,
+		Days:      days,
+		Now:       now,
+	})
+
+	if tick%ChecksumInterval == 0 {
+		e.recordChecksum()
+	}
+}
+
+// recordChecksum writes the checksum of the world to the journal
+func (e *GameEngine) recordChecksum() {
+	if e.journal == nil {
+		return
+	}
+
+	e.worldState.AcquireLock()
+	tick := e.worldState.Turn
+	checksum, err := e.worldState.Checksum()
+	e.worldState.ReleaseLock()
+	if err != nil {
+		log.Printf("Failed to compute the checksum of session %s: %v", e.sessionID, err)
+		return
+	}
+
+	e.journal.Record(&journal.Entry{
+		Kind:     journal.KindChecksum,
+		Tick:     tick,
+		Time:     time.Now(),
+		Checksum: checksum,
+	})
+}
+
+func (e *GameEngine) drainCommands() {
+	for {
+		select {
+		case cmd := <-e.commands:
+			e.recordCommand(cmd)
+			if err := e.dispatchCommand(cmd); err != nil {
+				log.Printf("Failed to process game command from %s: %v", cmd.PlayerID, err)
+			}
+		default:
+			return
+		}
+	}
+}
+
+// drainClockChanges applies the queued clock changes and tells the systems
+// about those that changed anything
+func (e *GameEngine) drainClockChanges() {
+	for {
+		select {
+		case change := <-e.clock:
+			if e.applyClockChange(change) {
+				e.recordClockChange(change)
+				change.Timestamp = time.Now().UnixNano()
+				e.eventBus.Publish(change)
+			}
+		default:
+			return
+		}
+	}
+}
+
+// applyClockChange updates the game clock and fills in the resulting state of
+// the clock. It reports whether the clock changed.
+func (e *GameEngine) applyClockChange(change *types.ClockChangedEvent) bool {
+	e.worldState.AcquireLock()
+	defer e.worldState.ReleaseLock()
+
+	clock := &e.worldState.Clock
+	var changed bool
+	switch {
+	case change.YearsPerHour > 0:
+		changed = clock.YearsPerHour != change.YearsPerHour
+		clock.YearsPerHour = change.YearsPerHour
+	case change.Paused:
+		changed = !clock.Paused
+		if changed || e.pauseReason == "" {
+			e.pauseReason = change.Reason
+		}
+		clock.Paused = true
+	default:
+		changed = clock.Paused
+		e.pauseReason = ""
+		clock.Paused = false
+	}
+
+	change.Paused = clock.Paused
+	change.Reason = e.pauseReason
+	change.YearsPerHour = clock.YearsPerHour
+	change.Now = clock.Days
+	return changed
+}
+
+// recordCommand writes a game command to the journal before it is applied.
+// Only the tick loop changes the turn, so it can be read without the lock.
+func (e *GameEngine) recordCommand(cmd *events.ClientCommandWrapper) {
+	if e.journal == nil {
+		return
+	}
+
+	entry, err := journal.NewCommandEntry(journal.KindApplied, e.worldState.Turn, cmd)
+	if err != nil {
+		log.Printf("Failed to journal game command from %s: %v", cmd.PlayerID, err)
+		return
+	}
+	e.journal.Record(entry)
+}
+
+// recordClockChange writes the state of the clock after a change to the journal
+func (e *GameEngine) recordClockChange(change *types.ClockChangedEvent) {
+	if e.journal == nil {
+		return
+	}
+
+	e.journal.Record(&journal.Entry{
+		Kind: journal.KindClock,
+		Tick: e.worldState.Turn,
+		Time: time.Now(),
+		Clock: &journal.Clock{
+			Paused:       change.Paused,
+			Reason:       change.Reason,
+			YearsPerHour: change.YearsPerHour,
+		},
+	})
+}
+
+func (e *GameEngine) drainResyncs() {
+	for {
+		select {
+		case resync := <-e.resyncs:
+			resync.Timestamp = time.Now().UnixNano()
+			e.eventBus.Publish(resync)
+		default:
+			return
+		}
+	}
+}
+
+// dispatchCommand translates a game command into an engine event and publishes
+// it. Commands that cannot be translated are rejected back to the player.
+func (e *GameEngine) dispatchCommand(cmd *events.ClientCommandWrapper) error {
+	event, err := e.translateCommand(cmd.PlayerID, cmd.Command.GetGameCommand())
+	if err != nil {
+		e.rejectCommand(cmd.PlayerID, err)
+		return err
+	}
+
+	e.eventBus.Publish(event)
+	return nil
+}
+
+// rejectCommand notifies a player that their command was not accepted
+func (e *GameEngine) rejectCommand(playerID uuid.UUID, err error) {
+	rejected := &types.CommandRejectedEvent{
+		BaseEvent: types.BaseEvent{
+			SessionID: e.sessionID,
+			Type:      "command_rejected",
+			Timestamp: time.Now().UnixNano(),
+		},
+		PlayerID:  playerID,
+		ErrorCode: systems.ErrCodeInvalidCommand,
+		Message:   err.Error(),
+	}
+
+	var cmdErr *CommandError
+	if errors.As(err, &cmdErr) {
+		rejected.ErrorCode = cmdErr.Code
+		rejected.Message = cmdErr.Message
+		rejected.Context = cmdErr.Context
+	}
+
+	e.eventBus.Publish(rejected)
+}
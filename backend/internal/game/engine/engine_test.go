@@ -0,0 +1,406 @@
+package engine_test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/engine"
+	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/internal/interfaces"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
+	"github.com/gr4vediggr/stellarlight/pkg/messages"
+)
+
+func TestGameCommandTranslation(t *testing.T) {
+	world := types.NewWorldState()
+
+	system := &types.StarSystemState{ID: uuid.New(), Fleets: make(map[uuid.UUID]*types.Fleet)}
+	system.Ref = world.IDs.Register(system.ID)
+	world.Galaxy.AddSystem(system)
+
+	playerID, otherID := uuid.New(), uuid.New()
+	own := types.NewEmpireState(playerID, "Own")
+	other := types.NewEmpireState(otherID, "Other")
+	world.Empires[playerID] = own
+	world.Empires[otherID] = other
+
+	ownFleet := &types.Fleet{ID: uuid.New(), Owner: own.ID, Location: system.ID}
+	ownFleet.Ref = world.IDs.Register(ownFleet.ID)
+	own.TotalFleets[ownFleet.ID] = ownFleet
+
+	otherFleet := &types.Fleet{ID: uuid.New(), Owner: other.ID, Location: system.ID}
+	otherFleet.Ref = world.IDs.Register(otherFleet.ID)
+	other.TotalFleets[otherFleet.ID] = otherFleet
+
+	e := engine.NewGameEngine(uuid.New(), world, &resource.Assets{}, clientMap{})
+	e.SetTickRate(5 * time.Millisecond)
+
+	moved := make(chan *types.FleetMoveCommandEvent, 1)
+	rejected := make(chan *types.CommandRejectedEvent, 4)
+	events.Subscribe(e.EventBus(), "fleet_move_command", func(event *types.FleetMoveCommandEvent) {
+		moved <- event
+	})
+	events.Subscribe(e.EventBus(), "command_rejected", func(event *types.CommandRejectedEvent) {
+		rejected <- event
+	})
+
+	e.StartGame()
+	defer e.Stop()
+
+	send := func(gc *messages.GameCommand) {
+		err := e.ProcessGameCommand(&events.ClientCommandWrapper{
+			PlayerID: playerID,
+			Command: &messages.ClientCommand{
+				Command: &messages.ClientCommand_GameCommand{GameCommand: gc},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Failed to queue command: %v", err)
+		}
+	}
+	moveFleet := func(fleet, destination uint64) *messages.GameCommand {
+		return &messages.GameCommand{Action: &messages.GameCommand_MoveFleet{
+			MoveFleet: &messages.MoveFleetCommand{FleetId: fleet, DestinationStarId: destination},
+		}}
+	}
+
+	expectRejection := func(code string) {
+		t.Helper()
+		select {
+		case r := <-rejected:
+			if r.ErrorCode != code || r.PlayerID != playerID {
+				t.Errorf("Expected %s for player, got %s: %s", code, r.ErrorCode, r.Message)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Expected %s rejection", code)
+		}
+	}
+
+	// Fleets of other empires look like missing ones
+	send(moveFleet(otherFleet.Ref, system.Ref))
+	expectRejection("UNKNOWN_FLEET")
+
+	send(moveFleet(12345, system.Ref))
+	expectRejection("UNKNOWN_FLEET")
+
+	send(moveFleet(ownFleet.Ref, 0))
+	expectRejection("UNKNOWN_SYSTEM")
+
+	send(&messages.GameCommand{})
+	expectRejection("INVALID_COMMAND")
+
+	send(moveFleet(ownFleet.Ref, system.Ref))
+	select {
+	case m := <-moved:
+		if m.FleetID != ownFleet.ID || m.TargetSystemID != system.ID || m.PlayerID != playerID {
+			t.Errorf("Unexpected move event: %+v", m)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected fleet move event")
+	}
+}
+
+// clientMap is a client registry without a session
+type clientMap map[uuid.UUID]interfaces.GameClientInterface
+
+func (m clientMap) GetClient(playerID uuid.UUID) (interfaces.GameClientInterface, bool) {
+	client, exists := m[playerID]
+	return client, exists
+}
+
+// recordingClient collects the messages sent to a player. Like a websocket
+// client, it drops messages when its buffer is full.
+type recordingClient struct {
+	userID   uuid.UUID
+	messages chan *messages.ServerMessage
+}
+
+func (c *recordingClient) GetUserID() uuid.UUID { return c.userID }
+func (c *recordingClient) Disconnect()          {}
+func (c *recordingClient) SendMessage(msg *messages.ServerMessage) error {
+	select {
+	case c.messages <- msg:
+	default:
+	}
+	return nil
+}
+
+func TestSnapshotOnlyContainsVisibleState(t *testing.T) {
+	world := types.NewWorldState()
+
+	// Three systems in a row, the player owns the first one
+	systems := make([]*types.StarSystemState, 3)
+	for i := range systems {
+		systems[i] = &types.StarSystemState{ID: uuid.New(), Fleets: make(map[uuid.UUID]*types.Fleet)}
+		systems[i].Ref = world.IDs.Register(systems[i].ID)
+		world.Galaxy.AddSystem(systems[i])
+	}
+	for i := 1; i < len(systems); i++ {
+		systems[i-1].Hyperlanes = append(systems[i-1].Hyperlanes, systems[i].ID)
+		systems[i].Hyperlanes = append(systems[i].Hyperlanes, systems[i-1].ID)
+	}
+
+	playerID, otherID := uuid.New(), uuid.New()
+	own := types.NewEmpireState(playerID, "Own")
+	other := types.NewEmpireState(otherID, "Other")
+	world.Empires[playerID] = own
+	world.Empires[otherID] = other
+	world.ClaimSystem(own, systems[0])
+	world.ClaimSystem(other, systems[2])
+
+	visibleFleet := &types.Fleet{ID: uuid.New(), Owner: other.ID, Location: systems[1].ID, Ships: map[string]int{}}
+	hiddenFleet := &types.Fleet{ID: uuid.New(), Owner: other.ID, Location: systems[2].ID, Ships: map[string]int{}}
+	for _, fleet := range []*types.Fleet{visibleFleet, hiddenFleet} {
+		fleet.Ref = world.IDs.Register(fleet.ID)
+		other.TotalFleets[fleet.ID] = fleet
+	}
+	systems[1].AddFleet(visibleFleet)
+	systems[2].AddFleet(hiddenFleet)
+
+	client := &recordingClient{userID: playerID, messages: make(chan *messages.ServerMessage, 64)}
+	e := engine.NewGameEngine(uuid.New(), world, &resource.Assets{}, clientMap{playerID: client})
+	e.SetTickRate(time.Hour)
+
+	e.StartGame()
+	defer e.Stop()
+
+	var snapshot *messages.GameSnapshotMessage
+	select {
+	case msg := <-client.messages:
+		snapshot = msg.GetGameMessage().GetSnapshot()
+	case <-time.After(time.Second):
+		t.Fatal("Expected a snapshot when the game starts")
+	}
+	if snapshot == nil {
+		t.Fatal("Expected the first message to be a snapshot")
+	}
+
+	if snapshot.Empire.GetEmpireId() != own.ID.String() || snapshot.Sequence != 0 {
+		t.Errorf("Unexpected empire or sequence in snapshot: %v, %d", snapshot.Empire, snapshot.Sequence)
+	}
+	if len(snapshot.Systems) != 3 {
+		t.Fatalf("Expected the layout of all 3 systems, got %d", len(snapshot.Systems))
+	}
+
+	for _, system := range snapshot.Systems {
+		hidden := system.Id == systems[2].Ref
+		if system.Visible == hidden {
+			t.Errorf("Expected visibility of system %d to be %v", system.Id, !hidden)
+		}
+		if hidden && system.OwnerEmpireId != "" {
+			t.Error("Expected the owner of a system never seen to be unknown")
+		}
+	}
+
+	if len(snapshot.Fleets) != 1 || snapshot.Fleets[0].Id != visibleFleet.Ref {
+		t.Errorf("Expected only the fleet in vision, got %v", snapshot.Fleets)
+	}
+}
+
+func TestResyncReplaysMissedUpdates(t *testing.T) {
+	world := types.NewWorldState()
+	playerID := uuid.New()
+	world.Empires[playerID] = types.NewEmpireState(playerID, "Own")
+	world.Clock = types.NewGameClock(1000) // an in-game day every 10 milliseconds
+
+	client := &recordingClient{userID: playerID, messages: make(chan *messages.ServerMessage, 256)}
+	e := engine.NewGameEngine(uuid.New(), world, &resource.Assets{}, clientMap{playerID: client})
+	e.SetTickRate(time.Millisecond)
+
+	e.StartGame()
+	defer e.Stop()
+
+	// Turn updates are not part of the update stream
+	next := func() *messages.GameMessage {
+		t.Helper()
+		for {
+			select {
+			case msg := <-client.messages:
+				if msg.GetGameMessage().GetTurnUpdate() == nil {
+					return msg.GetGameMessage()
+				}
+			case <-time.After(time.Second):
+				t.Fatal("Expected a game message")
+				return nil
+			}
+		}
+	}
+
+	if next().GetSnapshot() == nil {
+		t.Fatal("Expected a snapshot when the game starts")
+	}
+
+	// Economy updates produce a delta per update
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		if delta := next().GetDelta(); delta == nil || delta.Sequence != sequence {
+			t.Fatalf("Expected delta %d, got %v", sequence, delta)
+		}
+	}
+
+	if err := e.RequestResync(playerID, 1); err != nil {
+		t.Fatalf("Failed to request resync: %v", err)
+	}
+
+	// Deltas 2 and 3 are sent again, between the live ones
+	seen := map[uint64]bool{}
+	for !seen[2] || !seen[3] {
+		delta := next().GetDelta()
+		if delta == nil {
+			t.Fatal("Expected only deltas after a resync within the buffer")
+		}
+		seen[delta.Sequence] = true
+	}
+
+	if err := e.RequestResync(playerID, 0); err != nil {
+		t.Fatalf("Failed to request resync: %v", err)
+	}
+	for {
+		if next().GetSnapshot() != nil {
+			break
+		}
+	}
+}
+
+func TestPauseFreezesClock(t *testing.T) {
+	world := types.NewWorldState()
+	playerID := uuid.New()
+	world.Empires[playerID] = types.NewEmpireState(playerID, "Own")
+	world.Clock = types.NewGameClock(1000)
+
+	client := &recordingClient{userID: playerID, messages: make(chan *messages.ServerMessage, 256)}
+	e := engine.NewGameEngine(uuid.New(), world, &resource.Assets{}, clientMap{playerID: client})
+	e.SetTickRate(time.Millisecond)
+
+	e.StartGame()
+	defer e.Stop()
+
+	// Waits for the turn update telling the game was paused or resumed
+	waitForPause := func(paused bool) *messages.TurnUpdateMessage {
+		t.Helper()
+		for {
+			select {
+			case msg := <-client.messages:
+				if update := msg.GetGameMessage().GetTurnUpdate(); update != nil && update.IsPaused == paused {
+					return update
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("Expected a turn update with the game paused: %v", paused)
+				return nil
+			}
+		}
+	}
+	now := func() (float64, int) {
+		world.AcquireLock()
+		defer world.ReleaseLock()
+		return world.Clock.Days, world.Turn
+	}
+
+	if err := e.Pause("host"); err != nil {
+		t.Fatalf("Failed to pause: %v", err)
+	}
+	if update := waitForPause(true); update.PauseReason != "host" {
+		t.Errorf("Expected the host to have paused the game, got %q", update.PauseReason)
+	}
+
+	days, turn := now()
+	time.Sleep(20 * time.Millisecond)
+	if d, tr := now(); d != days || tr != turn {
+		t.Errorf("Expected the clock to stand still while paused, moved from day %v to %v", days, d)
+	}
+
+	if err := e.SetSpeed(2000); err != nil {
+		t.Fatalf("Failed to change speed: %v", err)
+	}
+	if err := e.Resume(); err != nil {
+		t.Fatalf("Failed to resume: %v", err)
+	}
+	if update := waitForPause(false); update.YearsPerHour != 2000 {
+		t.Errorf("Expected the new speed to be kept, got %v", update.YearsPerHour)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	if d, _ := now(); d <= days {
+		t.Error("Expected the clock to run again after resuming")
+	}
+}
+
+func TestEngineLifecycle(t *testing.T) {
+	world := types.NewWorldState()
+	e := engine.NewGameEngine(uuid.New(), world, &resource.Assets{}, clientMap{})
+	e.SetTickRate(5 * time.Millisecond)
+
+	var started atomic.Int32
+	events.Subscribe(e.EventBus(), string(events.EventTypeGameStarted), func(event *types.GameStartedEvent) {
+		started.Add(1)
+	})
+	turn := func() int {
+		world.AcquireLock()
+		defer world.ReleaseLock()
+		return world.Turn
+	}
+	eventually := func(what string, done func() bool) {
+		t.Helper()
+		deadline := time.Now().Add(time.Second)
+		for !done() {
+			if time.Now().After(deadline) {
+				t.Fatalf("Expected %s", what)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	stopWithin := func(what string) {
+		t.Helper()
+		stopped := make(chan struct{})
+		go func() {
+			e.Stop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(time.Second):
+			t.Fatalf("Expected %s to return", what)
+		}
+	}
+
+	// Stopping an engine that never started does nothing
+	stopWithin("a stop before the start")
+	if e.IsRunning() {
+		t.Fatal("Expected the engine not to run before it started")
+	}
+	if err := e.ProcessGameCommand(&events.ClientCommandWrapper{PlayerID: uuid.New(), Command: &messages.ClientCommand{}}); !errors.Is(err, engine.ErrEngineNotRunning) {
+		t.Errorf("Expected commands to be refused before the start, got %v", err)
+	}
+
+	// Starting twice runs a single tick loop
+	begin := time.Now()
+	e.StartGame()
+	e.StartGame()
+	if !e.IsRunning() {
+		t.Fatal("Expected the engine to run after the start")
+	}
+	eventually("ticks to be simulated", func() bool { return turn() >= 10 })
+	if elapsed, ticks := time.Since(begin), turn(); ticks > int(elapsed/(5*time.Millisecond))+2 {
+		t.Errorf("Expected a single tick loop, got %d ticks in %v", ticks, elapsed)
+	}
+	eventually("the game to be started", func() bool { return started.Load() >= 1 })
+
+	// Stopping twice stops the tick loop once
+	stopWithin("the first stop")
+	stopWithin("the second stop")
+	if e.IsRunning() {
+		t.Fatal("Expected the engine to be stopped")
+	}
+	stoppedAt := turn()
+	time.Sleep(20 * time.Millisecond)
+	if ticks := turn(); ticks != stoppedAt {
+		t.Errorf("Expected no ticks after the stop, went from %d to %d", stoppedAt, ticks)
+	}
+	if n := started.Load(); n != 1 {
+		t.Errorf("Expected the game to start once, started %d times", n)
+	}
+}
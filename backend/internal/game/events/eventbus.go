@@ -1,42 +1,146 @@
 package events
 
 import (
-	"reflect"
+	"log"
+	"sync"
 
 	"github.com/google/uuid"
 )
 
+// SubscriptionID identifies a subscription to the event bus
+type SubscriptionID uint64
+
+// EventBus delivers game events to the handlers subscribed to their type.
+//
+// Events of a session are handled one at a time, in the order they were
+// published. An event published by a handler is queued and handled after the
+// current event is done with, so a tick and everything it causes is handled
+// in the same order every time. Events of different sessions may be handled
+// at the same time.
+//
+// The bus is safe for concurrent use. The goroutine that publishes an event
+// while its session is idle handles the queue of the session until it is
+// empty; if another goroutine is already handling events of the session,
+// Publish queues the event and returns right away.
 type EventBus struct {
-	subscribers map[string][]func(GameEvent)
+	mu          sync.Mutex
+	nextID      SubscriptionID
+	subscribers map[string][]subscription   // by event type, in order of subscription
+	queues      map[uuid.UUID]*sessionQueue // pending events of sessions being handled
+}
+
+type subscription struct {
+	id      SubscriptionID
+	handler func(GameEvent)
+}
+
+// sessionQueue holds the events of a session waiting to be handled
+type sessionQueue struct {
+	events []GameEvent
 }
 
 func NewEventBus() *EventBus {
 	return &EventBus{
-		subscribers: make(map[string][]func(GameEvent)),
+		subscribers: make(map[string][]subscription),
+		queues:      make(map[uuid.UUID]*sessionQueue),
 	}
 }
 
-func (eb *EventBus) Subscribe(eventType string, handler func(GameEvent)) func() {
-	eb.subscribers[eventType] = append(eb.subscribers[eventType], handler)
-	return func() {
-		for i, h := range eb.subscribers[eventType] {
-			// Compare function pointers using reflect
-			if reflect.ValueOf(h).Pointer() == reflect.ValueOf(handler).Pointer() {
-				eb.subscribers[eventType] = append(eb.subscribers[eventType][:i], eb.subscribers[eventType][i+1:]...)
-				break
+// Subscribe calls handler for every event of the given type. Handlers of the
+// same type are called in the order they subscribed.
+func (eb *EventBus) Subscribe(eventType string, handler func(GameEvent)) SubscriptionID {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+
+	eb.nextID++
+	eb.subscribers[eventType] = append(eb.subscribers[eventType], subscription{id: eb.nextID, handler: handler})
+	return eb.nextID
+}
+
+// Unsubscribe removes subscriptions. Events already being handled may still
+// reach them.
+func (eb *EventBus) Unsubscribe(ids ...SubscriptionID) {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+
+	for _, id := range ids {
+		for eventType, subs := range eb.subscribers {
+			for i, sub := range subs {
+				if sub.id == id {
+					// Copy so that handlers being called keep their list
+					eb.subscribers[eventType] = append(subs[:i:i], subs[i+1:]...)
+					break
+				}
 			}
 		}
 	}
 }
 
+// Publish queues an event for its session and handles the queue unless
+// another goroutine is already doing so
 func (eb *EventBus) Publish(event GameEvent) {
-	if handlers, ok := eb.subscribers[event.GetType()]; ok {
-		for _, handler := range handlers {
-			handler(event)
+	sessionID := event.GetSessionID()
+
+	eb.mu.Lock()
+	if queue, dispatching := eb.queues[sessionID]; dispatching {
+		queue.events = append(queue.events, event)
+		eb.mu.Unlock()
+		return
+	}
+	queue := &sessionQueue{}
+	eb.queues[sessionID] = queue
+	eb.mu.Unlock()
+
+	// Don't leave the session stuck behind a handler that panicked
+	defer func() {
+		if event != nil {
+			eb.mu.Lock()
+			delete(eb.queues, sessionID)
+			eb.mu.Unlock()
+		}
+	}()
+
+	for event != nil {
+		eb.dispatch(event)
+
+		eb.mu.Lock()
+		event = nil
+		if len(queue.events) > 0 {
+			event = queue.events[0]
+			queue.events = queue.events[1:]
+		} else {
+			delete(eb.queues, sessionID)
 		}
+		eb.mu.Unlock()
 	}
 }
 
+// dispatch calls the handlers of an event. The bus is not locked while they
+// run, so they can publish, subscribe and unsubscribe.
+func (eb *EventBus) dispatch(event GameEvent) {
+	eb.mu.Lock()
+	subs := eb.subscribers[event.GetType()]
+	eb.mu.Unlock()
+
+	for _, sub := range subs {
+		sub.handler(event)
+	}
+}
+
+// Subscribe calls handler for every event of the given type that is an E.
+// Events of the type that are not an E are logged and dropped instead of
+// making the handler panic.
+func Subscribe[E GameEvent](eb *EventBus, eventType string, handler func(E)) SubscriptionID {
+	return eb.Subscribe(eventType, func(event GameEvent) {
+		typed, ok := event.(E)
+		if !ok {
+			log.Printf("Dropping %s event of unexpected type %T", eventType, event)
+			return
+		}
+		handler(typed)
+	})
+}
+
 // GameEvent interface for all game events
 type GameEvent interface {
 	GetSessionID() uuid.UUID
@@ -0,0 +1,76 @@
+package events_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+)
+
+func TestEventsRaisedByHandlersAreQueued(t *testing.T) {
+	bus := events.NewEventBus()
+	sessionID := uuid.New()
+	event := func(eventType string) *types.BaseEvent {
+		return &types.BaseEvent{SessionID: sessionID, Type: eventType}
+	}
+
+	var handled []string
+	bus.Subscribe("tick", func(events.GameEvent) {
+		bus.Publish(event("first"))
+		bus.Publish(event("second"))
+		handled = append(handled, "tick 1")
+	})
+	bus.Subscribe("tick", func(events.GameEvent) {
+		handled = append(handled, "tick 2")
+	})
+	bus.Subscribe("first", func(events.GameEvent) {
+		bus.Publish(event("third"))
+		handled = append(handled, "first")
+	})
+	for _, eventType := range []string{"second", "third"} {
+		bus.Subscribe(eventType, func(e events.GameEvent) {
+			handled = append(handled, e.GetType())
+		})
+	}
+
+	bus.Publish(event("tick"))
+
+	expected := []string{"tick 1", "tick 2", "first", "second", "third"}
+	if !reflect.DeepEqual(handled, expected) {
+		t.Errorf("Expected events to be handled in the order %v, got %v", expected, handled)
+	}
+}
+
+func TestUnsubscribeRemovesOnlyThatSubscription(t *testing.T) {
+	bus := events.NewEventBus()
+
+	calls := 0
+	handler := func(events.GameEvent) { calls++ }
+	first := bus.Subscribe("tick", handler)
+	bus.Subscribe("tick", handler)
+
+	bus.Unsubscribe(first)
+	bus.Publish(&types.BaseEvent{Type: "tick"})
+	if calls != 1 {
+		t.Errorf("Expected the handler to be called once, called %d times", calls)
+	}
+}
+
+func TestTypedSubscribeDropsOtherTypes(t *testing.T) {
+	bus := events.NewEventBus()
+
+	var ticks []int
+	events.Subscribe(bus, "game_tick", func(tick *types.GameTickEvent) {
+		ticks = append(ticks, tick.Tick)
+	})
+
+	bus.Publish(&types.BaseEvent{Type: "game_tick"})
+	bus.Publish(&types.GameTickEvent{BaseEvent: types.BaseEvent{Type: "game_tick"}, Tick: 7})
+
+	if !reflect.DeepEqual(ticks, []int{7}) {
+		t.Errorf("Expected only the tick event to be handled, got %v", ticks)
+	}
+}
@@ -0,0 +1,88 @@
+package journal
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// FileJournal keeps the journal of every session in a file of JSON lines in
+// a directory
+type FileJournal struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewFileJournal creates a journal in dir, creating the directory if needed
+func NewFileJournal(dir string) (*FileJournal, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileJournal{dir: dir}, nil
+}
+
+func (j *FileJournal) path(sessionID uuid.UUID) string {
+	return filepath.Join(j.dir, sessionID.String()+".jsonl")
+}
+
+func (j *FileJournal) Append(ctx context.Context, sessionID uuid.UUID, entries []*Entry) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	file, err := os.OpenFile(j.path(sessionID), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			file.Close()
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func (j *FileJournal) Load(ctx context.Context, sessionID uuid.UUID) ([]*Entry, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	file, err := os.Open(j.path(sessionID))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Decode(file)
+}
+
+// Decode reads entries written as JSON lines, as kept by FileJournal
+func Decode(r io.Reader) ([]*Entry, error) {
+	var entries []*Entry
+	decoder := json.NewDecoder(r)
+	for decoder.More() {
+		entry := &Entry{}
+		if err := decoder.Decode(entry); err != nil {
+			return nil, fmt.Errorf("entry %d: %w", len(entries)+1, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,136 @@
+package journal
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/pkg/messages"
+)
+
+// Kinds of journal entries
+const (
+	KindStart    = "start"    // the game started, earlier entries are commands given in the lobby
+	KindCommand  = "command"  // a command of a player reached the session
+	KindApplied  = "applied"  // the engine applied a game command before simulating the next tick
+	KindClock    = "clock"    // the game was paused, resumed or changed speed before the next tick
+	KindChecksum = "checksum" // checksum of the world after a tick
+	KindRestore  = "restore"  // the game was restored from a checkpoint, with the checksum of the restored world
+	KindGap      = "gap"      // entries needed to replay the game were lost, the journal cannot be replayed
+)
+
+// Journal is an append-only log of everything that happened to the games of
+// the server. A game can be replayed from the entries of its session.
+type Journal interface {
+	Append(ctx context.Context, sessionID uuid.UUID, entries []*Entry) error
+	Load(ctx context.Context, sessionID uuid.UUID) ([]*Entry, error)
+}
+
+// Entry is a single entry of the journal of a session. Tick is the number of
+// ticks simulated when it was written, so entries that change the world take
+// effect in tick Tick+1.
+type Entry struct {
+	Kind     string          `json:"kind"`
+	Tick     int             `json:"tick"`
+	Time     time.Time       `json:"time"`
+	PlayerID *uuid.UUID      `json:"player_id,omitempty"`
+	Command  json.RawMessage `json:"command,omitempty"` // ClientCommand in protobuf JSON
+	Start    *Start          `json:"start,omitempty"`
+	Clock    *Clock          `json:"clock,omitempty"`
+	Checksum string          `json:"checksum,omitempty"`
+}
+
+// Start holds everything needed to set up a game again
+type Start struct {
+	Seed     int64           `json:"seed"`
+	Settings json.RawMessage `json:"settings"` // GalaxyGenerateSettings in protobuf JSON
+	Players  []Player        `json:"players"`  // in join order
+	TickRate time.Duration   `json:"tick_rate"`
+}
+
+// Player is a player of a game at its start
+type Player struct {
+	ID       uuid.UUID `json:"id"`
+	EmpireID uuid.UUID `json:"empire_id"`
+	Name     string    `json:"name"`
+	Color    string    `json:"color"`
+}
+
+// Clock is the state of the game clock after it was changed
+type Clock struct {
+	Paused       bool    `json:"paused"`
+	Reason       string  `json:"reason,omitempty"`
+	YearsPerHour float64 `json:"years_per_hour"`
+}
+
+// NewStartEntry creates the first entry of a game
+func NewStartEntry(settings *messages.GalaxyGenerateSettings, players []Player, tickRate time.Duration) (*Entry, error) {
+	data, err := protojson.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Entry{
+		Kind: KindStart,
+		Time: time.Now(),
+		Start: &Start{
+			Seed:     settings.GetSeed(),
+			Settings: data,
+			Players:  players,
+			TickRate: tickRate,
+		},
+	}, nil
+}
+
+// NewCommandEntry creates an entry for a command of a player
+func NewCommandEntry(kind string, tick int, cmd *events.ClientCommandWrapper) (*Entry, error) {
+	data, err := protojson.Marshal(cmd.Command)
+	if err != nil {
+		return nil, err
+	}
+
+	playerID := cmd.PlayerID
+	return &Entry{
+		Kind:     kind,
+		Tick:     tick,
+		Time:     time.Now(),
+		PlayerID: &playerID,
+		Command:  data,
+	}, nil
+}
+
+// GalaxySettings decodes the settings of a start entry
+func (s *Start) GalaxySettings() (*messages.GalaxyGenerateSettings, error) {
+	settings := &messages.GalaxyGenerateSettings{}
+	if err := protojson.Unmarshal(s.Settings, settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// NeededForReplay reports whether the game cannot be replayed without the entry
+func (e *Entry) NeededForReplay() bool {
+	switch e.Kind {
+	case KindStart, KindApplied, KindClock, KindRestore:
+		return true
+	}
+	return false
+}
+
+// ClientCommand decodes the command of a command entry
+func (e *Entry) ClientCommand() (*events.ClientCommandWrapper, error) {
+	cmd := &messages.ClientCommand{}
+	if err := protojson.Unmarshal(e.Command, cmd); err != nil {
+		return nil, err
+	}
+
+	wrapper := &events.ClientCommandWrapper{Command: cmd}
+	if e.PlayerID != nil {
+		wrapper.PlayerID = *e.PlayerID
+	}
+	return wrapper, nil
+}
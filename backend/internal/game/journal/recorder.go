@@ -0,0 +1,164 @@
+package journal
+
+import (
+	"context"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// recorderBufferSize is the number of entries that can wait to be written
+	recorderBufferSize = 1024
+	// appendTimeout bounds a single write to the journal
+	appendTimeout = 10 * time.Second
+	// recordTimeout bounds how long an entry needed for replays waits for
+	// room in a full buffer
+	recordTimeout = 2 * time.Second
+)
+
+// Recorder writes the journal entries of a session in the background, so
+// that the tick loop never waits for the journal. Entries are written in the
+// order they were recorded. A nil Recorder drops everything.
+//
+// Once an entry needed for replays is lost, a gap entry is written so that
+// replays refuse the journal instead of reporting a checksum mismatch.
+type Recorder struct {
+	journal    Journal
+	sessionID  uuid.UUID
+	entries    chan *Entry
+	done       chan struct{}
+	incomplete atomic.Bool // an entry needed for replays was lost
+
+	mu     sync.RWMutex // guards closed and sends on entries
+	closed bool
+}
+
+// NewRecorder starts a recorder that appends to the journal of a session
+func NewRecorder(journal Journal, sessionID uuid.UUID) *Recorder {
+	r := &Recorder{
+		journal:   journal,
+		sessionID: sessionID,
+		entries:   make(chan *Entry, recorderBufferSize),
+		done:      make(chan struct{}),
+	}
+	go r.run()
+	return r
+}
+
+// Record queues an entry. If the journal falls too far behind, entries needed
+// for replays wait a bounded time for room, other entries are dropped and
+// logged rather than holding up the game. Entries recorded after Close are
+// dropped.
+func (r *Recorder) Record(entry *Entry) {
+	if r == nil {
+		return
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.closed {
+		return
+	}
+
+	select {
+	case r.entries <- entry:
+		return
+	default:
+	}
+
+	// Waiting is pointless once the journal can no longer be replayed
+	if entry.NeededForReplay() && !r.incomplete.Load() {
+		timer := time.NewTimer(recordTimeout)
+		defer timer.Stop()
+
+		select {
+		case r.entries <- entry:
+			return
+		case <-timer.C:
+		}
+	}
+
+	log.Printf("Journal of session %s is full, dropping %s entry of tick %d", r.sessionID, entry.Kind, entry.Tick)
+	if entry.NeededForReplay() {
+		r.markIncomplete()
+	}
+}
+
+// markIncomplete records that the journal can no longer be replayed
+func (r *Recorder) markIncomplete() {
+	if !r.incomplete.Swap(true) {
+		log.Printf("Journal of session %s lost entries needed for replays", r.sessionID)
+	}
+}
+
+// Close writes the remaining entries and stops the recorder. It is safe to
+// call more than once.
+func (r *Recorder) Close() {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.entries)
+	}
+	r.mu.Unlock()
+	<-r.done
+}
+
+func (r *Recorder) run() {
+	defer close(r.done)
+
+	gapWritten := false
+	lastTick := 0
+	for entry := range r.entries {
+		batch := []*Entry{entry}
+		// Write everything that piled up in one go
+	collect:
+		for {
+			select {
+			case next, ok := <-r.entries:
+				if !ok {
+					break collect
+				}
+				batch = append(batch, next)
+			default:
+				break collect
+			}
+		}
+
+		lastTick = batch[len(batch)-1].Tick
+		gap := r.incomplete.Load() && !gapWritten
+		if gap {
+			batch = append(batch, &Entry{Kind: KindGap, Tick: lastTick, Time: time.Now()})
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), appendTimeout)
+		if err := r.journal.Append(ctx, r.sessionID, batch); err != nil {
+			log.Printf("Failed to write %d journal entries of session %s: %v", len(batch), r.sessionID, err)
+			for _, lost := range batch {
+				if lost.NeededForReplay() {
+					r.markIncomplete()
+					break
+				}
+			}
+		} else if gap {
+			gapWritten = true
+		}
+		cancel()
+	}
+
+	// The gap must be written even if nothing else is recorded after the loss
+	if r.incomplete.Load() && !gapWritten {
+		ctx, cancel := context.WithTimeout(context.Background(), appendTimeout)
+		if err := r.journal.Append(ctx, r.sessionID, []*Entry{{Kind: KindGap, Tick: lastTick, Time: time.Now()}}); err != nil {
+			log.Printf("Failed to write the gap in the journal of session %s: %v", r.sessionID, err)
+		}
+		cancel()
+	}
+}
@@ -0,0 +1,48 @@
+package journal_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/journal"
+)
+
+// memoryJournal keeps the journal entries of every session
+type memoryJournal struct {
+	entries map[uuid.UUID][]*journal.Entry
+	mu      sync.Mutex
+}
+
+func (m *memoryJournal) Append(ctx context.Context, sessionID uuid.UUID, entries []*journal.Entry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[sessionID] = append(m.entries[sessionID], entries...)
+	return nil
+}
+
+func (m *memoryJournal) Load(ctx context.Context, sessionID uuid.UUID) ([]*journal.Entry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*journal.Entry(nil), m.entries[sessionID]...), nil
+}
+
+func TestRecordAfterClose(t *testing.T) {
+	store := &memoryJournal{entries: make(map[uuid.UUID][]*journal.Entry)}
+	sessionID := uuid.New()
+
+	recorder := journal.NewRecorder(store, sessionID)
+	recorder.Record(&journal.Entry{Kind: journal.KindApplied, Tick: 1})
+	recorder.Close()
+
+	// A player still connected to a session that shut down may send commands
+	recorder.Record(&journal.Entry{Kind: journal.KindCommand, Tick: 2})
+	recorder.Close()
+
+	entries, _ := store.Load(context.Background(), sessionID)
+	if len(entries) != 1 || entries[0].Tick != 1 {
+		t.Errorf("Expected only the entry recorded before Close, got %+v", entries)
+	}
+}
@@ -0,0 +1,285 @@
+package session
+
+import (
+	"log"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+)
+
+// SessionSummary describes a session for operators
+type SessionSummary struct {
+	ID          uuid.UUID        `json:"id"`
+	InviteCode  string           `json:"inviteCode"`
+	State       GameSessionState `json:"state"`
+	PauseReason string           `json:"pauseReason,omitempty"`
+	HostID      uuid.UUID        `json:"hostId"`
+	CreatedAt   time.Time        `json:"createdAt"`
+	EndedAt     *time.Time       `json:"endedAt,omitempty"`
+	Players     int              `json:"players"`
+	Connected   int              `json:"connected"`
+	Banned      int              `json:"banned"`
+	TickRateMs  int64            `json:"tickRateMs"`
+	Turn        int              `json:"turn"`
+}
+
+// PlayerSummary describes a player of a session for operators
+type PlayerSummary struct {
+	ID          uuid.UUID `json:"id"`
+	DisplayName string    `json:"displayName"`
+	EmpireID    uuid.UUID `json:"empireId"`
+	Color       string    `json:"color"`
+	IsHost      bool      `json:"isHost"`
+	Ready       bool      `json:"ready"`
+	Connected   bool      `json:"connected"`
+	JoinedAt    time.Time `json:"joinedAt"`
+	LastSeen    time.Time `json:"lastSeen"`
+}
+
+// WorldSummary describes the world of a game for operators
+type WorldSummary struct {
+	Turn         int             `json:"turn"`
+	Date         string          `json:"date"`
+	Paused       bool            `json:"paused"`
+	YearsPerHour float64         `json:"yearsPerHour"`
+	Systems      int             `json:"systems"`
+	Colonies     int             `json:"colonies"`
+	Battles      int             `json:"battles"`
+	Empires      []EmpireSummary `json:"empires"`
+}
+
+// EmpireSummary describes an empire for operators
+type EmpireSummary struct {
+	ID        uuid.UUID           `json:"id"`
+	PlayerID  uuid.UUID           `json:"playerId"`
+	Name      string              `json:"name"`
+	Systems   int                 `json:"systems"`
+	Colonies  int                 `json:"colonies"`
+	Fleets    int                 `json:"fleets"`
+	Ships     int                 `json:"ships"`
+	Resources types.ResourceState `json:"resources"`
+}
+
+// SessionDetails is everything operators can inspect about a session
+type SessionDetails struct {
+	Session SessionSummary  `json:"session"`
+	Players []PlayerSummary `json:"players"`
+	World   *WorldSummary   `json:"world,omitempty"` // nil until the game has started
+}
+
+// hasWorld reports whether the session has a world to inspect
+func (s GameSessionState) hasWorld() bool {
+	return s == StateActive || s == StatePaused || s == StateEnded
+}
+
+// summary describes the session for operators
+func (s *GameSession) summary() SessionSummary {
+	s.mu.RLock()
+	summary := SessionSummary{
+		ID:          s.ID,
+		InviteCode:  s.InviteCode,
+		State:       s.State,
+		PauseReason: s.pauseReason,
+		HostID:      s.HostID,
+		CreatedAt:   s.CreatedAt,
+		Players:     len(s.players),
+		Banned:      len(s.banned),
+		TickRateMs:  s.engine.TickRate().Milliseconds(),
+	}
+	if !s.EndedAt.IsZero() {
+		endedAt := s.EndedAt
+		summary.EndedAt = &endedAt
+	}
+	for _, player := range s.players {
+		if player.IsActive {
+			summary.Connected++
+		}
+	}
+	s.mu.RUnlock()
+
+	if summary.State.hasWorld() {
+		s.world.AcquireLock()
+		summary.Turn = s.world.Turn
+		s.world.ReleaseLock()
+	}
+	return summary
+}
+
+// details describes the session, its players and its world for operators
+func (s *GameSession) details() *SessionDetails {
+	details := &SessionDetails{Session: s.summary()}
+
+	players := s.playersInJoinOrder()
+	s.mu.RLock()
+	for _, player := range players {
+		details.Players = append(details.Players, PlayerSummary{
+			ID:          player.User.ID,
+			DisplayName: player.User.DisplayName,
+			EmpireID:    player.EmpireID,
+			Color:       player.Color,
+			IsHost:      player.User.ID == s.HostID,
+			Ready:       player.Ready,
+			Connected:   player.IsActive,
+			JoinedAt:    player.JoinedAt,
+			LastSeen:    player.LastSeen,
+		})
+	}
+	s.mu.RUnlock()
+
+	if details.Session.State.hasWorld() {
+		details.World = s.worldSummary()
+	}
+	return details
+}
+
+// worldSummary counts what the world of the game holds
+func (s *GameSession) worldSummary() *WorldSummary {
+	s.world.AcquireLock()
+	defer s.world.ReleaseLock()
+
+	summary := &WorldSummary{
+		Turn:         s.world.Turn,
+		Date:         s.world.Clock.Date().String(),
+		Paused:       s.world.Clock.Paused,
+		YearsPerHour: s.world.Clock.YearsPerHour,
+		Systems:      len(s.world.Galaxy.Systems),
+		Colonies:     len(s.world.Colonies),
+		Battles:      len(s.world.Battles),
+		Empires:      make([]EmpireSummary, 0, len(s.world.Empires)),
+	}
+
+	for _, empire := range s.world.SortedEmpires() {
+		ships := 0
+		for _, fleet := range empire.TotalFleets {
+			for _, count := range fleet.Ships {
+				ships += count
+			}
+		}
+
+		summary.Empires = append(summary.Empires, EmpireSummary{
+			ID:        empire.ID,
+			PlayerID:  empire.PlayerID,
+			Name:      empire.Name,
+			Systems:   len(empire.Systems),
+			Colonies:  len(empire.Colonies),
+			Fleets:    len(empire.TotalFleets),
+			Ships:     ships,
+			Resources: empire.Resources,
+		})
+	}
+	return summary
+}
+
+// end stops the game for good. Players stay in the session until it is
+// cleaned up. A game whose galaxy is being generated cannot be ended.
+func (s *GameSession) end() error {
+	s.mu.Lock()
+	if s.State == StateStarting {
+		s.mu.Unlock()
+		return ErrInvalidStateTransition
+	}
+	s.State = StateEnded
+	s.EndedAt = time.Now()
+	s.pauseReason = ""
+	clear(s.votes)
+	s.mu.Unlock()
+
+	s.engine.Stop()
+	s.broadcastLobbyState()
+	return nil
+}
+
+// kick removes a player from the session and closes their connection. A
+// banned player may not join the session again. In a running game the empire
+// of the player stays in the galaxy. If the host is removed, the player who
+// joined first after them becomes the host.
+func (s *GameSession) kick(playerID uuid.UUID, ban bool) error {
+	reason := ErrPlayerKicked
+	if ban {
+		reason = ErrPlayerBanned
+	}
+
+	s.mu.Lock()
+	if _, exists := s.players[playerID]; !exists {
+		s.mu.Unlock()
+		return ErrPlayerNotInSession
+	}
+	client := s.clients[playerID]
+	wasHost := s.HostID == playerID
+	delete(s.players, playerID)
+	delete(s.clients, playerID)
+	delete(s.votes, playerID)
+	if ban {
+		s.banned[playerID] = true
+	}
+	s.mu.Unlock()
+
+	if wasHost {
+		if players := s.playersInJoinOrder(); len(players) > 0 {
+			s.mu.Lock()
+			s.HostID = players[0].User.ID
+			s.mu.Unlock()
+		}
+	}
+
+	if client != nil {
+		// Queued before the connection closes so that the player learns why
+		if err := client.SendMessage(errorMessage(reason)); err != nil {
+			log.Printf("Failed to notify player %s of their removal: %v", playerID, err)
+		}
+		client.Disconnect()
+	}
+	s.broadcastLobbyState()
+
+	log.Printf("Removed player %s from session %s (banned: %t)", playerID, s.ID, ban)
+	return nil
+}
+
+// expired reports whether the session can be cleaned up: a game an hour after
+// it ended, or a lobby a day after it was created
+func (s *GameSession) expired(now time.Time) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	switch s.State {
+	case StateEnded:
+		return now.Sub(s.EndedAt) > time.Hour
+	case StateWaiting:
+		return now.Sub(s.CreatedAt) > 24*time.Hour
+	}
+	return false
+}
+
+// isBanned reports whether a player is banned from the session
+func (s *GameSession) isBanned(playerID uuid.UUID) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.banned[playerID]
+}
+
+// adminControl pauses or resumes the game on behalf of an operator
+func (s *GameSession) adminControl(action controlAction) error {
+	s.mu.Lock()
+	if s.State != StateActive && s.State != StatePaused {
+		s.mu.Unlock()
+		return ErrGameNotActive
+	}
+	if action == actionResume && s.maintenance {
+		s.mu.Unlock()
+		return ErrMaintenance
+	}
+	clear(s.votes)
+	s.mu.Unlock()
+
+	return s.applyControl(&controlVote{action: action}, PauseReasonAdmin)
+}
+
+// sortSummaries orders sessions from the oldest to the newest
+func sortSummaries(summaries []SessionSummary) {
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].CreatedAt.Before(summaries[j].CreatedAt)
+	})
+}
@@ -0,0 +1,193 @@
+package session
+
+import (
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/pkg/messages"
+)
+
+// Reasons a game is paused, sent to clients with the turn update
+const (
+	PauseReasonHost        = "host"
+	PauseReasonVote        = "vote"
+	PauseReasonMaintenance = "maintenance"
+	PauseReasonAdmin       = "admin"
+)
+
+// VoteDuration is how long a vote to pause, resume or change the speed counts
+const VoteDuration = time.Minute
+
+// controlAction is a change of the game clock
+type controlAction string
+
+const (
+	actionPause  controlAction = "pause"
+	actionResume controlAction = "resume"
+	actionSpeed  controlAction = "speed"
+)
+
+// controlVote is a player's vote for a change of the game clock
+type controlVote struct {
+	action       controlAction
+	yearsPerHour float64 // only for speed changes
+	castAt       time.Time
+}
+
+// sameProposal reports whether two votes are for the same change
+func (v *controlVote) sameProposal(other *controlVote) bool {
+	return v.action == other.action && v.yearsPerHour == other.yearsPerHour
+}
+
+// handleControlCommand pauses, resumes or changes the speed of the game. The
+// host's commands take effect right away. If voting is enabled, commands of
+// other players are votes and take effect once a majority of the connected
+// players voted for the same change.
+func (s *GameSession) handleControlCommand(playerID uuid.UUID, cmd *messages.GameControlCommand) error {
+	vote := &controlVote{castAt: time.Now()}
+	switch {
+	case cmd.GetPause() != nil:
+		vote.action = actionPause
+	case cmd.GetResume() != nil:
+		vote.action = actionResume
+	case cmd.GetSetSpeed() != nil:
+		vote.action = actionSpeed
+		vote.yearsPerHour = cmd.GetSetSpeed().GetYearsPerHour()
+		if vote.yearsPerHour < MinYearsPerHour || vote.yearsPerHour > MaxYearsPerHour {
+			return ErrInvalidGameSpeed
+		}
+	default:
+		return ErrInvalidCommand
+	}
+
+	s.mu.Lock()
+	if s.State != StateActive && s.State != StatePaused {
+		s.mu.Unlock()
+		return ErrGameNotActive
+	}
+	if vote.action == actionResume && s.maintenance {
+		s.mu.Unlock()
+		return ErrMaintenance
+	}
+
+	if playerID == s.HostID {
+		clear(s.votes)
+		s.mu.Unlock()
+		return s.applyControl(vote, PauseReasonHost)
+	}
+
+	if !s.settings.GetControlVoting() {
+		s.mu.Unlock()
+		return ErrNotHost
+	}
+
+	s.votes[playerID] = vote
+	votes, required := s.countVotes(vote)
+	passed := votes >= required
+	if passed {
+		clear(s.votes)
+	}
+	s.mu.Unlock()
+
+	s.broadcastGameMessage(&messages.GameMessage{
+		Content: &messages.GameMessage_ControlVote{
+			ControlVote: &messages.ControlVoteMessage{
+				Action:       string(vote.action),
+				YearsPerHour: vote.yearsPerHour,
+				Votes:        int32(votes),
+				Required:     int32(required),
+				PlayerId:     playerID.String(),
+			},
+		},
+	})
+
+	if !passed {
+		return nil
+	}
+	return s.applyControl(vote, PauseReasonVote)
+}
+
+// countVotes returns the number of recent votes of connected players for the
+// same change as vote, and the number needed for a majority. Must be called
+// with the session lock held.
+func (s *GameSession) countVotes(vote *controlVote) (votes, required int) {
+	connected := 0
+	for playerID, player := range s.players {
+		if !player.IsActive {
+			continue
+		}
+		connected++
+
+		other, exists := s.votes[playerID]
+		if exists && other.sameProposal(vote) && time.Since(other.castAt) < VoteDuration {
+			votes++
+		}
+	}
+	return votes, connected/2 + 1
+}
+
+// applyControl passes a change of the game clock on to the engine, which
+// notifies the clients once it is applied
+func (s *GameSession) applyControl(vote *controlVote, reason string) error {
+	var err error
+	switch vote.action {
+	case actionPause:
+		err = s.engine.Pause(reason)
+	case actionResume:
+		err = s.engine.Resume()
+	case actionSpeed:
+		err = s.engine.SetSpeed(vote.yearsPerHour)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	switch {
+	case vote.action == actionPause && s.State == StateActive:
+		s.State = StatePaused
+		s.pauseReason = reason
+	case vote.action == actionResume && s.State == StatePaused:
+		s.State = StateActive
+		s.pauseReason = ""
+	}
+	s.mu.Unlock()
+
+	return nil
+}
+
+// setMaintenance pauses a running game when the server enters maintenance.
+// When maintenance ends, only games paused for it are resumed.
+func (s *GameSession) setMaintenance(maintenance bool) {
+	s.mu.Lock()
+	s.maintenance = maintenance
+	state, reason := s.State, s.pauseReason
+	s.mu.Unlock()
+
+	var err error
+	switch {
+	case maintenance && state == StateActive:
+		err = s.applyControl(&controlVote{action: actionPause}, PauseReasonMaintenance)
+	case !maintenance && state == StatePaused && reason == PauseReasonMaintenance:
+		err = s.applyControl(&controlVote{action: actionResume}, "")
+	}
+	if err != nil {
+		log.Printf("Failed to change the clock of session %s for maintenance: %v", s.ID, err)
+	}
+}
+
+// broadcastGameMessage sends a game message outside the update stream to
+// every connected client
+func (s *GameSession) broadcastGameMessage(gameMsg *messages.GameMessage) {
+	msg := &messages.ServerMessage{
+		Timestamp: time.Now().UnixMilli(),
+		Message: &messages.ServerMessage_GameMessage{
+			GameMessage: gameMsg,
+		},
+	}
+	for _, client := range s.connectedClients() {
+		go client.SendMessage(msg)
+	}
+}
@@ -1,6 +1,11 @@
 package session
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/gr4vediggr/stellarlight/internal/chat"
+	"github.com/gr4vediggr/stellarlight/internal/game/engine"
+)
 
 // Session errors
 var (
@@ -12,4 +17,55 @@ var (
 	ErrSessionFull            = errors.New("session is full")
 	ErrInvalidCommand         = errors.New("invalid command")
 	ErrInvalidPlayerID        = errors.New("invalid player ID")
+	ErrGameNotActive          = errors.New("game is not active")
+	ErrNotHost                = errors.New("only the host can do this")
+	ErrPlayersNotReady        = errors.New("not all players are ready")
+	ErrNotEnoughStarSystems   = errors.New("not enough star systems for all players")
+	ErrGalaxyGenerationFailed = errors.New("galaxy generation failed")
+	ErrInvalidSettings        = errors.New("invalid lobby settings")
+	ErrChatUnavailable        = errors.New("chat is not available")
+	ErrMaintenance            = errors.New("server is in maintenance")
+	ErrPlayerKicked           = errors.New("removed from the session by an admin")
+	ErrPlayerBanned           = errors.New("banned from the session")
+	ErrInvalidGameSpeed       = engine.ErrInvalidGameSpeed
 )
+
+// errorCodes maps errors to the codes sent to clients in an ErrorMessage
+var errorCodes = []struct {
+	err  error
+	code string
+}{
+	{ErrPlayerNotInSession, "PLAYER_NOT_IN_SESSION"},
+	{ErrPlayerNotActive, "PLAYER_NOT_ACTIVE"},
+	{ErrInvalidStateTransition, "INVALID_STATE"},
+	{ErrSessionFull, "LOBBY_FULL"},
+	{ErrInvalidCommand, "INVALID_COMMAND"},
+	{ErrGameNotActive, "GAME_NOT_ACTIVE"},
+	{ErrNotHost, "NOT_HOST"},
+	{ErrPlayersNotReady, "PLAYERS_NOT_READY"},
+	{ErrNotEnoughStarSystems, "NOT_ENOUGH_STAR_SYSTEMS"},
+	{ErrGalaxyGenerationFailed, "GALAXY_GENERATION_FAILED"},
+	{ErrInvalidSettings, "INVALID_SETTINGS"},
+	{ErrChatUnavailable, "CHAT_UNAVAILABLE"},
+	{ErrMaintenance, "MAINTENANCE"},
+	{ErrPlayerKicked, "PLAYER_KICKED"},
+	{ErrPlayerBanned, "PLAYER_BANNED"},
+	{ErrInvalidGameSpeed, "INVALID_GAME_SPEED"},
+	{chat.ErrMessageEmpty, "CHAT_MESSAGE_EMPTY"},
+	{chat.ErrMessageTooLong, "CHAT_MESSAGE_TOO_LONG"},
+	{chat.ErrRateLimited, "CHAT_RATE_LIMITED"},
+	{chat.ErrRecipientNotFound, "CHAT_RECIPIENT_NOT_FOUND"},
+	{chat.ErrInvalidScope, "INVALID_COMMAND"},
+	{engine.ErrEngineNotRunning, "GAME_NOT_RUNNING"},
+	{engine.ErrCommandQueueFull, "COMMAND_QUEUE_FULL"},
+}
+
+// errorCode returns the client error code for err
+func errorCode(err error) string {
+	for _, ec := range errorCodes {
+		if errors.Is(err, ec.err) {
+			return ec.code
+		}
+	}
+	return "INTERNAL_ERROR"
+}
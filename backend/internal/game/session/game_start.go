@@ -0,0 +1,334 @@
+package session
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/gr4vediggr/stellarlight/internal/domain/galaxy"
+	"github.com/gr4vediggr/stellarlight/internal/game/engine"
+	"github.com/gr4vediggr/stellarlight/internal/game/journal"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/internal/gen"
+	"github.com/gr4vediggr/stellarlight/pkg/messages"
+)
+
+// HomePopulation is the population an empire's capital starts with
+const HomePopulation = 10
+
+// Loading phases reported to clients while a game is being set up
+const (
+	LoadingPhaseGalaxyGeneration = "GALAXY_GENERATION"
+	LoadingPhaseEmpireSetup      = "EMPIRE_SETUP"
+)
+
+// handleStartGame validates the start request and kicks off game setup in the background
+func (s *GameSession) handleStartGame(playerID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.HostID != playerID {
+		return ErrNotHost
+	}
+
+	if s.State != StateWaiting {
+		return ErrInvalidStateTransition
+	}
+
+	for _, player := range s.players {
+		if !player.Ready {
+			return ErrPlayersNotReady
+		}
+	}
+
+	// Record the seed so that the galaxy of this game can be generated again
+	if s.settings.Seed == 0 {
+		settings := proto.Clone(s.settings).(*messages.GalaxyGenerateSettings)
+		settings.Seed = gen.NewSeed()
+		s.settings = settings
+	}
+
+	s.State = StateStarting
+	go s.startGame(s.settings)
+
+	return nil
+}
+
+// startGame generates the galaxy, sets up the empires and starts the engine
+func (s *GameSession) startGame(settings *messages.GalaxyGenerateSettings) {
+	config := configFromSettings(settings)
+
+	s.broadcastLobbyMessage(&messages.LobbyMessage{
+		Content: &messages.LobbyMessage_GameStarting{
+			GameStarting: &messages.GameStartingMessage{
+				FinalSettings: settings,
+				StartTime:     time.Now().UnixMilli(),
+			},
+		},
+	})
+
+	s.broadcastLoadingProgress(0, "Generating galaxy...", LoadingPhaseGalaxyGeneration)
+
+	builder := gen.NewGalaxyBuilder(s.assets.StarTypes, s.assets.PlanetTypes)
+	g, err := builder.GenerateGalaxy(config)
+	if err != nil {
+		s.abortGameStart(fmt.Errorf("%w: %v", ErrGalaxyGenerationFailed, err))
+		return
+	}
+
+	s.broadcastLoadingProgress(0.5, "Building star systems...", LoadingPhaseGalaxyGeneration)
+	s.buildWorld(g, settings.Seed, types.NewGameClock(settings.YearsPerHour))
+
+	s.broadcastLoadingProgress(0.75, "Creating empires...", LoadingPhaseEmpireSetup)
+	if err := s.setupEmpires(g); err != nil {
+		s.abortGameStart(err)
+		return
+	}
+
+	s.broadcastLoadingProgress(1, "Ready", LoadingPhaseEmpireSetup)
+	s.recordStart(settings)
+
+	s.mu.Lock()
+	s.State = StateActive
+	maintenance := s.maintenance
+	s.mu.Unlock()
+
+	s.engine.StartGame()
+	if maintenance {
+		s.setMaintenance(true)
+	}
+	s.broadcastLobbyState()
+
+	log.Printf("Game started for session %s with %d star systems", s.ID, len(g.StarSystems))
+}
+
+// abortGameStart returns the session to the lobby after a failed start
+func (s *GameSession) abortGameStart(err error) {
+	log.Printf("Failed to start game for session %s: %v", s.ID, err)
+
+	s.mu.Lock()
+	s.State = StateWaiting
+	hostID := s.HostID
+	s.mu.Unlock()
+
+	// Discard any partially built world; the engine keeps its pointer to it
+	s.world.AcquireLock()
+	s.world.Galaxy = types.NewGalaxyState()
+	s.world.Empires = make(map[uuid.UUID]*types.EmpireState)
+	s.world.Colonies = make(map[uuid.UUID]*types.Colony)
+	s.world.IDs = types.NewIDRegistry()
+	s.world.ReleaseLock()
+
+	s.sendErrorToClient(hostID, err)
+	s.broadcastLobbyState()
+}
+
+// recordStart writes everything needed to set up the game again to the journal
+func (s *GameSession) recordStart(settings *messages.GalaxyGenerateSettings) {
+	if s.journal == nil {
+		return
+	}
+
+	joined := s.playersInJoinOrder()
+	players := make([]journal.Player, 0, len(joined))
+	for _, player := range joined {
+		players = append(players, journal.Player{
+			ID:       player.User.ID,
+			EmpireID: player.EmpireID,
+			Name:     player.User.DisplayName,
+			Color:    player.Color,
+		})
+	}
+
+	entry, err := journal.NewStartEntry(settings, players, engine.DefaultTickRate)
+	if err != nil {
+		log.Printf("Failed to journal the start of session %s: %v", s.ID, err)
+		return
+	}
+	s.journal.Record(entry)
+}
+
+// buildWorld replaces the world galaxy with the generated galaxy and starts
+// the game calendar. The IDs of everything created during the game are
+// derived from the seed.
+func (s *GameSession) buildWorld(g *galaxy.Galaxy, seed int64, clock types.GameClock) {
+	galaxyState := types.NewGalaxyStateFromGalaxy(g)
+
+	// Number the systems in a stable order for the protobuf references
+	systems := make([]*types.StarSystemState, 0, len(galaxyState.Systems))
+	for _, system := range galaxyState.Systems {
+		systems = append(systems, system)
+	}
+	sort.Slice(systems, func(i, j int) bool {
+		return systems[i].ID.String() < systems[j].ID.String()
+	})
+
+	s.world.AcquireLock()
+	defer s.world.ReleaseLock()
+
+	s.world.IDs.Seed(seed)
+	for _, system := range systems {
+		system.Ref = s.world.IDs.Register(system.ID)
+		for _, planet := range system.Planets {
+			planet.Ref = s.world.IDs.Register(planet.ID)
+		}
+	}
+	s.world.Galaxy = galaxyState
+	s.world.Clock = clock
+	s.world.Turn = 0
+}
+
+// setupEmpires creates an empire with a home system for every player in the session
+func (s *GameSession) setupEmpires(g *galaxy.Galaxy) error {
+	// Assign home systems in join order
+	players := s.playersInJoinOrder()
+
+	homes, err := selectHomeSystems(g, len(players))
+	if err != nil {
+		return err
+	}
+
+	s.world.AcquireLock()
+	defer s.world.ReleaseLock()
+
+	for i, player := range players {
+		home, exists := s.world.Galaxy.GetSystem(homes[i])
+		if !exists {
+			return ErrNotEnoughStarSystems
+		}
+
+		empire := types.NewEmpireState(player.User.ID, player.User.DisplayName)
+		empire.ID = player.EmpireID
+		empire.Color = player.Color
+		empire.HomeSystem = home.ID
+		s.world.ClaimSystem(empire, home)
+		s.foundHomeColony(empire, home)
+
+		s.world.Empires[player.User.ID] = empire
+	}
+
+	return nil
+}
+
+// playersInJoinOrder returns the players of the session, first to join first
+func (s *GameSession) playersInJoinOrder() []*types.Player {
+	s.mu.RLock()
+	players := make([]*types.Player, 0, len(s.players))
+	for _, player := range s.players {
+		players = append(players, player)
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(players, func(i, j int) bool {
+		return players[i].JoinedAt.Before(players[j].JoinedAt)
+	})
+	return players
+}
+
+// foundHomeColony settles the planet of the home system that can hold the
+// largest population. Must be called with the world lock held.
+func (s *GameSession) foundHomeColony(empire *types.EmpireState, home *types.StarSystemState) {
+	var capital *types.PlanetState
+	var capitalHabitability float64
+	for _, planet := range home.Planets {
+		habitability := 0.0
+		if pt, exists := s.assets.PlanetTypeByName(planet.Type); exists {
+			habitability = pt.Habitability
+		}
+
+		if capital == nil || types.ColonyCapacity(planet.Size, habitability) > types.ColonyCapacity(capital.Size, capitalHabitability) {
+			capital, capitalHabitability = planet, habitability
+		}
+	}
+
+	if capital == nil {
+		// Home systems without planets only happen in tiny galaxies
+		log.Printf("Home system %s of empire %s has no planet to settle", home.ID, empire.ID)
+		return
+	}
+
+	// Home worlds always support at least their starting population
+	s.world.FoundColony(empire, home, capital, capitalHabitability, HomePopulation)
+}
+
+// selectHomeSystems picks count star systems that are spread out as far as possible.
+// Systems with planets are preferred.
+func selectHomeSystems(g *galaxy.Galaxy, count int) ([]uuid.UUID, error) {
+	candidates := make([]*galaxy.StarSystem, 0, len(g.StarSystems))
+	for _, system := range g.StarSystems {
+		if hasPlanets(system) {
+			candidates = append(candidates, system)
+		}
+	}
+	if len(candidates) < count {
+		candidates = candidates[:0]
+		for _, system := range g.StarSystems {
+			candidates = append(candidates, system)
+		}
+	}
+	if len(candidates) < count {
+		return nil, ErrNotEnoughStarSystems
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].ID.String() < candidates[j].ID.String()
+	})
+
+	// Start with the system farthest from the galactic core
+	center := &galaxy.StarSystem{}
+	first := 0
+	for i, c := range candidates {
+		if c.DistanceSquared(center) > candidates[first].DistanceSquared(center) {
+			first = i
+		}
+	}
+
+	selected := []*galaxy.StarSystem{candidates[first]}
+	for len(selected) < count {
+		best, bestDistance := -1, -1.0
+		for i, c := range candidates {
+			minDistance := -1.0
+			for _, sel := range selected {
+				d := c.DistanceSquared(sel)
+				if minDistance < 0 || d < minDistance {
+					minDistance = d
+				}
+			}
+			if minDistance > bestDistance {
+				best, bestDistance = i, minDistance
+			}
+		}
+		selected = append(selected, candidates[best])
+	}
+
+	ids := make([]uuid.UUID, len(selected))
+	for i, system := range selected {
+		ids[i] = system.ID
+	}
+	return ids, nil
+}
+
+func hasPlanets(system *galaxy.StarSystem) bool {
+	for _, star := range system.Stars {
+		if len(star.Planets) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *GameSession) broadcastLoadingProgress(progress float32, status, phase string) {
+	s.broadcastLobbyMessage(&messages.LobbyMessage{
+		Content: &messages.LobbyMessage_GameLoading{
+			GameLoading: &messages.GameLoadingMessage{
+				Progress:   progress,
+				StatusText: status,
+				Phase:      phase,
+			},
+		},
+	})
+}
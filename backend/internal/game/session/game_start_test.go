@@ -0,0 +1,127 @@
+package session_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/session"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
+	"github.com/gr4vediggr/stellarlight/internal/users"
+	"github.com/gr4vediggr/stellarlight/pkg/messages"
+)
+
+// lobbyWithGuest creates a session with a host and a guest, both connected
+func lobbyWithGuest(t *testing.T, manager *session.SessionManager) (*session.GameSession, *testClient, *testClient) {
+	t.Helper()
+
+	host := &users.User{ID: uuid.New(), DisplayName: "Host"}
+	guest := &users.User{ID: uuid.New(), DisplayName: "Guest"}
+	created, err := manager.CreateSession(host)
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+	if _, err := manager.JoinSession(guest, created.GetInviteCode()); err != nil {
+		t.Fatalf("Failed to join session: %v", err)
+	}
+
+	game := created.(*session.GameSession)
+	hostClient, guestClient := newTestClient(host.ID), newTestClient(guest.ID)
+	game.AddClient(hostClient, 0)
+	game.AddClient(guestClient, 0)
+	return game, hostClient, guestClient
+}
+
+func setReady(game *session.GameSession, playerID uuid.UUID) {
+	lobbyCommand(game, playerID, &messages.LobbyCommand{Action: &messages.LobbyCommand_SetReady{SetReady: &messages.SetReadyCommand{Ready: true}}})
+}
+
+func startGame(game *session.GameSession, playerID uuid.UUID) {
+	lobbyCommand(game, playerID, &messages.LobbyCommand{Action: &messages.LobbyCommand_StartGame{StartGame: &messages.StartGameCommand{}}})
+}
+
+// expectError waits for an error with the code to reach the client
+func expectError(t *testing.T, client *testClient, code string) {
+	t.Helper()
+	reply := client.await(func(msg *messages.ServerMessage) bool { return msg.GetErrorMessage() != nil })
+	if reply.GetErrorMessage().GetErrorCode() != code {
+		t.Errorf("Expected %s, got %v", code, reply)
+	}
+}
+
+func expectState(t *testing.T, manager *session.SessionManager, sessionID uuid.UUID, state session.GameSessionState) {
+	t.Helper()
+	details, err := manager.InspectSession(sessionID)
+	if err != nil {
+		t.Fatalf("Failed to inspect session: %v", err)
+	}
+	if details.Session.State != state {
+		t.Errorf("Expected session to be %s, got %s", state, details.Session.State)
+	}
+}
+
+func TestOnlyHostCanStartGame(t *testing.T) {
+	manager := session.NewSessionManager(&resource.Assets{}, nil, nil)
+	game, _, guestClient := lobbyWithGuest(t, manager)
+
+	setReady(game, game.HostID)
+	setReady(game, guestClient.userID)
+	startGame(game, guestClient.userID)
+
+	expectError(t, guestClient, "NOT_HOST")
+	expectState(t, manager, game.ID, session.StateWaiting)
+}
+
+func TestGameStartWaitsForReadyPlayers(t *testing.T) {
+	manager := session.NewSessionManager(&resource.Assets{}, nil, nil)
+	game, hostClient, _ := lobbyWithGuest(t, manager)
+
+	setReady(game, game.HostID)
+	startGame(game, game.HostID)
+
+	expectError(t, hostClient, "PLAYERS_NOT_READY")
+	expectState(t, manager, game.ID, session.StateWaiting)
+}
+
+func TestAbortedGameStartReturnsToLobby(t *testing.T) {
+	assets, err := resource.LoadAssetsFromDirs([]string{"../../../assets/"})
+	if err != nil {
+		t.Fatalf("Failed to load assets: %v", err)
+	}
+	manager := session.NewSessionManager(assets, nil, nil)
+	game, hostClient, guestClient := lobbyWithGuest(t, manager)
+
+	// More players than star systems, so setting up the empires fails after
+	// the galaxy was built
+	lobbyCommand(game, game.HostID, &messages.LobbyCommand{Action: &messages.LobbyCommand_UpdateSettings{
+		UpdateSettings: &messages.UpdateSettingsCommand{Settings: &messages.GalaxyGenerateSettings{
+			NumStars: session.MinStarSystems, Shape: "spiral", MaxHyperlanes: 5, HyperlaneConnectivity: 50, Seed: 1,
+		}},
+	}})
+	for i := 0; i < session.MinStarSystems; i++ {
+		player := &users.User{ID: uuid.New(), DisplayName: fmt.Sprintf("Player %d", i)}
+		if err := game.AddPlayer(player); err != nil {
+			t.Fatalf("Failed to add player: %v", err)
+		}
+		setReady(game, player.ID)
+	}
+	setReady(game, game.HostID)
+	setReady(game, guestClient.userID)
+	startGame(game, game.HostID)
+
+	expectError(t, hostClient, "NOT_ENOUGH_STAR_SYSTEMS")
+	expectState(t, manager, game.ID, session.StateWaiting)
+
+	// Ending the session exposes its world, which must be empty again
+	if err := manager.EndSession(game.ID); err != nil {
+		t.Fatalf("Failed to end session: %v", err)
+	}
+	details, err := manager.InspectSession(game.ID)
+	if err != nil {
+		t.Fatalf("Failed to inspect session: %v", err)
+	}
+	if world := details.World; world == nil || world.Systems != 0 || len(world.Empires) != 0 || world.Colonies != 0 {
+		t.Errorf("Expected the partly built world to be discarded, got %+v", world)
+	}
+}
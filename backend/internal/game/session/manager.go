@@ -1,11 +1,19 @@
 package session
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/gr4vediggr/stellarlight/internal/chat"
+	"github.com/gr4vediggr/stellarlight/internal/game/journal"
 	"github.com/gr4vediggr/stellarlight/internal/interfaces"
+	"github.com/gr4vediggr/stellarlight/internal/notifications"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
 	"github.com/gr4vediggr/stellarlight/internal/users"
 )
 
@@ -14,18 +22,111 @@ type SessionManager struct {
 	sessions       map[uuid.UUID]*GameSession // sessionID -> session
 	playerSessions map[uuid.UUID]uuid.UUID    // playerID -> sessionID
 	inviteCodes    map[string]uuid.UUID       // inviteCode -> sessionID
+	assets         *resource.Assets           // game assets shared by all sessions
+	notifier       notifications.Notifier     // inbox for players who are offline, may be nil
+	chat           *chat.ChatService          // chat shared by all sessions, may be nil
+	store          SessionRepository          // checkpoints of all sessions, may be nil
+	journal        journal.Journal            // commands of all sessions for replays, may be nil
+	removed        []uuid.UUID                // sessions to delete from the store at the next checkpoint
+	maintenance    bool                       // running games are paused
 	mu             sync.RWMutex
 }
 
-// NewSessionManager creates a new session manager
-func NewSessionManager() *SessionManager {
+// NewSessionManager creates a new session manager. Sessions are only kept in
+// memory if store is nil.
+func NewSessionManager(assets *resource.Assets, notifier notifications.Notifier, store SessionRepository) *SessionManager {
 	return &SessionManager{
+		assets:         assets,
+		notifier:       notifier,
+		store:          store,
 		sessions:       make(map[uuid.UUID]*GameSession),
 		playerSessions: make(map[uuid.UUID]uuid.UUID),
 		inviteCodes:    make(map[string]uuid.UUID),
 	}
 }
 
+// SetChatService sets the chat service used by sessions created from now on.
+// The chat service finds recipients through the session manager, so it is
+// set once both exist.
+func (sm *SessionManager) SetChatService(chatService *chat.ChatService) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.chat = chatService
+}
+
+// SetJournal sets the journal the commands of sessions created or restored
+// from now on are written to
+func (sm *SessionManager) SetJournal(j journal.Journal) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.journal = j
+}
+
+// LoadSessions restores the sessions stored at the last checkpoint and
+// resumes their games. Must be called before players connect.
+func (sm *SessionManager) LoadSessions(ctx context.Context) error {
+	if sm.store == nil {
+		return nil
+	}
+
+	records, err := sm.store.LoadSessions(ctx)
+	if err != nil {
+		return err
+	}
+
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	for _, record := range records {
+		session := restoreGameSession(record, sm.assets, sm.notifier, sm.chat, sm.journal)
+
+		sm.sessions[session.ID] = session
+		sm.inviteCodes[session.InviteCode] = session.ID
+		for playerID := range session.players {
+			sm.playerSessions[playerID] = session.ID
+		}
+	}
+
+	log.Printf("Restored %d sessions", len(records))
+	return nil
+}
+
+// Checkpoint stores the current state of every session and deletes the
+// sessions cleaned up since the last checkpoint
+func (sm *SessionManager) Checkpoint(ctx context.Context) error {
+	if sm.store == nil {
+		return nil
+	}
+
+	sm.mu.Lock()
+	sessions := make([]*GameSession, 0, len(sm.sessions))
+	for _, session := range sm.sessions {
+		sessions = append(sessions, session)
+	}
+	removed := sm.removed
+	sm.removed = nil
+	sm.mu.Unlock()
+
+	var errs []error
+	for _, sessionID := range removed {
+		if err := sm.store.DeleteSession(ctx, sessionID); err != nil {
+			errs = append(errs, fmt.Errorf("delete session %s: %w", sessionID, err))
+		}
+	}
+
+	for _, session := range sessions {
+		record, err := session.checkpoint()
+		if err == nil {
+			err = sm.store.SaveSession(ctx, record)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("save session %s: %w", session.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 // CreateSession creates a new game session
 func (sm *SessionManager) CreateSession(creator *users.User) (interfaces.GameSessionInterface, error) {
 	sm.mu.Lock()
@@ -44,7 +145,9 @@ func (sm *SessionManager) CreateSession(creator *users.User) (interfaces.GameSes
 	}
 
 	// Create new session
-	session := NewGameSession(creator)
+	session := NewGameSession(creator, sm.assets, sm.notifier, sm.chat)
+	session.maintenance = sm.maintenance
+	session.setJournal(sm.journal)
 
 	// Register session
 	sm.sessions[session.ID] = session
@@ -84,6 +187,9 @@ func (sm *SessionManager) JoinSession(player *users.User, inviteCode string) (in
 	if session.State != StateWaiting {
 		return nil, ErrInvalidStateTransition
 	}
+	if session.isBanned(player.ID) {
+		return nil, ErrPlayerBanned
+	}
 
 	// Add player to session
 	if err := session.AddPlayer(player); err != nil {
@@ -116,6 +222,43 @@ func (sm *SessionManager) GetPlayerSession(playerID uuid.UUID) (interfaces.GameS
 	return session, nil
 }
 
+// GetClient returns the connected client of a player in any session
+// (implements interfaces.ClientRegistry)
+func (sm *SessionManager) GetClient(playerID uuid.UUID) (interfaces.GameClientInterface, bool) {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	session, exists := sm.sessions[sm.playerSessions[playerID]]
+	if !exists {
+		return nil, false
+	}
+	return session.GetClient(playerID)
+}
+
+// GetSessionClients returns the connected clients of a session
+func (sm *SessionManager) GetSessionClients(sessionID uuid.UUID) []interfaces.GameClientInterface {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	session, exists := sm.sessions[sessionID]
+	if !exists {
+		return nil
+	}
+	return session.connectedClients()
+}
+
+// GetConnectedClients returns the connected clients of all sessions
+func (sm *SessionManager) GetConnectedClients() []interfaces.GameClientInterface {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	var clients []interfaces.GameClientInterface
+	for _, session := range sm.sessions {
+		clients = append(clients, session.connectedClients()...)
+	}
+	return clients
+}
+
 // GetSession returns a session by ID
 func (sm *SessionManager) GetSession(sessionID uuid.UUID) (interfaces.GameSessionInterface, error) {
 	sm.mu.RLock()
@@ -179,21 +322,51 @@ func (sm *SessionManager) LeaveSession(playerID uuid.UUID) error {
 	return nil
 }
 
-// CleanupExpiredSessions removes old sessions
-func (sm *SessionManager) CleanupExpiredSessions() {
+// EnterMaintenance pauses every running game, so that no game goes on while
+// players may be unable to connect. Games stay paused until ExitMaintenance,
+// or until they are resumed after a restart.
+func (sm *SessionManager) EnterMaintenance() {
+	sm.setMaintenance(true)
+	log.Printf("Entered maintenance, running games are paused")
+}
+
+// ExitMaintenance resumes the games paused by EnterMaintenance
+func (sm *SessionManager) ExitMaintenance() {
+	sm.setMaintenance(false)
+	log.Printf("Left maintenance, games are resumed")
+}
+
+// InMaintenance reports whether the server is in maintenance
+func (sm *SessionManager) InMaintenance() bool {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+	return sm.maintenance
+}
+
+func (sm *SessionManager) setMaintenance(maintenance bool) {
+	sm.mu.Lock()
+	sm.maintenance = maintenance
+	sessions := make([]*GameSession, 0, len(sm.sessions))
+	for _, session := range sm.sessions {
+		sessions = append(sessions, session)
+	}
+	sm.mu.Unlock()
+
+	for _, session := range sessions {
+		session.setMaintenance(maintenance)
+	}
+}
+
+// CleanupExpiredSessions removes old sessions and returns how many were removed
+func (sm *SessionManager) CleanupExpiredSessions() int {
 	sm.mu.Lock()
 	defer sm.mu.Unlock()
 
 	expiredSessions := make([]uuid.UUID, 0)
+	now := time.Now()
 
 	for sessionID, session := range sm.sessions {
-		// Clean up sessions that have been ended for more than 1 hour
-		if session.State == StateEnded && time.Since(session.CreatedAt) > time.Hour {
-			expiredSessions = append(expiredSessions, sessionID)
-		}
-
-		// Clean up waiting sessions older than 24 hours
-		if session.State == StateWaiting && time.Since(session.CreatedAt) > 24*time.Hour {
+		if session.expired(now) {
 			expiredSessions = append(expiredSessions, sessionID)
 		}
 	}
@@ -201,6 +374,7 @@ func (sm *SessionManager) CleanupExpiredSessions() {
 	for _, sessionID := range expiredSessions {
 		sm.cleanupSession(sessionID)
 	}
+	return len(expiredSessions)
 }
 
 // cleanupSession removes a session and all its references (must be called with lock held)
@@ -223,6 +397,10 @@ func (sm *SessionManager) cleanupSession(sessionID uuid.UUID) {
 	for playerID := range session.players {
 		delete(sm.playerSessions, playerID)
 	}
+
+	if sm.store != nil {
+		sm.removed = append(sm.removed, sessionID)
+	}
 }
 
 // GetActiveSessions returns a list of all active sessions (for admin/monitoring)
@@ -237,3 +415,96 @@ func (sm *SessionManager) GetActiveSessions() []interfaces.GameSessionInterface
 
 	return sessions
 }
+
+// ListSessions describes every session, oldest first (for admin/monitoring)
+func (sm *SessionManager) ListSessions() []SessionSummary {
+	sm.mu.RLock()
+	sessions := make([]*GameSession, 0, len(sm.sessions))
+	for _, session := range sm.sessions {
+		sessions = append(sessions, session)
+	}
+	sm.mu.RUnlock()
+
+	summaries := make([]SessionSummary, 0, len(sessions))
+	for _, session := range sessions {
+		summaries = append(summaries, session.summary())
+	}
+	sortSummaries(summaries)
+	return summaries
+}
+
+// InspectSession describes a session with its players and world (for admin/monitoring)
+func (sm *SessionManager) InspectSession(sessionID uuid.UUID) (*SessionDetails, error) {
+	session, err := sm.session(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return session.details(), nil
+}
+
+// EndSession stops the game of a session for good. The session is removed
+// by the next cleanup once it is old enough.
+func (sm *SessionManager) EndSession(sessionID uuid.UUID) error {
+	session, err := sm.session(sessionID)
+	if err != nil {
+		return err
+	}
+	return session.end()
+}
+
+// PauseSession pauses the game of a session until an admin or the host resumes it
+func (sm *SessionManager) PauseSession(sessionID uuid.UUID) error {
+	session, err := sm.session(sessionID)
+	if err != nil {
+		return err
+	}
+	return session.adminControl(actionPause)
+}
+
+// ResumeSession resumes the game of a session
+func (sm *SessionManager) ResumeSession(sessionID uuid.UUID) error {
+	session, err := sm.session(sessionID)
+	if err != nil {
+		return err
+	}
+	return session.adminControl(actionResume)
+}
+
+// KickPlayer removes a player from a session. A banned player cannot join
+// the session again. A session without players is cleaned up.
+func (sm *SessionManager) KickPlayer(sessionID, playerID uuid.UUID, ban bool) error {
+	session, err := sm.session(sessionID)
+	if err != nil {
+		return err
+	}
+	if err := session.kick(playerID, ban); err != nil {
+		return err
+	}
+
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if sm.playerSessions[playerID] == sessionID {
+		delete(sm.playerSessions, playerID)
+	}
+
+	session.mu.RLock()
+	playerCount := len(session.players)
+	session.mu.RUnlock()
+	if playerCount == 0 {
+		sm.cleanupSession(sessionID)
+	}
+	return nil
+}
+
+// session returns a session by ID
+func (sm *SessionManager) session(sessionID uuid.UUID) (*GameSession, error) {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	session, exists := sm.sessions[sessionID]
+	if !exists {
+		return nil, ErrSessionNotFound
+	}
+	return session, nil
+}
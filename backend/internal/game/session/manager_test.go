@@ -0,0 +1,138 @@
+package session_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/session"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
+	"github.com/gr4vediggr/stellarlight/internal/users"
+)
+
+// memoryStore keeps the last checkpoint of every session
+type memoryStore struct {
+	records map[uuid.UUID]*session.SessionRecord
+}
+
+func (m *memoryStore) SaveSession(ctx context.Context, record *session.SessionRecord) error {
+	m.records[record.ID] = record
+	return nil
+}
+
+func (m *memoryStore) LoadSessions(ctx context.Context) ([]*session.SessionRecord, error) {
+	records := make([]*session.SessionRecord, 0, len(m.records))
+	for _, record := range m.records {
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (m *memoryStore) DeleteSession(ctx context.Context, id uuid.UUID) error {
+	delete(m.records, id)
+	return nil
+}
+
+func TestSessionsSurviveRestart(t *testing.T) {
+	store := &memoryStore{records: make(map[uuid.UUID]*session.SessionRecord)}
+	assets := &resource.Assets{}
+	ctx := context.Background()
+
+	host := &users.User{ID: uuid.New(), DisplayName: "Host"}
+	guest := &users.User{ID: uuid.New(), DisplayName: "Guest"}
+
+	before := session.NewSessionManager(assets, nil, store)
+	created, err := before.CreateSession(host)
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+	if _, err := before.JoinSession(guest, created.GetInviteCode()); err != nil {
+		t.Fatalf("Failed to join session: %v", err)
+	}
+	if err := before.Checkpoint(ctx); err != nil {
+		t.Fatalf("Failed to checkpoint: %v", err)
+	}
+
+	after := session.NewSessionManager(assets, nil, store)
+	if err := after.LoadSessions(ctx); err != nil {
+		t.Fatalf("Failed to load sessions: %v", err)
+	}
+
+	restored, err := after.GetSessionByInviteCode(created.GetInviteCode())
+	if err != nil || restored.GetID() != created.GetID() {
+		t.Fatalf("Expected session %s to be restored, got %v", created.GetID(), err)
+	}
+	for _, user := range []*users.User{host, guest} {
+		if s, err := after.GetPlayerSession(user.ID); err != nil || s.GetID() != created.GetID() {
+			t.Errorf("Expected %s to be back in the session, got %v", user.DisplayName, err)
+		}
+	}
+
+}
+
+func TestBannedPlayerCannotRejoin(t *testing.T) {
+	store := &memoryStore{records: make(map[uuid.UUID]*session.SessionRecord)}
+	assets := &resource.Assets{}
+	ctx := context.Background()
+
+	host := &users.User{ID: uuid.New(), DisplayName: "Host"}
+	guest := &users.User{ID: uuid.New(), DisplayName: "Guest"}
+
+	before := session.NewSessionManager(assets, nil, store)
+	created, err := before.CreateSession(host)
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+	if _, err := before.JoinSession(guest, created.GetInviteCode()); err != nil {
+		t.Fatalf("Failed to join session: %v", err)
+	}
+
+	if err := before.KickPlayer(created.GetID(), guest.ID, true); err != nil {
+		t.Fatalf("Failed to ban player: %v", err)
+	}
+	if _, err := before.GetPlayerSession(guest.ID); err == nil {
+		t.Error("Expected the banned player to be out of the session")
+	}
+	if summaries := before.ListSessions(); len(summaries) != 1 || summaries[0].Players != 1 || summaries[0].Banned != 1 {
+		t.Errorf("Expected one session with the host and a banned player, got %+v", summaries)
+	}
+	if err := before.Checkpoint(ctx); err != nil {
+		t.Fatalf("Failed to checkpoint: %v", err)
+	}
+
+	// The ban survives a restart
+	after := session.NewSessionManager(assets, nil, store)
+	if err := after.LoadSessions(ctx); err != nil {
+		t.Fatalf("Failed to load sessions: %v", err)
+	}
+	if _, err := after.JoinSession(guest, created.GetInviteCode()); !errors.Is(err, session.ErrPlayerBanned) {
+		t.Errorf("Expected the banned player to be refused, got %v", err)
+	}
+}
+
+func TestEndedSessionExpiresAfterItEnded(t *testing.T) {
+	manager := session.NewSessionManager(&resource.Assets{}, nil, nil)
+
+	host := &users.User{ID: uuid.New(), DisplayName: "Host"}
+	created, err := manager.CreateSession(host)
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+	game := created.(*session.GameSession)
+	game.CreatedAt = time.Now().Add(-48 * time.Hour)
+
+	if err := manager.EndSession(game.ID); err != nil {
+		t.Fatalf("Failed to end session: %v", err)
+	}
+	if removed := manager.CleanupExpiredSessions(); removed != 0 {
+		t.Errorf("Expected a session that just ended to be kept, %d removed", removed)
+	}
+
+	game.EndedAt = time.Now().Add(-2 * time.Hour)
+	if removed := manager.CleanupExpiredSessions(); removed != 1 {
+		t.Errorf("Expected the session to expire an hour after it ended, %d removed", removed)
+	}
+}
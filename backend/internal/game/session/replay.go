@@ -0,0 +1,249 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/engine"
+	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/journal"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/internal/gen"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
+	"github.com/gr4vediggr/stellarlight/internal/users"
+)
+
+// Replay errors
+var (
+	ErrNoGameStart       = errors.New("journal has no game start")
+	ErrChecksumMismatch  = errors.New("world checksum does not match the journal")
+	ErrReplayStuck       = errors.New("game is paused and never resumed")
+	ErrJournalOutOfOrder = errors.New("journal entry is older than the replayed tick")
+	ErrJournalIncomplete = errors.New("journal lost entries needed to replay the game")
+)
+
+// ReplayResult is the world rebuilt from the journal of a session
+type ReplayResult struct {
+	World     *types.WorldState
+	Checksums int // checksums of the journal the replay matched
+}
+
+// replay simulates a game again from its journal
+type replay struct {
+	session *GameSession
+	engine  *engine.GameEngine
+	pending bool // commands or clock changes are queued for the next step
+	result  *ReplayResult
+}
+
+// Replay rebuilds the game of a session from its journal: the galaxy is
+// generated again from the seed, and the ticks are simulated again with the
+// commands and clock changes applied before the same ticks as in the game.
+// The world is compared with every checksum in the journal and the replay
+// stops at the first mismatch with ErrChecksumMismatch, returning the world
+// as it was replayed.
+//
+// If until is above zero, the replay stops once that tick is simulated.
+// Otherwise it stops at the last entry of the journal. A journal that lost
+// entries is refused with ErrJournalIncomplete.
+func Replay(entries []*journal.Entry, assets *resource.Assets, until int) (*ReplayResult, error) {
+	for _, entry := range entries {
+		if entry.Kind == journal.KindGap {
+			return nil, fmt.Errorf("%w: around tick %d", ErrJournalIncomplete, entry.Tick)
+		}
+	}
+
+	start := -1
+	for i, entry := range entries {
+		if entry.Kind == journal.KindStart {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return nil, ErrNoGameStart
+	}
+
+	r, err := newReplay(entries[start].Start, assets)
+	if err != nil {
+		return nil, err
+	}
+	defer r.engine.Stop()
+
+	for _, entry := range withoutLostTicks(entries[start+1:]) {
+		if until > 0 && (entry.Tick > until || entry.Tick == until && entry.Kind != journal.KindChecksum) {
+			break
+		}
+		if err := r.apply(entry); err != nil {
+			return r.result, err
+		}
+	}
+	if until > 0 {
+		if err := r.advance(until); err != nil {
+			return r.result, err
+		}
+	}
+
+	return r.result, nil
+}
+
+// newReplay sets up the game like at its start
+func newReplay(start *journal.Start, assets *resource.Assets) (*replay, error) {
+	settings, err := start.GalaxySettings()
+	if err != nil {
+		return nil, fmt.Errorf("decode settings: %w", err)
+	}
+
+	session := newGameSession(uuid.New(), types.NewWorldState(), assets, nil, nil)
+	session.settings = settings
+	for i, player := range start.Players {
+		session.players[player.ID] = &types.Player{
+			User:     &users.User{ID: player.ID, DisplayName: player.Name},
+			EmpireID: player.EmpireID,
+			Color:    player.Color,
+			JoinedAt: time.Unix(0, int64(i)),
+		}
+	}
+
+	builder := gen.NewGalaxyBuilder(assets.StarTypes, assets.PlanetTypes)
+	g, err := builder.GenerateGalaxy(configFromSettings(settings))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrGalaxyGenerationFailed, err)
+	}
+	session.buildWorld(g, start.Seed, types.NewGameClock(settings.YearsPerHour))
+	if err := session.setupEmpires(g); err != nil {
+		return nil, err
+	}
+
+	gameEngine := session.engine.(*engine.GameEngine)
+	gameEngine.SetTickRate(start.TickRate)
+	gameEngine.StartReplay()
+
+	return &replay{
+		session: session,
+		engine:  gameEngine,
+		result:  &ReplayResult{World: session.world},
+	}, nil
+}
+
+// withoutLostTicks drops the entries of ticks that were lost because the game
+// was restored from an older checkpoint
+func withoutLostTicks(entries []*journal.Entry) []*journal.Entry {
+	kept := make([]*journal.Entry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Kind == journal.KindRestore {
+			for len(kept) > 0 && kept[len(kept)-1].Tick > entry.Tick {
+				kept = kept[:len(kept)-1]
+			}
+		}
+		kept = append(kept, entry)
+	}
+	return kept
+}
+
+// apply replays a single entry of the journal
+func (r *replay) apply(entry *journal.Entry) error {
+	switch entry.Kind {
+	case journal.KindApplied:
+		if err := r.advance(entry.Tick); err != nil {
+			return err
+		}
+		cmd, err := entry.ClientCommand()
+		if err != nil {
+			return fmt.Errorf("decode command of tick %d: %w", entry.Tick, err)
+		}
+		if err := r.engine.ProcessGameCommand(cmd); err != nil {
+			return err
+		}
+		r.pending = true
+
+	case journal.KindClock:
+		if err := r.advance(entry.Tick); err != nil {
+			return err
+		}
+		if err := r.changeClock(entry.Clock); err != nil {
+			return err
+		}
+		r.pending = true
+
+	case journal.KindChecksum:
+		return r.verify(entry)
+
+	case journal.KindRestore:
+		if err := r.verify(entry); err != nil {
+			return err
+		}
+		// The restored engine told its systems that the game started again
+		r.engine.EventBus().Publish(&types.GameStartedEvent{
+			BaseEvent: types.BaseEvent{
+				SessionID: r.session.ID,
+				Type:      string(events.EventTypeGameStarted),
+				Timestamp: time.Now().UnixNano(),
+			},
+		})
+	}
+	return nil
+}
+
+// changeClock queues a change that brings the game clock to the state of the journal
+func (r *replay) changeClock(clock *journal.Clock) error {
+	if clock == nil {
+		return nil
+	}
+	if err := r.engine.SetSpeed(clock.YearsPerHour); err != nil {
+		return err
+	}
+	if clock.Paused {
+		return r.engine.Pause(clock.Reason)
+	}
+	return r.engine.Resume()
+}
+
+// advance simulates ticks until tick was simulated
+func (r *replay) advance(tick int) error {
+	world := r.session.world
+	if world.Turn > tick {
+		return fmt.Errorf("%w: tick %d, replayed %d", ErrJournalOutOfOrder, tick, world.Turn)
+	}
+
+	for world.Turn < tick {
+		turn := world.Turn
+		pending := r.pending
+		r.engine.Step()
+		r.pending = false
+		if world.Turn == turn && !pending {
+			return fmt.Errorf("%w: stuck at tick %d", ErrReplayStuck, turn)
+		}
+	}
+	return nil
+}
+
+// verify compares the world with a checksum of the journal. Commands given
+// while the game was paused are applied first, as the checksum was taken
+// after them.
+func (r *replay) verify(entry *journal.Entry) error {
+	if err := r.advance(entry.Tick); err != nil {
+		return err
+	}
+	if r.pending {
+		r.engine.Step()
+		r.pending = false
+	}
+
+	world := r.session.world
+	world.AcquireLock()
+	checksum, err := world.Checksum()
+	world.ReleaseLock()
+	if err != nil {
+		return err
+	}
+	if checksum != entry.Checksum {
+		return fmt.Errorf("%w at tick %d", ErrChecksumMismatch, entry.Tick)
+	}
+
+	r.result.Checksums++
+	return nil
+}
@@ -0,0 +1,115 @@
+package session_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/journal"
+	"github.com/gr4vediggr/stellarlight/internal/game/session"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
+	"github.com/gr4vediggr/stellarlight/internal/users"
+	"github.com/gr4vediggr/stellarlight/pkg/messages"
+)
+
+// memoryJournal keeps the journal entries of every session
+type memoryJournal struct {
+	entries map[uuid.UUID][]*journal.Entry
+	mu      sync.Mutex
+}
+
+func (m *memoryJournal) Append(ctx context.Context, sessionID uuid.UUID, entries []*journal.Entry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[sessionID] = append(m.entries[sessionID], entries...)
+	return nil
+}
+
+func (m *memoryJournal) Load(ctx context.Context, sessionID uuid.UUID) ([]*journal.Entry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*journal.Entry(nil), m.entries[sessionID]...), nil
+}
+
+func (m *memoryJournal) has(sessionID uuid.UUID, kind string) bool {
+	entries, _ := m.Load(context.Background(), sessionID)
+	for _, entry := range entries {
+		if entry.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func TestReplayReproducesTheWorld(t *testing.T) {
+	assets, err := resource.LoadAssetsFromDirs([]string{"../../../assets/"})
+	if err != nil {
+		t.Fatalf("Failed to load assets: %v", err)
+	}
+	store := &memoryJournal{entries: make(map[uuid.UUID][]*journal.Entry)}
+
+	manager := session.NewSessionManager(assets, nil, nil)
+	manager.SetJournal(store)
+
+	host := &users.User{ID: uuid.New(), DisplayName: "Host"}
+	created, err := manager.CreateSession(host)
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+	game := created.(*session.GameSession)
+
+	send := func(cmd *messages.ClientCommand) {
+		game.ProcessCommand(&events.ClientCommandWrapper{PlayerID: host.ID, Command: cmd})
+	}
+	lobby := func(cmd *messages.LobbyCommand) {
+		send(&messages.ClientCommand{Command: &messages.ClientCommand_LobbyCommand{LobbyCommand: cmd}})
+	}
+	lobby(&messages.LobbyCommand{Action: &messages.LobbyCommand_SetReady{SetReady: &messages.SetReadyCommand{Ready: true}}})
+	lobby(&messages.LobbyCommand{Action: &messages.LobbyCommand_StartGame{StartGame: &messages.StartGameCommand{}}})
+
+	deadline := time.Now().Add(10 * time.Second)
+	for !store.has(game.ID, journal.KindStart) {
+		if time.Now().After(deadline) {
+			t.Fatal("Game did not start")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	send(&messages.ClientCommand{Command: &messages.ClientCommand_GameCommand{GameCommand: &messages.GameCommand{
+		Action: &messages.GameCommand_QueueResearch{QueueResearch: &messages.QueueResearchCommand{Technology: "improved_hulls"}},
+	}}})
+	time.Sleep(500 * time.Millisecond)
+	game.Shutdown()
+
+	entries, _ := store.Load(context.Background(), game.ID)
+	if !store.has(game.ID, journal.KindApplied) {
+		t.Fatal("Expected the research command to be journaled")
+	}
+
+	result, err := session.Replay(entries, assets, 0)
+	if err != nil {
+		t.Fatalf("Failed to replay: %v", err)
+	}
+	if result.Checksums == 0 {
+		t.Error("Expected the checksum written when the game stopped to be verified")
+	}
+	if len(result.World.Empires) != 1 {
+		t.Errorf("Expected the empire of the host, got %d empires", len(result.World.Empires))
+	}
+}
+
+func TestReplayRefusesIncompleteJournal(t *testing.T) {
+	entries := []*journal.Entry{
+		{Kind: journal.KindStart, Start: &journal.Start{}},
+		{Kind: journal.KindGap, Tick: 42},
+	}
+
+	if _, err := session.Replay(entries, nil, 0); !errors.Is(err, session.ErrJournalIncomplete) {
+		t.Errorf("Expected ErrJournalIncomplete, got %v", err)
+	}
+}
@@ -8,21 +8,29 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/gr4vediggr/stellarlight/internal/chat"
+	"github.com/gr4vediggr/stellarlight/internal/game/engine"
 	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/journal"
+	"github.com/gr4vediggr/stellarlight/internal/game/systems"
 	"github.com/gr4vediggr/stellarlight/internal/game/types"
 	"github.com/gr4vediggr/stellarlight/internal/interfaces"
+	"github.com/gr4vediggr/stellarlight/internal/notifications"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
 	"github.com/gr4vediggr/stellarlight/internal/users"
 	"github.com/gr4vediggr/stellarlight/pkg/messages"
+	"google.golang.org/protobuf/proto"
 )
 
 // GameSessionState represents the current state of a game session
 type GameSessionState string
 
 const (
-	StateWaiting GameSessionState = "waiting" // Waiting for players
-	StateActive  GameSessionState = "active"  // Game is running
-	StatePaused  GameSessionState = "paused"  // Game is paused
-	StateEnded   GameSessionState = "ended"   // Game has ended
+	StateWaiting  GameSessionState = "waiting"  // Waiting for players
+	StateStarting GameSessionState = "starting" // Galaxy is being generated
+	StateActive   GameSessionState = "active"   // Game is running
+	StatePaused   GameSessionState = "paused"   // Game is paused
+	StateEnded    GameSessionState = "ended"    // Game has ended
 )
 
 // GameSession represents a single game instance (lobby + active game)
@@ -31,42 +39,224 @@ type GameSession struct {
 	InviteCode string
 	State      GameSessionState
 	CreatedAt  time.Time
+	EndedAt    time.Time // zero unless the game was ended
 	HostID     uuid.UUID // ID of the host player
 	// Players and connections
 	players map[uuid.UUID]*types.Player
 	clients map[uuid.UUID]interfaces.GameClientInterface
 	mu      sync.RWMutex
 
+	// Galaxy generation
+	assets   *resource.Assets
+	settings *messages.GalaxyGenerateSettings
+
+	// Chat
+	chat *chat.ChatService
+
+	// Pause and speed control
+	votes       map[uuid.UUID]*controlVote // open votes by player
+	pauseReason string                     // why the game is paused
+	maintenance bool                       // server is in maintenance, games stay paused
+
+	// Moderation
+	banned map[uuid.UUID]bool // players who may not join again
+
 	// Game engine
+	world  *types.WorldState
 	engine interfaces.GameEngineInterface
 
+	// Journal of commands for replays, nil if not journaled
+	journal *journal.Recorder
+
 	// Lifecycle
 	ctx    context.Context
 	cancel context.CancelFunc
 }
 
-// NewGameSession creates a new game session
-func NewGameSession(creatorUser *users.User) *GameSession {
+// NewGameSession creates a new game session. Players who are offline receive
+// notifications through the notifier, chat commands are handled by the chat
+// service. Both may be nil.
+func NewGameSession(creatorUser *users.User, assets *resource.Assets, notifier notifications.Notifier, chatService *chat.ChatService) *GameSession {
+	session := newGameSession(uuid.New(), types.NewWorldState(), assets, notifier, chatService)
+	session.InviteCode = generateInviteCode()
+	session.HostID = creatorUser.ID // Set creator as host
+
+	// Add creator as first player
+	session.AddPlayer(creatorUser)
+	return session
+}
+
+// restoreGameSession recreates a session from a checkpoint. Running games are
+// resumed, their players reconnect like after losing their connection.
+func restoreGameSession(record *SessionRecord, assets *resource.Assets, notifier notifications.Notifier, chatService *chat.ChatService, j journal.Journal) *GameSession {
+	world := record.World
+	if world == nil {
+		world = types.NewWorldState()
+	}
+
+	session := newGameSession(record.ID, world, assets, notifier, chatService)
+	session.InviteCode = record.InviteCode
+	session.State = record.State
+	session.CreatedAt = record.CreatedAt
+	session.EndedAt = record.EndedAt
+	session.HostID = record.HostID
+	if record.Settings != nil {
+		session.settings = record.Settings
+	}
+	for _, player := range record.Players {
+		player.IsActive = false
+		session.players[player.User.ID] = player
+	}
+	for _, playerID := range record.Banned {
+		session.banned[playerID] = true
+	}
+	session.setJournal(j)
+	if session.State == StateActive || session.State == StatePaused {
+		session.recordRestore()
+	}
+
+	// The reason of a pause is not stored, a game that was paused stays
+	// paused until it is resumed like after maintenance
+	switch session.State {
+	case StateActive:
+		session.engine.StartGame()
+	case StatePaused:
+		session.pauseReason = PauseReasonMaintenance
+		session.engine.StartGame()
+		if err := session.engine.Pause(PauseReasonMaintenance); err != nil {
+			log.Printf("Failed to pause restored session %s: %v", session.ID, err)
+		}
+	}
+	return session
+}
+
+// newGameSession creates a session without players around a world
+func newGameSession(id uuid.UUID, world *types.WorldState, assets *resource.Assets, notifier notifications.Notifier, chatService *chat.ChatService) *GameSession {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	session := &GameSession{
-		ID:         uuid.New(),
-		InviteCode: generateInviteCode(),
-		State:      StateWaiting,
-		CreatedAt:  time.Now(),
-		HostID:     creatorUser.ID, // Set creator as host
-		players:    make(map[uuid.UUID]*types.Player),
-		clients:    make(map[uuid.UUID]interfaces.GameClientInterface),
+		ID:        id,
+		State:     StateWaiting,
+		CreatedAt: time.Now(),
+		players:   make(map[uuid.UUID]*types.Player),
+		clients:   make(map[uuid.UUID]interfaces.GameClientInterface),
+		votes:     make(map[uuid.UUID]*controlVote),
+		banned:    make(map[uuid.UUID]bool),
+		world:     world,
+
+		assets:   assets,
+		settings: defaultSettings(),
+		chat:     chatService,
 
 		ctx:    ctx,
 		cancel: cancel,
 	}
+	gameEngine := engine.NewGameEngine(session.ID, session.world, assets, session)
+	if notifier != nil {
+		gameEngine.RegisterSystem(systems.NewInboxSystem(gameEngine.EventBus(), session.world, assets, session, notifier))
+	}
+	session.engine = gameEngine
 
-	// Add creator as first player
-	session.AddPlayer(creatorUser)
 	return session
 }
 
+// checkpoint returns a record of the session to be stored. The world is
+// copied, so the game goes on while the record is written.
+func (s *GameSession) checkpoint() (*SessionRecord, error) {
+	s.mu.RLock()
+	record := &SessionRecord{
+		ID:         s.ID,
+		InviteCode: s.InviteCode,
+		State:      s.State,
+		HostID:     s.HostID,
+		CreatedAt:  s.CreatedAt,
+		EndedAt:    s.EndedAt,
+		Settings:   proto.Clone(s.settings).(*messages.GalaxyGenerateSettings),
+		Players:    make([]*types.Player, 0, len(s.players)),
+		Banned:     make([]uuid.UUID, 0, len(s.banned)),
+	}
+	for _, player := range s.players {
+		copied := *player
+		record.Players = append(record.Players, &copied)
+	}
+	for playerID := range s.banned {
+		record.Banned = append(record.Banned, playerID)
+	}
+	s.mu.RUnlock()
+
+	switch record.State {
+	case StateWaiting:
+		return record, nil
+	case StateStarting:
+		// The galaxy is still being generated, the game is set up again after a restart
+		record.State = StateWaiting
+		return record, nil
+	}
+
+	s.world.AcquireLock()
+	world, err := s.world.Copy()
+	s.world.ReleaseLock()
+	if err != nil {
+		return nil, err
+	}
+	record.World = world
+
+	return record, nil
+}
+
+// setJournal starts journaling the commands of the session. Must be called
+// before the game starts.
+func (s *GameSession) setJournal(j journal.Journal) {
+	if j == nil {
+		return
+	}
+	s.journal = journal.NewRecorder(j, s.ID)
+	s.engine.SetJournal(s.journal)
+}
+
+// recordCommand writes a command that reached the session to the journal
+func (s *GameSession) recordCommand(cmd *events.ClientCommandWrapper) {
+	if s.journal == nil {
+		return
+	}
+
+	s.world.AcquireLock()
+	tick := s.world.Turn
+	s.world.ReleaseLock()
+
+	entry, err := journal.NewCommandEntry(journal.KindCommand, tick, cmd)
+	if err != nil {
+		log.Printf("Failed to journal command from %s in session %s: %v", cmd.PlayerID, s.ID, err)
+		return
+	}
+	s.journal.Record(entry)
+}
+
+// recordRestore writes the turn and checksum of a world restored from a
+// checkpoint to the journal. Ticks simulated after the checkpoint are lost
+// and replays go on from here.
+func (s *GameSession) recordRestore() {
+	if s.journal == nil {
+		return
+	}
+
+	s.world.AcquireLock()
+	tick := s.world.Turn
+	checksum, err := s.world.Checksum()
+	s.world.ReleaseLock()
+	if err != nil {
+		log.Printf("Failed to compute the checksum of restored session %s: %v", s.ID, err)
+		return
+	}
+
+	s.journal.Record(&journal.Entry{
+		Kind:     journal.KindRestore,
+		Tick:     tick,
+		Time:     time.Now(),
+		Checksum: checksum,
+	})
+}
+
 // GetID returns the session ID (implements interfaces.GameSessionInterface)
 func (s *GameSession) GetID() uuid.UUID {
 	return s.ID
@@ -94,8 +284,11 @@ func (s *GameSession) AddPlayer(user *users.User) error {
 	return nil
 }
 
-// AddClient connects a client to the session
-func (s *GameSession) AddClient(client interfaces.GameClientInterface) {
+// AddClient connects a client to the session. A client reconnecting to a
+// running game presents the last sequence number it applied, 0 if it has no
+// state, and is sent the updates it missed or a fresh snapshot. A newer
+// connection of the same player replaces the older one.
+func (s *GameSession) AddClient(client interfaces.GameClientInterface, lastSequence uint64) {
 	log.Println("adding client")
 	s.mu.Lock()
 
@@ -106,10 +299,49 @@ func (s *GameSession) AddClient(client interfaces.GameClientInterface) {
 		player.LastSeen = time.Now()
 		player.IsActive = true
 	}
+	state := s.State
 	s.mu.Unlock()
 
 	// Send lobby state to client
 	s.broadcastLobbyState()
+
+	if state == StateActive || state == StatePaused {
+		s.requestResync(client.GetUserID(), lastSequence)
+	}
+}
+
+// DisconnectClient removes a client whose connection closed. It is ignored if
+// the player has reconnected in the meantime, so the closing of an old
+// connection never marks a connected player as inactive.
+func (s *GameSession) DisconnectClient(client interfaces.GameClientInterface) {
+	s.mu.RLock()
+	current, exists := s.clients[client.GetUserID()]
+	s.mu.RUnlock()
+
+	if !exists || current != client {
+		return
+	}
+	s.RemoveClient(client.GetUserID())
+}
+
+// GetClient returns the connected client of a player (implements interfaces.ClientRegistry)
+func (s *GameSession) GetClient(playerID uuid.UUID) (interfaces.GameClientInterface, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	client, exists := s.clients[playerID]
+	return client, exists
+}
+
+// connectedClients returns the clients of all connected players
+func (s *GameSession) connectedClients() []interfaces.GameClientInterface {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	clients := make([]interfaces.GameClientInterface, 0, len(s.clients))
+	for _, client := range s.clients {
+		clients = append(clients, client)
+	}
+	return clients
 }
 
 // RemoveClient disconnects a websocket client
@@ -128,6 +360,8 @@ func (s *GameSession) RemoveClient(userID uuid.UUID) {
 
 // ProcessCommand handles a command from a client
 func (s *GameSession) ProcessCommand(cmd *events.ClientCommandWrapper) {
+	s.recordCommand(cmd)
+
 	// Validate command
 	if err := s.validateCommand(cmd); err != nil {
 		s.sendErrorToClient(cmd.PlayerID, err)
@@ -139,14 +373,83 @@ func (s *GameSession) ProcessCommand(cmd *events.ClientCommandWrapper) {
 	}
 
 	if gc := cmd.Command.GetGameCommand(); gc != nil {
-		s.engine.ProcessGameCommand(cmd)
+		s.handleGameCommand(cmd)
+	}
+
+	if cc := cmd.Command.GetChatCommand(); cc != nil {
+		s.handleChatCommand(cmd.PlayerID, cc)
+	}
+
+	if ctl := cmd.Command.GetControlCommand(); ctl != nil {
+		if err := s.handleControlCommand(cmd.PlayerID, ctl); err != nil {
+			s.sendErrorToClient(cmd.PlayerID, err)
+		}
+	}
+
+	if rc := cmd.Command.GetResyncCommand(); rc != nil {
+		log.Printf("Player %s lost the update stream after sequence %d", cmd.PlayerID, rc.GetLastSequence())
+		s.requestResync(cmd.PlayerID, rc.GetLastSequence())
 	}
 
 }
 
-// Shutdown cleanly shuts down the game session
+// Shutdown cleanly shuts down the game session and disconnects its clients
 func (s *GameSession) Shutdown() {
-	// Todo
+	s.engine.Stop()
+	s.journal.Close()
+	s.cancel()
+
+	s.mu.Lock()
+	clients := make([]interfaces.GameClientInterface, 0, len(s.clients))
+	for playerID, client := range s.clients {
+		clients = append(clients, client)
+		delete(s.clients, playerID)
+	}
+	s.mu.Unlock()
+
+	// Disconnected in the background, the disconnect handlers look the session
+	// up in the session manager, which may be shutting it down under its lock
+	for _, client := range clients {
+		go client.Disconnect()
+	}
+}
+
+func (s *GameSession) handleGameCommand(cmd *events.ClientCommandWrapper) {
+	s.mu.RLock()
+	state := s.State
+	s.mu.RUnlock()
+
+	// Orders can be given while the game is paused
+	if state != StateActive && state != StatePaused {
+		s.sendErrorToClient(cmd.PlayerID, ErrGameNotActive)
+		return
+	}
+
+	if err := s.engine.ProcessGameCommand(cmd); err != nil {
+		s.sendErrorToClient(cmd.PlayerID, err)
+	}
+}
+
+func (s *GameSession) handleChatCommand(playerID uuid.UUID, chatCmd *messages.ChatCommand) {
+	if s.chat == nil {
+		s.sendErrorToClient(playerID, ErrChatUnavailable)
+		return
+	}
+
+	s.mu.RLock()
+	player := s.players[playerID]
+	s.mu.RUnlock()
+
+	if err := s.chat.HandleCommand(s.ctx, player.User, s.ID, chatCmd); err != nil {
+		s.sendErrorToClient(playerID, err)
+	}
+}
+
+// requestResync asks the engine to bring the player's client up to date
+func (s *GameSession) requestResync(playerID uuid.UUID, lastSequence uint64) {
+	if err := s.engine.RequestResync(playerID, lastSequence); err != nil {
+		s.sendErrorToClient(playerID, err)
+	}
 }
 
 func (s *GameSession) validateCommand(cmd *events.ClientCommandWrapper) error {
@@ -188,7 +491,13 @@ func (s *GameSession) handleLobbyCommand(playerID uuid.UUID, lobbyCmd *messages.
 		s.handlePlayerColor(playerID, sc)
 
 	} else if st := lobbyCmd.GetUpdateSettings(); st != nil {
-		s.handleSettingsUpdate(playerID, st)
+		if err := s.handleSettingsUpdate(playerID, st); err != nil {
+			s.sendErrorToClient(playerID, err)
+		}
+	} else if lobbyCmd.GetStartGame() != nil {
+		if err := s.handleStartGame(playerID); err != nil {
+			s.sendErrorToClient(playerID, err)
+		}
 	}
 
 	// broadcast new state
@@ -215,13 +524,37 @@ func (s *GameSession) handlePlayerColor(playerID uuid.UUID, data *messages.SetCo
 
 }
 
-func (s *GameSession) handleSettingsUpdate(playerID uuid.UUID, data *messages.UpdateSettingsCommand) {
-	// Update lobby settings (implementation needed)
-	// For now, just broadcast updated lobby state
-}
+func (s *GameSession) handleSettingsUpdate(playerID uuid.UUID, data *messages.UpdateSettingsCommand) error {
+	s.mu.Lock()
+
+	if s.HostID != playerID {
+		s.mu.Unlock()
+		return ErrNotHost
+	}
+
+	if s.State != StateWaiting {
+		s.mu.Unlock()
+		return ErrInvalidStateTransition
+	}
+
+	if err := validateSettings(data.Settings); err != nil {
+		s.mu.Unlock()
+		return err
+	}
+
+	s.settings = data.Settings
+	s.mu.Unlock()
+
+	s.broadcastLobbyMessage(&messages.LobbyMessage{
+		Content: &messages.LobbyMessage_SettingsUpdated{
+			SettingsUpdated: &messages.LobbySettingsUpdatedMessage{
+				Settings:          data.Settings,
+				UpdatedByPlayerId: playerID.String(),
+			},
+		},
+	})
 
-func (s *GameSession) handleStartGame(playerID uuid.UUID) {
-	// Start the game if conditions are met
+	return nil
 }
 
 func (s *GameSession) handlePlayerLeave(playerID uuid.UUID) {
@@ -233,19 +566,24 @@ func (s *GameSession) broadcastLobbyState() {
 	lobbyState := s.createLobbyStateMessage()
 	log.Println("broadcasting lobby")
 
+	s.broadcastLobbyMessage(lobbyState)
+	log.Println("done broadcasting lobby")
+
+}
+
+func (s *GameSession) broadcastLobbyMessage(lobbyMsg *messages.LobbyMessage) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	msg := &messages.ServerMessage{
+		Timestamp: time.Now().UnixMilli(),
 		Message: &messages.ServerMessage_LobbyMessage{
-			LobbyMessage: lobbyState,
+			LobbyMessage: lobbyMsg,
 		},
 	}
 	for _, client := range s.clients {
 
 		go client.SendMessage(msg)
 	}
-	log.Println("done broadcasting lobby")
-
 }
 
 func (s *GameSession) createLobbyStateMessage() *messages.LobbyMessage {
@@ -261,6 +599,8 @@ func (s *GameSession) createLobbyStateMessage() *messages.LobbyMessage {
 			IsHost:      s.HostID == playerID,
 			IsReady:     player.Ready,
 			Color:       player.Color,
+			IsConnected: player.IsActive,
+			JoinedAt:    player.JoinedAt.UnixMilli(),
 		}
 		lobbyPlayers = append(lobbyPlayers, lobbyPlayer)
 	}
@@ -270,8 +610,12 @@ func (s *GameSession) createLobbyStateMessage() *messages.LobbyMessage {
 	switch s.State {
 	case StateWaiting:
 		status = messages.LobbyStateMessage_WAITING
-	case StateActive:
+	case StateStarting:
+		status = messages.LobbyStateMessage_STARTING
+	case StateActive, StatePaused:
 		status = messages.LobbyStateMessage_IN_GAME
+	case StateEnded:
+		status = messages.LobbyStateMessage_ENDED
 	default:
 		status = messages.LobbyStateMessage_WAITING
 	}
@@ -283,7 +627,7 @@ func (s *GameSession) createLobbyStateMessage() *messages.LobbyMessage {
 		HostPlayerId: s.HostID.String(),
 		Status:       status,
 		Players:      lobbyPlayers,
-		Settings:     nil, // TODO: Implement settings
+		Settings:     s.settings,
 	}
 
 	return &messages.LobbyMessage{
@@ -302,15 +646,19 @@ func (s *GameSession) sendErrorToClient(playerID uuid.UUID, err error) {
 		return
 	}
 
-	// Create error message
-	errorMsg := &messages.ErrorMessage{
-		ErrorMessage: err.Error(),
-	}
-
 	// Send to the specific client
-	if protobufClient, ok := client.(interface {
-		SendErrorMessage(msg *messages.ErrorMessage, messageID string) error
-	}); ok {
-		protobufClient.SendErrorMessage(errorMsg, "")
+	go client.SendMessage(errorMessage(err))
+}
+
+// errorMessage wraps a session error for clients
+func errorMessage(err error) *messages.ServerMessage {
+	return &messages.ServerMessage{
+		Timestamp: time.Now().UnixMilli(),
+		Message: &messages.ServerMessage_ErrorMessage{
+			ErrorMessage: &messages.ErrorMessage{
+				ErrorCode:    errorCode(err),
+				ErrorMessage: err.Error(),
+			},
+		},
 	}
 }
@@ -0,0 +1,32 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/pkg/messages"
+)
+
+// SessionRepository stores sessions so games survive a restart of the server
+type SessionRepository interface {
+	SaveSession(ctx context.Context, record *SessionRecord) error
+	LoadSessions(ctx context.Context) ([]*SessionRecord, error)
+	DeleteSession(ctx context.Context, id uuid.UUID) error
+}
+
+// SessionRecord is a checkpoint of a session
+type SessionRecord struct {
+	ID         uuid.UUID
+	InviteCode string
+	State      GameSessionState
+	HostID     uuid.UUID
+	CreatedAt  time.Time
+	EndedAt    time.Time // zero unless the game was ended
+	Settings   *messages.GalaxyGenerateSettings
+	Players    []*types.Player
+	Banned     []uuid.UUID       // players banned from the session
+	World      *types.WorldState // nil until the game has started
+}
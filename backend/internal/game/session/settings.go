@@ -0,0 +1,211 @@
+package session
+
+import (
+	"fmt"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/internal/gen"
+	"github.com/gr4vediggr/stellarlight/pkg/messages"
+	"google.golang.org/protobuf/proto"
+)
+
+// Lobby settings limits
+const (
+	MinStarSystems         = 50
+	MaxStarSystems         = 2000
+	MinHyperlanesPerSystem = 2
+	MaxHyperlanesPerSystem = 10
+	MaxHyperlaneDensity    = 100 // hyperlaneConnectivity is a percentage
+	MinYearsPerHour        = 0.1
+	MaxYearsPerHour        = 60.0
+)
+
+// validateSettings checks lobby settings against the generation limits
+func validateSettings(settings *messages.GalaxyGenerateSettings) error {
+	if settings == nil {
+		return fmt.Errorf("%w: settings are missing", ErrInvalidSettings)
+	}
+
+	if settings.NumStars < MinStarSystems || settings.NumStars > MaxStarSystems {
+		return fmt.Errorf("%w: star count must be between %d and %d", ErrInvalidSettings, MinStarSystems, MaxStarSystems)
+	}
+
+	if !gen.GalaxyShape(settings.Shape).IsValid() {
+		return fmt.Errorf("%w: unknown galaxy shape %q", ErrInvalidSettings, settings.Shape)
+	}
+
+	if settings.MaxHyperlanes < MinHyperlanesPerSystem || settings.MaxHyperlanes > MaxHyperlanesPerSystem {
+		return fmt.Errorf("%w: hyperlanes per system must be between %d and %d", ErrInvalidSettings, MinHyperlanesPerSystem, MaxHyperlanesPerSystem)
+	}
+
+	if settings.HyperlaneConnectivity < 0 || settings.HyperlaneConnectivity > MaxHyperlaneDensity {
+		return fmt.Errorf("%w: hyperlane connectivity must be between 0 and %d", ErrInvalidSettings, MaxHyperlaneDensity)
+	}
+
+	// 0 keeps the default rate for clients that do not set it
+	if settings.YearsPerHour != 0 && (settings.YearsPerHour < MinYearsPerHour || settings.YearsPerHour > MaxYearsPerHour) {
+		return fmt.Errorf("%w: game speed must be between %g and %g years per hour", ErrInvalidSettings, MinYearsPerHour, MaxYearsPerHour)
+	}
+
+	return validateShapeParams(shapeParamsFromSettings(settings.ShapeParams))
+}
+
+// paramRange is the allowed range of a shape parameter
+type paramRange struct {
+	name    string
+	value   float64
+	minimum float64
+	maximum float64
+}
+
+// validateShapeParams checks the parameters of every shape, after the
+// parameters left unset took their default value
+func validateShapeParams(params gen.ShapeParams) error {
+	spiral, barred := params.Spiral, params.BarredSpiral
+	ranges := []paramRange{
+		{"spiral arm count", float64(spiral.NumArms), 1, 8},
+		{"spiral arm spread", spiral.ArmSpread, 0.05, 2},
+		{"spiral core radius", spiral.MinRadius, 0, 0.9},
+		{"spiral twist", spiral.Twist, 0.1, 6},
+		{"spiral interarm chance", spiral.InterarmChance, 0, 1},
+		{"elliptical axis ratio", params.Elliptical.AxisRatio, 0.1, 1},
+		{"elliptical concentration", params.Elliptical.Concentration, 1, 5},
+		{"ring inner radius", params.Ring.InnerRadius, 0, 0.9},
+		{"barred spiral arm count", float64(barred.NumArms), 1, 8},
+		{"barred spiral bar length", barred.BarLength, 0.05, 0.9},
+		{"barred spiral bar width", barred.BarWidth, 0.01, 0.5},
+		{"barred spiral bar fraction", barred.BarFraction, 0, 1},
+		{"barred spiral arm spread", barred.ArmSpread, 0.05, 2},
+		{"barred spiral twist", barred.Twist, 0.1, 6},
+		{"barred spiral disk fraction", barred.DiskFraction, 0, 1 - barred.BarFraction},
+		{"cluster count", float64(params.Clustered.NumClusters), 1, 12},
+		{"cluster radius", params.Clustered.ClusterRadius, 0.05, 1},
+		{"cluster bridge spacing", params.Clustered.BridgeSpacing, 1, 10},
+		{"irregularity", params.Irregular.Irregularity, 0, 1},
+		{"irregular clump count", float64(params.Irregular.NumClumps), 0, 12},
+		{"irregular clump radius", params.Irregular.ClumpRadius, 0.05, 0.9},
+	}
+
+	for _, r := range ranges {
+		// Written so that NaN is out of range too
+		if !(r.value >= r.minimum && r.value <= r.maximum) {
+			return fmt.Errorf("%w: %s must be between %g and %g", ErrInvalidSettings, r.name, r.minimum, r.maximum)
+		}
+	}
+	return nil
+}
+
+// configFromSettings converts validated lobby settings to a galaxy generation config
+func configFromSettings(settings *messages.GalaxyGenerateSettings) gen.GalaxyGenerationConfig {
+	return gen.GalaxyGenerationConfig{
+		NumStarSystems:         int(settings.NumStars),
+		Shape:                  gen.GalaxyShape(settings.Shape),
+		Params:                 shapeParamsFromSettings(settings.ShapeParams),
+		HyperlaneDensity:       float64(settings.HyperlaneConnectivity) / MaxHyperlaneDensity,
+		MaxHyperlanesPerSystem: int(settings.MaxHyperlanes),
+		Seed:                   settings.Seed,
+	}
+}
+
+// defaultSettings returns the settings of a new lobby
+func defaultSettings() *messages.GalaxyGenerateSettings {
+	settings := settingsFromConfig(gen.DefaultGalaxyGenerationConfig())
+	settings.YearsPerHour = types.DefaultYearsPerHour
+	return settings
+}
+
+// settingsFromConfig converts a generation config to the settings shown in the lobby
+func settingsFromConfig(config gen.GalaxyGenerationConfig) *messages.GalaxyGenerateSettings {
+	return &messages.GalaxyGenerateSettings{
+		NumStars:              int32(config.NumStarSystems),
+		Shape:                 string(config.Shape),
+		MaxHyperlanes:         int32(config.MaxHyperlanesPerSystem),
+		HyperlaneConnectivity: int32(config.HyperlaneDensity * MaxHyperlaneDensity),
+		Seed:                  config.Seed,
+		ShapeParams:           settingsFromShapeParams(config.Params),
+	}
+}
+
+// shapeParamsFromSettings converts the shape parameters of the lobby settings,
+// parameters left unset take their default value
+func shapeParamsFromSettings(settings *messages.GalaxyShapeParams) gen.ShapeParams {
+	p := settingsFromShapeParams(gen.DefaultShapeParams())
+	if settings != nil {
+		proto.Merge(p, settings)
+	}
+
+	return gen.ShapeParams{
+		Spiral: gen.SpiralParams{
+			NumArms:        int(p.GetSpiral().GetNumArms()),
+			ArmSpread:      p.GetSpiral().GetArmSpread(),
+			MinRadius:      p.GetSpiral().GetMinRadius(),
+			Twist:          p.GetSpiral().GetTwist(),
+			InterarmChance: p.GetSpiral().GetInterarmChance(),
+		},
+		Elliptical: gen.EllipticalParams{
+			AxisRatio:     p.GetElliptical().GetAxisRatio(),
+			Concentration: p.GetElliptical().GetConcentration(),
+		},
+		Ring: gen.RingParams{
+			InnerRadius: p.GetRing().GetInnerRadius(),
+		},
+		BarredSpiral: gen.BarredSpiralParams{
+			NumArms:      int(p.GetBarredSpiral().GetNumArms()),
+			BarLength:    p.GetBarredSpiral().GetBarLength(),
+			BarWidth:     p.GetBarredSpiral().GetBarWidth(),
+			BarFraction:  p.GetBarredSpiral().GetBarFraction(),
+			ArmSpread:    p.GetBarredSpiral().GetArmSpread(),
+			Twist:        p.GetBarredSpiral().GetTwist(),
+			DiskFraction: p.GetBarredSpiral().GetDiskFraction(),
+		},
+		Clustered: gen.ClusteredParams{
+			NumClusters:   int(p.GetClustered().GetNumClusters()),
+			ClusterRadius: p.GetClustered().GetClusterRadius(),
+			BridgeSpacing: p.GetClustered().GetBridgeSpacing(),
+		},
+		Irregular: gen.IrregularParams{
+			Irregularity: p.GetIrregular().GetIrregularity(),
+			NumClumps:    int(p.GetIrregular().GetNumClumps()),
+			ClumpRadius:  p.GetIrregular().GetClumpRadius(),
+		},
+	}
+}
+
+// settingsFromShapeParams converts shape parameters to those shown in the lobby
+func settingsFromShapeParams(p gen.ShapeParams) *messages.GalaxyShapeParams {
+	return &messages.GalaxyShapeParams{
+		Spiral: &messages.SpiralShapeParams{
+			NumArms:        proto.Int32(int32(p.Spiral.NumArms)),
+			ArmSpread:      proto.Float64(p.Spiral.ArmSpread),
+			MinRadius:      proto.Float64(p.Spiral.MinRadius),
+			Twist:          proto.Float64(p.Spiral.Twist),
+			InterarmChance: proto.Float64(p.Spiral.InterarmChance),
+		},
+		Elliptical: &messages.EllipticalShapeParams{
+			AxisRatio:     proto.Float64(p.Elliptical.AxisRatio),
+			Concentration: proto.Float64(p.Elliptical.Concentration),
+		},
+		Ring: &messages.RingShapeParams{
+			InnerRadius: proto.Float64(p.Ring.InnerRadius),
+		},
+		BarredSpiral: &messages.BarredSpiralShapeParams{
+			NumArms:      proto.Int32(int32(p.BarredSpiral.NumArms)),
+			BarLength:    proto.Float64(p.BarredSpiral.BarLength),
+			BarWidth:     proto.Float64(p.BarredSpiral.BarWidth),
+			BarFraction:  proto.Float64(p.BarredSpiral.BarFraction),
+			ArmSpread:    proto.Float64(p.BarredSpiral.ArmSpread),
+			Twist:        proto.Float64(p.BarredSpiral.Twist),
+			DiskFraction: proto.Float64(p.BarredSpiral.DiskFraction),
+		},
+		Clustered: &messages.ClusteredShapeParams{
+			NumClusters:   proto.Int32(int32(p.Clustered.NumClusters)),
+			ClusterRadius: proto.Float64(p.Clustered.ClusterRadius),
+			BridgeSpacing: proto.Float64(p.Clustered.BridgeSpacing),
+		},
+		Irregular: &messages.IrregularShapeParams{
+			Irregularity: proto.Float64(p.Irregular.Irregularity),
+			NumClumps:    proto.Int32(int32(p.Irregular.NumClumps)),
+			ClumpRadius:  proto.Float64(p.Irregular.ClumpRadius),
+		},
+	}
+}
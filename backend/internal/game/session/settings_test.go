@@ -0,0 +1,138 @@
+package session_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/session"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
+	"github.com/gr4vediggr/stellarlight/internal/users"
+	"github.com/gr4vediggr/stellarlight/pkg/messages"
+)
+
+// testClient collects the messages a session sends to a player
+type testClient struct {
+	userID   uuid.UUID
+	messages chan *messages.ServerMessage
+}
+
+func newTestClient(userID uuid.UUID) *testClient {
+	return &testClient{userID: userID, messages: make(chan *messages.ServerMessage, 256)}
+}
+
+func (c *testClient) GetUserID() uuid.UUID { return c.userID }
+
+func (c *testClient) SendMessage(msg *messages.ServerMessage) error {
+	select {
+	case c.messages <- msg:
+	default:
+	}
+	return nil
+}
+
+func (c *testClient) Disconnect() {}
+
+// await returns the first message accepted by match, or nil after a second
+func (c *testClient) await(match func(*messages.ServerMessage) bool) *messages.ServerMessage {
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case msg := <-c.messages:
+			if match(msg) {
+				return msg
+			}
+		case <-timeout:
+			return nil
+		}
+	}
+}
+
+// lobbyCommand sends a lobby command of a player to a session
+func lobbyCommand(game *session.GameSession, playerID uuid.UUID, cmd *messages.LobbyCommand) {
+	game.ProcessCommand(&events.ClientCommandWrapper{
+		PlayerID: playerID,
+		Command:  &messages.ClientCommand{Command: &messages.ClientCommand_LobbyCommand{LobbyCommand: cmd}},
+	})
+}
+
+func TestUpdateSettingsValidation(t *testing.T) {
+	manager := session.NewSessionManager(&resource.Assets{}, nil, nil)
+	host := &users.User{ID: uuid.New(), DisplayName: "Host"}
+	created, err := manager.CreateSession(host)
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+	game := created.(*session.GameSession)
+	client := newTestClient(host.ID)
+	game.AddClient(client, 0)
+
+	valid := func() *messages.GalaxyGenerateSettings {
+		return &messages.GalaxyGenerateSettings{NumStars: 200, Shape: "spiral", MaxHyperlanes: 5, HyperlaneConnectivity: 50}
+	}
+	with := func(change func(*messages.GalaxyGenerateSettings)) *messages.GalaxyGenerateSettings {
+		settings := valid()
+		change(settings)
+		return settings
+	}
+	shapes := func(params *messages.GalaxyShapeParams) *messages.GalaxyGenerateSettings {
+		return with(func(s *messages.GalaxyGenerateSettings) { s.ShapeParams = params })
+	}
+
+	tests := []struct {
+		name     string
+		settings *messages.GalaxyGenerateSettings
+		valid    bool
+	}{
+		{"defaults", valid(), true},
+		{"fewest stars", with(func(s *messages.GalaxyGenerateSettings) { s.NumStars = session.MinStarSystems }), true},
+		{"too few stars", with(func(s *messages.GalaxyGenerateSettings) { s.NumStars = session.MinStarSystems - 1 }), false},
+		{"too many stars", with(func(s *messages.GalaxyGenerateSettings) { s.NumStars = session.MaxStarSystems + 1 }), false},
+		{"unknown shape", with(func(s *messages.GalaxyGenerateSettings) { s.Shape = "donut" }), false},
+		{"too few hyperlanes", with(func(s *messages.GalaxyGenerateSettings) { s.MaxHyperlanes = session.MinHyperlanesPerSystem - 1 }), false},
+		{"too dense", with(func(s *messages.GalaxyGenerateSettings) { s.HyperlaneConnectivity = session.MaxHyperlaneDensity + 1 }), false},
+		{"too fast", with(func(s *messages.GalaxyGenerateSettings) { s.YearsPerHour = session.MaxYearsPerHour + 1 }), false},
+		{"only arm count set", shapes(&messages.GalaxyShapeParams{
+			Spiral: &messages.SpiralShapeParams{NumArms: proto.Int32(4)},
+		}), true},
+		{"too many arms", shapes(&messages.GalaxyShapeParams{
+			Spiral: &messages.SpiralShapeParams{NumArms: proto.Int32(20)},
+		}), false},
+		{"explicit zero", shapes(&messages.GalaxyShapeParams{
+			Irregular: &messages.IrregularShapeParams{Irregularity: proto.Float64(0)},
+		}), true},
+		{"zero arms", shapes(&messages.GalaxyShapeParams{
+			BarredSpiral: &messages.BarredSpiralShapeParams{NumArms: proto.Int32(0)},
+		}), false},
+		{"not a number", shapes(&messages.GalaxyShapeParams{
+			Ring: &messages.RingShapeParams{InnerRadius: proto.Float64(math.NaN())},
+		}), false},
+		{"bar and disk over all stars", shapes(&messages.GalaxyShapeParams{
+			BarredSpiral: &messages.BarredSpiralShapeParams{BarFraction: proto.Float64(0.7), DiskFraction: proto.Float64(0.5)},
+		}), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lobbyCommand(game, host.ID, &messages.LobbyCommand{Action: &messages.LobbyCommand_UpdateSettings{
+				UpdateSettings: &messages.UpdateSettingsCommand{Settings: tt.settings},
+			}})
+
+			reply := client.await(func(msg *messages.ServerMessage) bool {
+				return msg.GetErrorMessage() != nil || msg.GetLobbyMessage().GetSettingsUpdated() != nil
+			})
+			switch {
+			case reply == nil:
+				t.Fatal("Expected an answer to the settings update")
+			case tt.valid && reply.GetErrorMessage() != nil:
+				t.Errorf("Expected the settings to be accepted, got %s", reply.GetErrorMessage().GetErrorMessage())
+			case !tt.valid && reply.GetErrorMessage().GetErrorCode() != "INVALID_SETTINGS":
+				t.Errorf("Expected INVALID_SETTINGS, got %v", reply)
+			}
+		})
+	}
+}
@@ -0,0 +1,393 @@
+package systems
+
+import (
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gr4vediggr/stellarlight/internal/domain/tech"
+	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
+)
+
+// BattleRoundDays is the number of in-game days between two battle rounds
+const BattleRoundDays = 6
+
+// shipStats are the combat values of a ship type
+type shipStats struct {
+	Hull   float64
+	Attack float64
+}
+
+var defaultShipStats = shipStats{Hull: 100, Attack: 10}
+
+// BattleSystem detects hostile fleets sharing a star system and resolves battles between them
+type BattleSystem struct {
+	name       string
+	eventBus   *events.EventBus
+	worldState *types.WorldState
+	assets     *resource.Assets
+
+	subscriptions []events.SubscriptionID
+	mu            sync.RWMutex
+}
+
+func NewBattleSystem(eventBus *events.EventBus, worldState *types.WorldState, assets *resource.Assets) *BattleSystem {
+	return &BattleSystem{
+		name:          "BattleSystem",
+		eventBus:      eventBus,
+		worldState:    worldState,
+		assets:        assets,
+		subscriptions: make([]events.SubscriptionID, 0),
+	}
+}
+
+func (s *BattleSystem) Initialize() error {
+	log.Printf("Initializing %s", s.name)
+
+	s.subscriptions = append(s.subscriptions,
+		events.Subscribe(s.eventBus, "game_tick", s.handleGameTick),
+		events.Subscribe(s.eventBus, "fleet_arrived", s.handleFleetArrived),
+	)
+
+	return nil
+}
+
+func (s *BattleSystem) Shutdown() error {
+	log.Printf("Shutting down %s", s.name)
+
+	s.eventBus.Unsubscribe(s.subscriptions...)
+	s.subscriptions = nil
+
+	return nil
+}
+
+func (s *BattleSystem) GetName() string {
+	return s.name
+}
+
+func (s *BattleSystem) handleGameTick(tickEvent *types.GameTickEvent) {
+	if !tickEvent.Crossed(BattleRoundDays) {
+		return
+	}
+
+	s.worldState.AcquireLock()
+	var pending []events.GameEvent
+	for _, battle := range s.sortedBattles() {
+		pending = append(pending, s.resolveRound(battle, tickEvent.SessionID)...)
+	}
+
+	// Catch hostile fleets that met without an arrival, e.g. newly built fleets
+	for _, systemID := range s.contestedSystems() {
+		pending = append(pending, s.checkSystem(systemID, tickEvent.SessionID)...)
+	}
+	s.worldState.ReleaseLock()
+
+	s.publish(pending)
+}
+
+// handleFleetArrived starts a battle when a fleet arrives at a system with hostile
+// fleets, or adds it to the battle already taking place there
+func (s *BattleSystem) handleFleetArrived(arrived *types.FleetArrivedEvent) {
+	s.worldState.AcquireLock()
+	pending := s.checkSystem(arrived.SystemID, arrived.SessionID)
+	s.worldState.ReleaseLock()
+
+	s.publish(pending)
+}
+
+// checkSystem starts or reinforces a battle in the given system. Must be called
+// with the world lock held.
+func (s *BattleSystem) checkSystem(systemID, sessionID uuid.UUID) []events.GameEvent {
+	fleets := s.stationaryFleets(systemID)
+
+	if battle, exists := s.worldState.Battles[systemID]; exists {
+		for _, fleet := range fleets {
+			battle.Join(fleet)
+		}
+		return nil
+	}
+
+	if countSides(fleets) < 2 {
+		return nil
+	}
+
+	battle := types.NewBattle(s.worldState.IDs.NewID(), systemID, s.worldState.Clock.Days)
+	for _, fleet := range fleets {
+		battle.Join(fleet)
+	}
+	s.worldState.Battles[systemID] = battle
+
+	return []events.GameEvent{&types.BattleStartedEvent{
+		BaseEvent:    s.newBaseEvent(sessionID, "battle_started"),
+		BattleID:     battle.ID,
+		SystemID:     systemID,
+		Participants: battle.ParticipantIDs(),
+	}}
+}
+
+// resolveRound lets every side fire at its enemies. Must be called with the world lock held.
+func (s *BattleSystem) resolveRound(battle *types.Battle, sessionID uuid.UUID) []events.GameEvent {
+	fleets := s.stationaryFleets(battle.SystemID)
+	for _, fleet := range fleets {
+		battle.Join(fleet)
+	}
+
+	if countSides(fleets) < 2 {
+		return []events.GameEvent{s.endBattle(battle, fleets, sessionID)}
+	}
+
+	battle.Round++
+
+	// Damage is computed from the fleets at the start of the round, so the order
+	// in which sides fire does not matter
+	sides := groupBySide(fleets)
+	owners := make([]uuid.UUID, 0, len(sides))
+	for owner := range sides {
+		owners = append(owners, owner)
+	}
+	sort.Slice(owners, func(i, j int) bool {
+		return owners[i].String() < owners[j].String()
+	})
+
+	// Sides are summed up in a fixed order so that replays add up the same
+	incoming := make(map[uuid.UUID]float64, len(sides))
+	for _, owner := range owners {
+		attack := 0.0
+		for _, fleet := range sides[owner] {
+			attack += s.fleetAttack(fleet)
+		}
+		share := attack / float64(len(sides)-1)
+		for enemy := range sides {
+			if enemy != owner {
+				incoming[enemy] += share
+			}
+		}
+	}
+
+	round := &types.BattleRoundEvent{
+		BaseEvent:    s.newBaseEvent(sessionID, "battle_round"),
+		BattleID:     battle.ID,
+		SystemID:     battle.SystemID,
+		Round:        battle.Round,
+		Participants: battle.ParticipantIDs(),
+		Losses:       make(map[uuid.UUID]map[string]int),
+	}
+
+	for _, owner := range owners {
+		s.applyDamage(battle, sides[owner], incoming[owner], round)
+	}
+
+	result := []events.GameEvent{round}
+
+	remaining := s.stationaryFleets(battle.SystemID)
+	if countSides(remaining) < 2 {
+		result = append(result, s.endBattle(battle, remaining, sessionID))
+	}
+
+	return result
+}
+
+// applyDamage spreads damage over a side's fleets by hull and destroys ships
+// once their hull is used up
+func (s *BattleSystem) applyDamage(battle *types.Battle, fleets []*types.Fleet, damage float64, round *types.BattleRoundEvent) {
+	totalHull := 0.0
+	for _, fleet := range fleets {
+		totalHull += s.fleetHull(fleet)
+	}
+	if totalHull <= 0 {
+		return
+	}
+
+	for _, fleet := range fleets {
+		battle.Damage[fleet.ID] += damage * s.fleetHull(fleet) / totalHull
+
+		// Weakest ships are lost first
+		shipTypes := make([]string, 0, len(fleet.Ships))
+		for shipType, count := range fleet.Ships {
+			if count > 0 {
+				shipTypes = append(shipTypes, shipType)
+			}
+		}
+		sort.Slice(shipTypes, func(i, j int) bool {
+			hi, hj := s.getShipStats(fleet.Owner, shipTypes[i]).Hull, s.getShipStats(fleet.Owner, shipTypes[j]).Hull
+			if hi != hj {
+				return hi < hj
+			}
+			return shipTypes[i] < shipTypes[j]
+		})
+
+		for _, shipType := range shipTypes {
+			hull := s.getShipStats(fleet.Owner, shipType).Hull
+			for fleet.Ships[shipType] > 0 && battle.Damage[fleet.ID] >= hull {
+				fleet.Ships[shipType]--
+				battle.Damage[fleet.ID] -= hull
+				if round.Losses[fleet.ID] == nil {
+					round.Losses[fleet.ID] = make(map[string]int)
+				}
+				round.Losses[fleet.ID][shipType]++
+			}
+			if fleet.Ships[shipType] == 0 {
+				delete(fleet.Ships, shipType)
+			}
+		}
+
+		if fleet.ShipCount() == 0 {
+			s.destroyFleet(fleet)
+			delete(battle.Damage, fleet.ID)
+			round.Destroyed = append(round.Destroyed, fleet.ID)
+		}
+	}
+}
+
+func (s *BattleSystem) endBattle(battle *types.Battle, remaining []*types.Fleet, sessionID uuid.UUID) *types.BattleEndedEvent {
+	delete(s.worldState.Battles, battle.SystemID)
+
+	var winner *uuid.UUID
+	if len(remaining) > 0 {
+		owner := remaining[0].Owner
+		winner = &owner
+	}
+
+	return &types.BattleEndedEvent{
+		BaseEvent:    s.newBaseEvent(sessionID, "battle_ended"),
+		BattleID:     battle.ID,
+		SystemID:     battle.SystemID,
+		Rounds:       battle.Round,
+		Participants: battle.ParticipantIDs(),
+		Winner:       winner,
+	}
+}
+
+// destroyFleet removes a fleet without ships from the world. Must be called with the world lock held.
+func (s *BattleSystem) destroyFleet(fleet *types.Fleet) {
+	if system, exists := s.worldState.Galaxy.GetSystem(fleet.Location); exists {
+		system.RemoveFleet(fleet.ID)
+	}
+	if empire, exists := s.worldState.GetEmpireByID(fleet.Owner); exists {
+		delete(empire.TotalFleets, fleet.ID)
+	}
+}
+
+// stationaryFleets returns the fleets in a system that are not leaving it, sorted by ID
+func (s *BattleSystem) stationaryFleets(systemID uuid.UUID) []*types.Fleet {
+	system, exists := s.worldState.Galaxy.GetSystem(systemID)
+	if !exists {
+		return nil
+	}
+
+	fleets := make([]*types.Fleet, 0, len(system.Fleets))
+	for _, fleet := range system.Fleets {
+		if !fleet.InTransit() && fleet.ShipCount() > 0 {
+			fleets = append(fleets, fleet)
+		}
+	}
+	sort.Slice(fleets, func(i, j int) bool {
+		return fleets[i].ID.String() < fleets[j].ID.String()
+	})
+	return fleets
+}
+
+// contestedSystems returns the systems without a battle where fleets of several
+// empires are stationed, sorted by ID
+func (s *BattleSystem) contestedSystems() []uuid.UUID {
+	owners := make(map[uuid.UUID]map[uuid.UUID]bool)
+	for _, empire := range s.worldState.Empires {
+		for _, fleet := range empire.TotalFleets {
+			if fleet.InTransit() || fleet.ShipCount() == 0 {
+				continue
+			}
+			if _, fighting := s.worldState.Battles[fleet.Location]; fighting {
+				continue
+			}
+			if owners[fleet.Location] == nil {
+				owners[fleet.Location] = make(map[uuid.UUID]bool)
+			}
+			owners[fleet.Location][fleet.Owner] = true
+		}
+	}
+
+	contested := make([]uuid.UUID, 0)
+	for systemID, systemOwners := range owners {
+		if len(systemOwners) > 1 {
+			contested = append(contested, systemID)
+		}
+	}
+	sort.Slice(contested, func(i, j int) bool {
+		return contested[i].String() < contested[j].String()
+	})
+	return contested
+}
+
+func (s *BattleSystem) sortedBattles() []*types.Battle {
+	battles := make([]*types.Battle, 0, len(s.worldState.Battles))
+	for _, battle := range s.worldState.Battles {
+		battles = append(battles, battle)
+	}
+	sort.Slice(battles, func(i, j int) bool {
+		return battles[i].ID.String() < battles[j].ID.String()
+	})
+	return battles
+}
+
+func (s *BattleSystem) fleetAttack(fleet *types.Fleet) float64 {
+	attack := 0.0
+	for shipType, count := range fleet.Ships {
+		attack += float64(count) * s.getShipStats(fleet.Owner, shipType).Attack
+	}
+	return attack
+}
+
+func (s *BattleSystem) fleetHull(fleet *types.Fleet) float64 {
+	hull := 0.0
+	for shipType, count := range fleet.Ships {
+		hull += float64(count) * s.getShipStats(fleet.Owner, shipType).Hull
+	}
+	return hull
+}
+
+// getShipStats returns the stats of a ship type including the technology
+// bonuses of the owning empire
+func (s *BattleSystem) getShipStats(owner uuid.UUID, shipType string) shipStats {
+	// Ships of a type that was removed from the assets still fight
+	stats := defaultShipStats
+	if st, exists := s.assets.ShipTypeByKey(shipType); exists && st.Hull > 0 {
+		stats = shipStats{Hull: st.Hull, Attack: st.Attack}
+	}
+
+	empire, _ := s.worldState.GetEmpireByID(owner)
+	stats.Hull *= techModifier(s.assets, empire, tech.EffectShipStat, tech.StatHull, shipType)
+	stats.Attack *= techModifier(s.assets, empire, tech.EffectShipStat, tech.StatAttack, shipType)
+
+	return stats
+}
+
+func (s *BattleSystem) newBaseEvent(sessionID uuid.UUID, eventType string) types.BaseEvent {
+	return types.BaseEvent{
+		SessionID: sessionID,
+		Type:      eventType,
+		Timestamp: time.Now().UnixNano(),
+	}
+}
+
+func (s *BattleSystem) publish(pending []events.GameEvent) {
+	for _, event := range pending {
+		s.eventBus.Publish(event)
+	}
+}
+
+func groupBySide(fleets []*types.Fleet) map[uuid.UUID][]*types.Fleet {
+	sides := make(map[uuid.UUID][]*types.Fleet)
+	for _, fleet := range fleets {
+		sides[fleet.Owner] = append(sides[fleet.Owner], fleet)
+	}
+	return sides
+}
+
+// countSides returns the number of hostile sides. Every empire is hostile to every other empire.
+func countSides(fleets []*types.Fleet) int {
+	return len(groupBySide(fleets))
+}
@@ -0,0 +1,217 @@
+package systems_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/systems"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+)
+
+// battleRecorder collects the battle events published on a bus
+type battleRecorder struct {
+	started []*types.BattleStartedEvent
+	rounds  []*types.BattleRoundEvent
+	ended   []*types.BattleEndedEvent
+}
+
+type battleTest struct {
+	world     *types.WorldState
+	eventBus  *events.EventBus
+	system    *types.StarSystemState
+	sessionID uuid.UUID
+	recorder  *battleRecorder
+}
+
+func newBattleTest(t *testing.T) *battleTest {
+	world := types.NewWorldState()
+	system := &types.StarSystemState{ID: uuid.New(), Fleets: make(map[uuid.UUID]*types.Fleet)}
+	world.Galaxy.AddSystem(system)
+
+	eventBus := events.NewEventBus()
+	battles := systems.NewBattleSystem(eventBus, world, loadAssets(t))
+	if err := battles.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize: %v", err)
+	}
+	t.Cleanup(func() { battles.Shutdown() })
+
+	recorder := &battleRecorder{}
+	events.Subscribe(eventBus, "battle_started", func(e *types.BattleStartedEvent) {
+		recorder.started = append(recorder.started, e)
+	})
+	events.Subscribe(eventBus, "battle_round", func(e *types.BattleRoundEvent) {
+		recorder.rounds = append(recorder.rounds, e)
+	})
+	events.Subscribe(eventBus, "battle_ended", func(e *types.BattleEndedEvent) {
+		recorder.ended = append(recorder.ended, e)
+	})
+
+	return &battleTest{world: world, eventBus: eventBus, system: system, sessionID: uuid.New(), recorder: recorder}
+}
+
+func (b *battleTest) empire(name string) *types.EmpireState {
+	empire := types.NewEmpireState(uuid.New(), name)
+	b.world.Empires[empire.PlayerID] = empire
+	return empire
+}
+
+// arrive stations a new fleet in the system and announces its arrival
+func (b *battleTest) arrive(empire *types.EmpireState, ships map[string]int) *types.Fleet {
+	fleet := addFleet(b.world, empire, b.system, ships)
+	b.eventBus.Publish(&types.FleetArrivedEvent{
+		BaseEvent: types.BaseEvent{SessionID: b.sessionID, Type: "fleet_arrived"},
+		FleetID:   fleet.ID,
+		SystemID:  b.system.ID,
+		Owner:     empire.ID,
+	})
+	return fleet
+}
+
+// round ticks until the next battle round
+func (b *battleTest) round(n int) {
+	tick(b.eventBus, b.sessionID, float64(n*systems.BattleRoundDays), systems.BattleRoundDays)
+}
+
+func TestBattleStartsWhenHostileFleetsMeet(t *testing.T) {
+	b := newBattleTest(t)
+	defender := b.empire("Defender")
+	attacker := b.empire("Attacker")
+
+	b.arrive(defender, map[string]int{"cruiser": 1})
+	if len(b.recorder.started) != 0 {
+		t.Fatal("Expected no battle with a single side in the system")
+	}
+
+	b.arrive(attacker, map[string]int{"cruiser": 1})
+	if len(b.recorder.started) != 1 {
+		t.Fatalf("Expected a battle to start, got %d battle started events", len(b.recorder.started))
+	}
+	started := b.recorder.started[0]
+	if started.SystemID != b.system.ID || len(started.Participants) != 2 {
+		t.Errorf("Expected a battle between 2 empires in the system, got %+v", started)
+	}
+	battle, exists := b.world.Battles[b.system.ID]
+	if !exists || battle.ID != started.BattleID {
+		t.Fatal("Expected the battle to be part of the world")
+	}
+	if !battle.Participants[defender.ID] || !battle.Participants[attacker.ID] {
+		t.Error("Expected both empires to take part")
+	}
+
+	b.arrive(attacker, map[string]int{"fighter": 1})
+	if len(b.recorder.started) != 1 || len(b.world.Battles) != 1 {
+		t.Error("Expected a single battle per system")
+	}
+}
+
+func TestBattleSpreadsLossesByHull(t *testing.T) {
+	// Fleet and empire IDs are random, so every run orders the sides differently
+	for run := 0; run < 10; run++ {
+		b := newBattleTest(t)
+		defender := b.empire("Defender")
+		attacker := b.empire("Attacker")
+
+		// 20 fighters deal 200 damage, split 200:800 between the defending fleets
+		small := b.arrive(defender, map[string]int{"fighter": 2})
+		large := b.arrive(defender, map[string]int{"cruiser": 1, "fighter": 2})
+		swarm := b.arrive(attacker, map[string]int{"fighter": 20})
+
+		b.round(1)
+
+		if len(b.recorder.rounds) != 1 {
+			t.Fatalf("Expected a battle round, got %d", len(b.recorder.rounds))
+		}
+		round := b.recorder.rounds[0]
+		if round.Round != 1 || len(round.Destroyed) != 0 {
+			t.Errorf("Expected round 1 without destroyed fleets, got %+v", round)
+		}
+		if round.Losses[small.ID] != nil || round.Losses[swarm.ID] != nil {
+			t.Errorf("Expected only the large fleet to lose ships, got %v", round.Losses)
+		}
+		// The weakest ships are lost first
+		if losses := round.Losses[large.ID]; len(losses) != 1 || losses["fighter"] != 1 {
+			t.Errorf("Expected the large fleet to lose a fighter, got %v", losses)
+		}
+		if large.Ships["cruiser"] != 1 || large.Ships["fighter"] != 1 || small.Ships["fighter"] != 2 {
+			t.Errorf("Unexpected ships left: small %v, large %v", small.Ships, large.Ships)
+		}
+
+		damage := b.world.Battles[b.system.ID].Damage
+		if damage[small.ID] != 40 || damage[large.ID] != 60 || damage[swarm.ID] != 85 {
+			t.Errorf("Expected damage of 40, 60 and 85 to carry over, got %v, %v and %v",
+				damage[small.ID], damage[large.ID], damage[swarm.ID])
+		}
+	}
+}
+
+func TestBattleReinforcements(t *testing.T) {
+	b := newBattleTest(t)
+	defender := b.empire("Defender")
+	attacker := b.empire("Attacker")
+	latecomer := b.empire("Latecomer")
+
+	b.arrive(defender, map[string]int{"cruiser": 1})
+	b.arrive(attacker, map[string]int{"cruiser": 1})
+	b.round(1)
+
+	reinforcement := b.arrive(defender, map[string]int{"fighter": 1})
+	third := b.arrive(latecomer, map[string]int{"fighter": 1})
+
+	if len(b.recorder.started) != 1 {
+		t.Errorf("Expected reinforcements to join the running battle, got %d battles", len(b.recorder.started))
+	}
+	battle := b.world.Battles[b.system.ID]
+	if !battle.Fleets[reinforcement.ID] || !battle.Fleets[third.ID] {
+		t.Error("Expected the arriving fleets to join the battle")
+	}
+	if !battle.Participants[latecomer.ID] {
+		t.Error("Expected the third empire to take part")
+	}
+
+	b.round(2)
+
+	if len(b.recorder.rounds) != 2 || len(b.recorder.rounds[1].Participants) != 3 {
+		t.Fatalf("Expected the second round to be fought by 3 empires, got %+v", b.recorder.rounds)
+	}
+	if b.recorder.rounds[1].Round != 2 {
+		t.Errorf("Expected the battle to continue with round 2, got %d", b.recorder.rounds[1].Round)
+	}
+}
+
+func TestBattleEndsWhenFleetIsDestroyed(t *testing.T) {
+	b := newBattleTest(t)
+	defender := b.empire("Defender")
+	attacker := b.empire("Attacker")
+
+	b.arrive(defender, map[string]int{"dreadnought": 1})
+	doomed := b.arrive(attacker, map[string]int{"fighter": 1})
+	b.round(1)
+
+	if len(b.recorder.rounds) != 1 {
+		t.Fatalf("Expected a battle round, got %d", len(b.recorder.rounds))
+	}
+	if destroyed := b.recorder.rounds[0].Destroyed; len(destroyed) != 1 || destroyed[0] != doomed.ID {
+		t.Errorf("Expected the attacking fleet to be destroyed, got %v", destroyed)
+	}
+	if len(b.recorder.ended) != 1 {
+		t.Fatalf("Expected the battle to end, got %d battle ended events", len(b.recorder.ended))
+	}
+	ended := b.recorder.ended[0]
+	if ended.Winner == nil || *ended.Winner != defender.ID || ended.Rounds != 1 {
+		t.Errorf("Expected the defender to win after 1 round, got %+v", ended)
+	}
+
+	if len(b.world.Battles) != 0 {
+		t.Error("Expected the battle to be removed from the world")
+	}
+	if attacker.TotalFleets[doomed.ID] != nil || b.system.Fleets[doomed.ID] != nil {
+		t.Error("Expected the destroyed fleet to be removed from the world")
+	}
+
+	b.round(2)
+	if len(b.recorder.rounds) != 1 || len(b.recorder.started) != 1 {
+		t.Error("Expected no fighting after the battle ended")
+	}
+}
@@ -1,32 +1,59 @@
 package systems
 
 import (
+	"encoding/json"
 	"log"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
 	"github.com/gr4vediggr/stellarlight/internal/interfaces"
 	"github.com/gr4vediggr/stellarlight/pkg/messages"
 )
 
-// ClientUpdateSystem handles sending updates to connected clients
+// ClientUpdateSystem handles sending updates to connected clients. Events about
+// star systems, fleets and battles only go to the players who can see them.
+//
+// Every player gets a full snapshot when they connect, followed by a stream of
+// deltas and game events. Each message of the stream carries the next sequence
+// number of the player, so a client that misses one can ask for a resync. The
+// last ReplayBufferSize messages of every player are kept to replay them to
+// clients that reconnect or fell behind.
 type ClientUpdateSystem struct {
-	name     string
-	eventBus *events.EventBus
-	clients  map[uuid.UUID]interfaces.GameClientInterface
+	name       string
+	eventBus   *events.EventBus
+	worldState *types.WorldState
+	clients    interfaces.ClientRegistry
 
-	subscriptions []func()
+	tick      int64
+	sequences map[uuid.UUID]uint64              // last sequence number sent to each player
+	history   map[uuid.UUID][]*sequencedMessage // recent messages of each player, oldest first
+
+	subscriptions []events.SubscriptionID
 	mu            sync.RWMutex
 }
 
-func NewClientUpdateSystem(eventBus *events.EventBus, clients map[uuid.UUID]interfaces.GameClientInterface) *ClientUpdateSystem {
+// ReplayBufferSize is the number of messages kept per player for replay
+const ReplayBufferSize = 512
+
+// sequencedMessage is a message of a player's update stream
+type sequencedMessage struct {
+	sequence uint64
+	message  *messages.GameMessage
+}
+
+func NewClientUpdateSystem(eventBus *events.EventBus, worldState *types.WorldState, clients interfaces.ClientRegistry) *ClientUpdateSystem {
 	return &ClientUpdateSystem{
 		name:          "ClientUpdateSystem",
 		eventBus:      eventBus,
+		worldState:    worldState,
 		clients:       clients,
-		subscriptions: make([]func(), 0),
+		sequences:     make(map[uuid.UUID]uint64),
+		history:       make(map[uuid.UUID][]*sequencedMessage),
+		subscriptions: make([]events.SubscriptionID, 0),
 	}
 }
 
@@ -35,11 +62,26 @@ func (s *ClientUpdateSystem) Initialize() error {
 
 	// Subscribe to all events that should be sent to clients
 	s.subscriptions = append(s.subscriptions,
-		s.eventBus.Subscribe("ship_built", s.handleShipBuilt),
-		s.eventBus.Subscribe("fleet_moved", s.handleFleetMoved),
-		s.eventBus.Subscribe("game_state_update", s.handleGameStateUpdate),
-		s.eventBus.Subscribe("player_joined", s.handlePlayerJoined),
+		events.Subscribe(s.eventBus, "ship_built", s.handleShipBuilt),
+		events.Subscribe(s.eventBus, "building_built", s.handleBuildingBuilt),
+		events.Subscribe(s.eventBus, "build_queue_updated", s.handleBuildQueueUpdated),
+		events.Subscribe(s.eventBus, "empire_income", s.handleEmpireIncome),
+		events.Subscribe(s.eventBus, "colony_founded", s.handleColonyFounded),
+		events.Subscribe(s.eventBus, "colony_updated", s.handleColonyUpdated),
+		events.Subscribe(s.eventBus, "system_owner_changed", s.handleSystemOwnerChanged),
+		events.Subscribe(s.eventBus, "tech_researched", s.handleTechResearched),
+		events.Subscribe(s.eventBus, "research_updated", s.handleResearchUpdated),
+		events.Subscribe(s.eventBus, "vision_changed", s.handleVisionChanged),
+		events.Subscribe(s.eventBus, "fleet_moved", s.handleFleetMoved),
+		events.Subscribe(s.eventBus, "fleet_arrived", s.handleFleetArrived),
+		events.Subscribe(s.eventBus, "game_tick", s.handleGameTick),
+		events.Subscribe(s.eventBus, "clock_changed", s.handleClockChanged),
+		events.Subscribe(s.eventBus, "resync_requested", s.handleResyncRequested),
 		s.eventBus.Subscribe("game_started", s.handleGameStarted),
+		events.Subscribe(s.eventBus, "command_rejected", s.handleCommandRejected),
+		s.eventBus.Subscribe("battle_started", s.handleBattleEvent),
+		s.eventBus.Subscribe("battle_round", s.handleBattleEvent),
+		s.eventBus.Subscribe("battle_ended", s.handleBattleEvent),
 	)
 
 	return nil
@@ -48,9 +90,7 @@ func (s *ClientUpdateSystem) Initialize() error {
 func (s *ClientUpdateSystem) Shutdown() error {
 	log.Printf("Shutting down %s", s.name)
 
-	for _, unsubscribe := range s.subscriptions {
-		unsubscribe()
-	}
+	s.eventBus.Unsubscribe(s.subscriptions...)
 	s.subscriptions = nil
 
 	return nil
@@ -60,31 +100,439 @@ func (s *ClientUpdateSystem) GetName() string {
 	return s.name
 }
 
-func (s *ClientUpdateSystem) handleShipBuilt(event events.GameEvent) {
+func (s *ClientUpdateSystem) handleShipBuilt(built *types.ShipBuiltEvent) {
+	s.sendGameEvent("SHIP_BUILT", built, []uuid.UUID{built.PlayerID})
+}
 
+func (s *ClientUpdateSystem) handleBuildingBuilt(built *types.BuildingBuiltEvent) {
+	s.sendGameEvent("BUILDING_BUILT", built, []uuid.UUID{built.PlayerID})
 }
 
-func (s *ClientUpdateSystem) handleFleetMoved(event events.GameEvent) {
+func (s *ClientUpdateSystem) handleBuildQueueUpdated(updated *types.BuildQueueUpdatedEvent) {
+	s.sendGameEvent("BUILD_QUEUE_UPDATED", updated, []uuid.UUID{updated.PlayerID})
+}
 
+func (s *ClientUpdateSystem) handleEmpireIncome(income *types.EmpireIncomeEvent) {
+	s.sendDelta([]uuid.UUID{income.PlayerID}, &messages.GameDeltaMessage{
+		Delta: &messages.GameDeltaMessage_ResourcesChanged{
+			ResourcesChanged: &messages.ResourcesChangedDelta{
+				Resources: resourceAmounts(income.Resources),
+				Income:    resourceAmounts(income.Income.Net),
+			},
+		},
+	})
 }
 
-func (s *ClientUpdateSystem) handleGameStateUpdate(event events.GameEvent) {
+func (s *ClientUpdateSystem) handleColonyFounded(founded *types.ColonyFoundedEvent) {
+	s.sendGameEvent("COLONY_FOUNDED", founded, []uuid.UUID{founded.PlayerID})
+}
 
+func (s *ClientUpdateSystem) handleColonyUpdated(updated *types.ColonyUpdatedEvent) {
+	s.sendGameEvent("COLONY_UPDATED", updated, []uuid.UUID{updated.PlayerID})
 }
 
-func (s *ClientUpdateSystem) handlePlayerJoined(event events.GameEvent) {
+// handleSystemOwnerChanged tells the old and new owner and every player who
+// can see the system about the change
+func (s *ClientUpdateSystem) handleSystemOwnerChanged(changed *types.SystemOwnerChangedEvent) {
+	var owners []uuid.UUID
+	if changed.PreviousOwner != nil {
+		owners = append(owners, *changed.PreviousOwner)
+	}
+	if changed.Owner != nil {
+		owners = append(owners, *changed.Owner)
+	}
+
+	delta := &messages.SystemOwnerChangedDelta{SystemId: refOf(s.worldState.IDs, changed.SystemID)}
+	if changed.PreviousOwner != nil {
+		delta.PreviousOwnerEmpireId = changed.PreviousOwner.String()
+	}
+	if changed.Owner != nil {
+		delta.OwnerEmpireId = changed.Owner.String()
+	}
+
+	s.worldState.AcquireLock()
+	recipients := s.observers(owners, changed.SystemID)
+	s.worldState.ReleaseLock()
+
+	s.sendDelta(recipients, &messages.GameDeltaMessage{
+		Delta: &messages.GameDeltaMessage_SystemOwnerChanged{SystemOwnerChanged: delta},
+	})
+}
+
+func (s *ClientUpdateSystem) handleTechResearched(researched *types.TechResearchedEvent) {
+	s.sendGameEvent("TECH_COMPLETED", researched, []uuid.UUID{researched.PlayerID})
+}
+
+func (s *ClientUpdateSystem) handleResearchUpdated(updated *types.ResearchUpdatedEvent) {
+	s.sendGameEvent("RESEARCH_UPDATED", updated, []uuid.UUID{updated.PlayerID})
+}
+
+// handleVisionChanged sends the contents of newly visible systems to the owner
+func (s *ClientUpdateSystem) handleVisionChanged(changed *types.VisionChangedEvent) {
+	delta := &messages.VisionChangedDelta{
+		Revealed: make([]*messages.SystemSnapshot, 0, len(changed.Revealed)),
+		Hidden:   make([]uint64, 0, len(changed.Hidden)),
+	}
+
+	s.worldState.AcquireLock()
+	empire, exists := s.worldState.Empires[changed.PlayerID]
+	if !exists {
+		s.worldState.ReleaseLock()
+		return
+	}
+	for i := range changed.Revealed {
+		intel := &changed.Revealed[i]
+		system, exists := s.worldState.Galaxy.GetSystem(intel.SystemID)
+		if !exists {
+			continue
+		}
+		delta.Revealed = append(delta.Revealed, systemSnapshot(s.worldState, empire, system, intel, true))
+		for _, fleet := range intel.Fleets {
+			if live, exists := system.Fleets[fleet.ID]; exists && fleet.Owner != empire.ID {
+				delta.Fleets = append(delta.Fleets, fleetSnapshot(s.worldState.IDs, live))
+			}
+		}
+	}
+	s.worldState.ReleaseLock()
+
+	for _, systemID := range changed.Hidden {
+		delta.Hidden = append(delta.Hidden, refOf(s.worldState.IDs, systemID))
+	}
 
+	s.sendDelta([]uuid.UUID{changed.PlayerID}, &messages.GameDeltaMessage{
+		Delta: &messages.GameDeltaMessage_VisionChanged{VisionChanged: delta},
+	})
 }
 
+// handleFleetMoved tells the owner and every player who can see either end of
+// the hyperlane that a fleet is on its way
+func (s *ClientUpdateSystem) handleFleetMoved(moved *types.FleetMovedEvent) {
+	s.worldState.AcquireLock()
+	fleet := s.findFleet(moved.Owner, moved.FleetID)
+	if fleet == nil {
+		s.worldState.ReleaseLock()
+		return
+	}
+	delta := &messages.FleetMovedDelta{
+		Fleet:        fleetSnapshot(s.worldState.IDs, fleet),
+		FromSystemId: refOf(s.worldState.IDs, moved.FromSystem),
+		ToSystemId:   refOf(s.worldState.IDs, moved.ToSystem),
+		ArrivalTime:  moved.ArrivalTime,
+	}
+	recipients := s.observers([]uuid.UUID{moved.Owner}, moved.FromSystem, moved.ToSystem)
+	s.worldState.ReleaseLock()
+
+	s.sendDelta(recipients, &messages.GameDeltaMessage{
+		Delta: &messages.GameDeltaMessage_FleetMoved{FleetMoved: delta},
+	})
+}
+
+func (s *ClientUpdateSystem) handleFleetArrived(arrived *types.FleetArrivedEvent) {
+	s.worldState.AcquireLock()
+	fleet := s.findFleet(arrived.Owner, arrived.FleetID)
+	if fleet == nil {
+		s.worldState.ReleaseLock()
+		return
+	}
+	delta := &messages.FleetArrivedDelta{
+		Fleet:    fleetSnapshot(s.worldState.IDs, fleet),
+		SystemId: refOf(s.worldState.IDs, arrived.SystemID),
+	}
+	recipients := s.observers([]uuid.UUID{arrived.Owner}, arrived.SystemID)
+	s.worldState.ReleaseLock()
+
+	s.sendDelta(recipients, &messages.GameDeltaMessage{
+		Delta: &messages.GameDeltaMessage_FleetArrived{FleetArrived: delta},
+	})
+}
+
+func (s *ClientUpdateSystem) handleGameTick(tickEvent *types.GameTickEvent) {
+	s.mu.Lock()
+	s.tick = int64(tickEvent.Tick)
+	s.mu.Unlock()
+
+	// Tell every player when a new in-game day starts
+	if !tickEvent.Crossed(1) {
+		return
+	}
+
+	s.worldState.AcquireLock()
+	yearsPerHour := s.worldState.Clock.YearsPerHour
+	s.worldState.ReleaseLock()
+
+	s.BroadcastToAll(&messages.ServerMessage{
+		Timestamp: time.Now().UnixMilli(),
+		Message: &messages.ServerMessage_GameMessage{
+			GameMessage: &messages.GameMessage{
+				Content: &messages.GameMessage_TurnUpdate{
+					TurnUpdate: &messages.TurnUpdateMessage{
+						TurnNumber:   int64(tickEvent.Tick),
+						Date:         gameDate(tickEvent.Now),
+						YearsPerHour: yearsPerHour,
+					},
+				},
+			},
+		},
+	})
+}
+
+// handleClockChanged tells every player that the game was paused, resumed or
+// changed speed
+func (s *ClientUpdateSystem) handleClockChanged(changed *types.ClockChangedEvent) {
+	s.mu.RLock()
+	tick := s.tick
+	s.mu.RUnlock()
+
+	s.BroadcastToAll(&messages.ServerMessage{
+		Timestamp: time.Now().UnixMilli(),
+		Message: &messages.ServerMessage_GameMessage{
+			GameMessage: &messages.GameMessage{
+				Content: &messages.GameMessage_TurnUpdate{
+					TurnUpdate: &messages.TurnUpdateMessage{
+						TurnNumber:   tick,
+						IsPaused:     changed.Paused,
+						Date:         gameDate(changed.Now),
+						YearsPerHour: changed.YearsPerHour,
+						PauseReason:  changed.Reason,
+					},
+				},
+			},
+		},
+	})
+}
+
+// handleGameStarted sends the first snapshot to every player of the game
 func (s *ClientUpdateSystem) handleGameStarted(event events.GameEvent) {
+	s.worldState.AcquireLock()
+	players := make([]uuid.UUID, 0, len(s.worldState.Empires))
+	for playerID := range s.worldState.Empires {
+		players = append(players, playerID)
+	}
+	s.worldState.ReleaseLock()
 
+	for _, playerID := range players {
+		s.sendSnapshot(playerID)
+	}
 }
 
-func (s *ClientUpdateSystem) SendToPlayer(playerID uuid.UUID, message *messages.ServerMessage) {
+// handleResyncRequested replays the messages a client missed, or sends a
+// snapshot if they are no longer buffered or the client has no state yet
+func (s *ClientUpdateSystem) handleResyncRequested(requested *types.ResyncRequestedEvent) {
+	missed, ok := s.missedMessages(requested.PlayerID, requested.LastSequence)
+	if !ok {
+		s.sendSnapshot(requested.PlayerID)
+		return
+	}
+
+	for _, m := range missed {
+		s.SendToPlayer(requested.PlayerID, &messages.ServerMessage{
+			Timestamp: time.Now().UnixMilli(),
+			Message: &messages.ServerMessage_GameMessage{
+				GameMessage: m.message,
+			},
+		})
+	}
+}
+
+// missedMessages returns the buffered messages after lastSequence. It reports
+// false if the client has no state or some of the messages were dropped.
+func (s *ClientUpdateSystem) missedMessages(playerID uuid.UUID, lastSequence uint64) ([]*sequencedMessage, bool) {
 	s.mu.RLock()
-	client, exists := s.clients[playerID]
+	defer s.mu.RUnlock()
+
+	current := s.sequences[playerID]
+	if lastSequence == 0 || lastSequence > current {
+		return nil, false
+	}
+
+	history := s.history[playerID]
+	if lastSequence == current {
+		return nil, true
+	}
+	if len(history) == 0 || history[0].sequence > lastSequence+1 {
+		return nil, false
+	}
+
+	start := len(history) - int(current-lastSequence)
+	return append([]*sequencedMessage(nil), history[start:]...), true
+}
+
+func (s *ClientUpdateSystem) handleCommandRejected(rejected *types.CommandRejectedEvent) {
+	s.SendToPlayer(rejected.PlayerID, &messages.ServerMessage{
+		Timestamp: time.Now().UnixMilli(),
+		Message: &messages.ServerMessage_ErrorMessage{
+			ErrorMessage: &messages.ErrorMessage{
+				ErrorCode:    rejected.ErrorCode,
+				ErrorMessage: rejected.Message,
+				Context:      rejected.Context,
+			},
+		},
+	})
+}
+
+// handleBattleEvent forwards battle events to the players whose empires take
+// part and to every player who can see the system
+func (s *ClientUpdateSystem) handleBattleEvent(event events.GameEvent) {
+	var participants []uuid.UUID
+	var systemID uuid.UUID
+	var eventType string
+
+	switch e := event.(type) {
+	case *types.BattleStartedEvent:
+		participants, systemID, eventType = e.Participants, e.SystemID, "BATTLE_STARTED"
+	case *types.BattleRoundEvent:
+		participants, systemID, eventType = e.Participants, e.SystemID, "BATTLE_ROUND"
+	case *types.BattleEndedEvent:
+		participants, systemID, eventType = e.Participants, e.SystemID, "BATTLE_ENDED"
+	default:
+		return
+	}
+
+	s.worldState.AcquireLock()
+	recipients := s.observers(participants, systemID)
+	s.worldState.ReleaseLock()
+
+	s.sendGameEvent(eventType, event, recipients)
+}
+
+// sendGameEvent sends an event as JSON to the given players
+func (s *ClientUpdateSystem) sendGameEvent(eventType string, event events.GameEvent, playerIDs []uuid.UUID) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("Failed to encode %s event: %v", eventType, err)
+		return
+	}
+
+	affected := make([]string, len(playerIDs))
+	for i, id := range playerIDs {
+		affected[i] = id.String()
+	}
+
+	for _, playerID := range playerIDs {
+		s.sendGameMessage(playerID, func(sequence uint64) *messages.GameMessage {
+			return &messages.GameMessage{
+				Content: &messages.GameMessage_GameEvent{
+					GameEvent: &messages.GameEventMessage{
+						EventType:       eventType,
+						EventData:       string(data),
+						AffectedPlayers: affected,
+						Sequence:        sequence,
+					},
+				},
+			}
+		})
+	}
+}
+
+// sendDelta sends a delta to the given players. The sequence number and tick
+// are filled in for every player.
+func (s *ClientUpdateSystem) sendDelta(playerIDs []uuid.UUID, delta *messages.GameDeltaMessage) {
+	s.mu.RLock()
+	tick := s.tick
 	s.mu.RUnlock()
 
+	for _, playerID := range playerIDs {
+		s.sendGameMessage(playerID, func(sequence uint64) *messages.GameMessage {
+			return &messages.GameMessage{
+				Content: &messages.GameMessage_Delta{
+					Delta: &messages.GameDeltaMessage{
+						Sequence: sequence,
+						Tick:     tick,
+						Delta:    delta.Delta,
+					},
+				},
+			}
+		})
+	}
+}
+
+// sendGameMessage sends a message as the next one of the player's update
+// stream. Sequence numbers are used up even while the player is offline.
+func (s *ClientUpdateSystem) sendGameMessage(playerID uuid.UUID, build func(sequence uint64) *messages.GameMessage) {
+	s.mu.Lock()
+	s.sequences[playerID]++
+	sequence := s.sequences[playerID]
+	message := build(sequence)
+
+	history := append(s.history[playerID], &sequencedMessage{sequence: sequence, message: message})
+	if len(history) > ReplayBufferSize {
+		history = history[len(history)-ReplayBufferSize:]
+	}
+	s.history[playerID] = history
+	s.mu.Unlock()
+
+	s.SendToPlayer(playerID, &messages.ServerMessage{
+		Timestamp: time.Now().UnixMilli(),
+		Message: &messages.ServerMessage_GameMessage{
+			GameMessage: message,
+		},
+	})
+}
+
+// sendSnapshot sends everything the player's empire can see. The snapshot is
+// tagged with the last sequence number sent, so the client knows which delta
+// comes next.
+func (s *ClientUpdateSystem) sendSnapshot(playerID uuid.UUID) {
+	s.worldState.AcquireLock()
+	empire, exists := s.worldState.Empires[playerID]
+	if !exists {
+		s.worldState.ReleaseLock()
+		return
+	}
+	snapshot := buildSnapshot(s.worldState, empire)
+	s.worldState.ReleaseLock()
+
+	s.mu.RLock()
+	snapshot.Sequence = s.sequences[playerID]
+	snapshot.Tick = s.tick
+	s.mu.RUnlock()
+	snapshot.GameTime = time.Now().UnixMilli()
+
+	s.SendToPlayer(playerID, &messages.ServerMessage{
+		Timestamp: time.Now().UnixMilli(),
+		Message: &messages.ServerMessage_GameMessage{
+			GameMessage: &messages.GameMessage{
+				Content: &messages.GameMessage_Snapshot{Snapshot: snapshot},
+			},
+		},
+	})
+}
+
+// findFleet looks up a fleet of an empire. Must be called with the world lock held.
+func (s *ClientUpdateSystem) findFleet(empireID, fleetID uuid.UUID) *types.Fleet {
+	empire, exists := s.worldState.GetEmpireByID(empireID)
+	if !exists {
+		return nil
+	}
+	return empire.TotalFleets[fleetID]
+}
+
+// observers returns the players owning the given empires together with every
+// player whose empire can see one of the systems, without duplicates. Must be
+// called with the world lock held.
+func (s *ClientUpdateSystem) observers(empireIDs []uuid.UUID, systemIDs ...uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool)
+	var players []uuid.UUID
+	add := func(playerID uuid.UUID) {
+		if !seen[playerID] {
+			seen[playerID] = true
+			players = append(players, playerID)
+		}
+	}
+
+	for _, empireID := range empireIDs {
+		if empire, exists := s.worldState.GetEmpireByID(empireID); exists {
+			add(empire.PlayerID)
+		}
+	}
+	for _, playerID := range s.worldState.Observers(systemIDs...) {
+		add(playerID)
+	}
+	return players
+}
+
+func (s *ClientUpdateSystem) SendToPlayer(playerID uuid.UUID, message *messages.ServerMessage) {
+	client, exists := s.clients.GetClient(playerID)
 	if exists && client != nil {
 		if err := client.SendMessage(message); err != nil {
 			log.Printf("Failed to send message to player %s: %v", playerID, err)
@@ -92,20 +540,18 @@ func (s *ClientUpdateSystem) SendToPlayer(playerID uuid.UUID, message *messages.
 	}
 }
 
+// BroadcastToAll sends a message to every connected client. It must only be
+// used for messages that carry no hidden game state; anything tied to a star
+// system goes to the observers of that system through the update stream.
 func (s *ClientUpdateSystem) BroadcastToAll(message *messages.ServerMessage) {
-	s.mu.RLock()
-	clients := make([]interfaces.GameClientInterface, 0, len(s.clients))
-	for _, client := range s.clients {
-		if client != nil {
-			clients = append(clients, client)
-		}
+	s.worldState.AcquireLock()
+	players := make([]uuid.UUID, 0, len(s.worldState.Empires))
+	for playerID := range s.worldState.Empires {
+		players = append(players, playerID)
 	}
-	s.mu.RUnlock()
+	s.worldState.ReleaseLock()
 
-	// Send to all clients
-	for _, client := range clients {
-		if err := client.SendMessage(message); err != nil {
-			log.Printf("Failed to broadcast message to client: %v", err)
-		}
+	for _, playerID := range players {
+		s.SendToPlayer(playerID, message)
 	}
 }
@@ -0,0 +1,332 @@
+package systems
+
+import (
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
+)
+
+const (
+	// ColonistPopulation is the population a colony ship settles a planet with
+	ColonistPopulation = 2
+
+	// populationGrowthRate is the relative population growth per in-game day
+	// of a colony far below its capacity
+	populationGrowthRate = 0.05
+
+	// ResettlementIntervalDays is the number of in-game days between two resettlements
+	ResettlementIntervalDays = 3
+
+	// resettlementThreshold is the fill ratio below which a colony attracts
+	// settlers from full colonies of the same empire
+	resettlementThreshold = 0.5
+)
+
+// ColonySystem founds colonies and lets their population grow and move
+// between the colonies of an empire
+type ColonySystem struct {
+	name       string
+	eventBus   *events.EventBus
+	worldState *types.WorldState
+	assets     *resource.Assets
+
+	// Subscriptions
+	subscriptions []events.SubscriptionID
+	mu            sync.RWMutex
+}
+
+func NewColonySystem(eventBus *events.EventBus, worldState *types.WorldState, assets *resource.Assets) *ColonySystem {
+	return &ColonySystem{
+		name:          "ColonySystem",
+		eventBus:      eventBus,
+		worldState:    worldState,
+		assets:        assets,
+		subscriptions: make([]events.SubscriptionID, 0),
+	}
+}
+
+func (s *ColonySystem) Initialize() error {
+	log.Printf("Initializing %s", s.name)
+
+	s.subscriptions = append(s.subscriptions,
+		events.Subscribe(s.eventBus, "game_tick", s.handleGameTick),
+		events.Subscribe(s.eventBus, "colonize_command", s.handleColonizeCommand),
+	)
+
+	return nil
+}
+
+func (s *ColonySystem) Shutdown() error {
+	log.Printf("Shutting down %s", s.name)
+
+	s.eventBus.Unsubscribe(s.subscriptions...)
+	s.subscriptions = nil
+
+	return nil
+}
+
+func (s *ColonySystem) GetName() string {
+	return s.name
+}
+
+func (s *ColonySystem) handleColonizeCommand(colonizeEvent *types.ColonizeCommandEvent) {
+	reject := func(code, message string) {
+		rejectCommand(s.eventBus, colonizeEvent.SessionID, colonizeEvent.PlayerID, code, message)
+	}
+
+	s.worldState.AcquireLock()
+
+	empire, exists := s.worldState.Empires[colonizeEvent.PlayerID]
+	if !exists {
+		s.worldState.ReleaseLock()
+		return
+	}
+
+	fleet, exists := empire.TotalFleets[colonizeEvent.FleetID]
+	if !exists {
+		s.worldState.ReleaseLock()
+		reject(ErrCodeUnknownFleet, "unknown fleet")
+		return
+	}
+
+	system, planet, exists := s.worldState.Galaxy.FindPlanet(colonizeEvent.PlanetID)
+	if !exists {
+		s.worldState.ReleaseLock()
+		reject(ErrCodeUnknownPlanet, "unknown planet")
+		return
+	}
+
+	if fleet.InTransit() || fleet.Location != system.ID {
+		s.worldState.ReleaseLock()
+		reject(ErrCodeNotInSystem, "the fleet must be in the planet's system")
+		return
+	}
+
+	shipType, ok := s.colonyShip(fleet)
+	if !ok {
+		s.worldState.ReleaseLock()
+		reject(ErrCodeNoColonyShip, "the fleet has no colony ship")
+		return
+	}
+
+	if planet.ColonyID != nil {
+		s.worldState.ReleaseLock()
+		reject(ErrCodeAlreadyColonized, "the planet is already colonised")
+		return
+	}
+
+	habitability := s.habitability(planet)
+	if habitability <= 0 {
+		s.worldState.ReleaseLock()
+		reject(ErrCodeUninhabitable, "the planet cannot support a population")
+		return
+	}
+
+	if system.Owner != nil && *system.Owner != empire.ID {
+		s.worldState.ReleaseLock()
+		reject(ErrCodeSystemOwned, "the system belongs to another empire")
+		return
+	}
+
+	// The colony ship is used up
+	fleet.Ships[shipType]--
+	if fleet.Ships[shipType] <= 0 {
+		delete(fleet.Ships, shipType)
+	}
+	if fleet.ShipCount() == 0 {
+		system.RemoveFleet(fleet.ID)
+		delete(empire.TotalFleets, fleet.ID)
+	}
+
+	colony := s.worldState.FoundColony(empire, system, planet, habitability, ColonistPopulation)
+	previousOwner, claimed := s.worldState.ClaimSystem(empire, system)
+	s.worldState.ReleaseLock()
+
+	now := time.Now().UnixNano()
+	if claimed {
+		owner := empire.ID
+		s.eventBus.Publish(&types.SystemOwnerChangedEvent{
+			BaseEvent: types.BaseEvent{
+				SessionID: colonizeEvent.SessionID,
+				Type:      "system_owner_changed",
+				Timestamp: now,
+			},
+			SystemID:      system.ID,
+			PreviousOwner: previousOwner,
+			Owner:         &owner,
+		})
+	}
+
+	s.eventBus.Publish(&types.ColonyFoundedEvent{
+		BaseEvent: types.BaseEvent{
+			SessionID: colonizeEvent.SessionID,
+			Type:      "colony_founded",
+			Timestamp: now,
+		},
+		PlayerID:   empire.PlayerID,
+		EmpireID:   empire.ID,
+		ColonyID:   colony.ID,
+		ColonyRef:  colony.Ref,
+		SystemID:   system.ID,
+		PlanetID:   planet.ID,
+		Population: colony.Population,
+	})
+}
+
+func (s *ColonySystem) handleGameTick(tickEvent *types.GameTickEvent) {
+	delta := tickEvent.Days
+
+	s.worldState.AcquireLock()
+	changed := make(map[uuid.UUID]*types.Colony)
+	for _, colony := range s.worldState.Colonies {
+		if s.growPopulation(colony, delta) {
+			changed[colony.ID] = colony
+		}
+	}
+
+	if tickEvent.Crossed(ResettlementIntervalDays) {
+		for _, empire := range s.worldState.SortedEmpires() {
+			for _, colony := range s.resettle(empire) {
+				changed[colony.ID] = colony
+			}
+		}
+	}
+
+	// Updates are published in the same order every time, so that replays
+	// see the same events as the game
+	colonies := make([]*types.Colony, 0, len(changed))
+	for _, colony := range changed {
+		colonies = append(colonies, colony)
+	}
+	sort.Slice(colonies, func(i, j int) bool {
+		return colonies[i].ID.String() < colonies[j].ID.String()
+	})
+
+	pending := make([]events.GameEvent, 0, len(colonies))
+	for _, colony := range colonies {
+		s.syncPlanet(colony)
+
+		owner, exists := s.worldState.GetEmpireByID(colony.Owner)
+		if !exists {
+			continue
+		}
+		pending = append(pending, &types.ColonyUpdatedEvent{
+			BaseEvent: types.BaseEvent{
+				SessionID: tickEvent.SessionID,
+				Type:      "colony_updated",
+				Timestamp: time.Now().UnixNano(),
+			},
+			PlayerID:      owner.PlayerID,
+			ColonyID:      colony.ID,
+			Population:    colony.Population,
+			MaxPopulation: colony.MaxPopulation,
+		})
+	}
+	s.worldState.ReleaseLock()
+
+	for _, e := range pending {
+		s.eventBus.Publish(e)
+	}
+}
+
+// growPopulation applies logistic growth to a colony. Colonies above their
+// capacity shrink. Reports whether the population changed.
+func (s *ColonySystem) growPopulation(colony *types.Colony, delta float64) bool {
+	if colony.MaxPopulation <= 0 || colony.Population <= 0 {
+		return false
+	}
+
+	population := float64(colony.Population)
+	colony.Growth += populationGrowthRate * population * (1 - population/float64(colony.MaxPopulation)) * delta
+
+	switch {
+	case colony.Growth >= 1:
+		colony.Population++
+		colony.Growth--
+	case colony.Growth <= -1:
+		colony.Population--
+		colony.Growth++
+	default:
+		return false
+	}
+	return true
+}
+
+// resettle moves settlers from the empire's full colonies to its emptiest
+// colonies. Returns the colonies whose population changed.
+func (s *ColonySystem) resettle(empire *types.EmpireState) []*types.Colony {
+	var full, sparse []*types.Colony
+	for _, colonyID := range empire.Colonies {
+		colony, exists := s.worldState.Colonies[colonyID]
+		if !exists || colony.MaxPopulation <= 0 {
+			continue
+		}
+
+		switch {
+		case colony.Population >= colony.MaxPopulation && colony.Population > 1:
+			full = append(full, colony)
+		case fillRatio(colony) < resettlementThreshold:
+			sparse = append(sparse, colony)
+		}
+	}
+
+	sort.Slice(sparse, func(i, j int) bool {
+		return fillRatio(sparse[i]) < fillRatio(sparse[j])
+	})
+
+	var changed []*types.Colony
+	for i, source := range full {
+		if i >= len(sparse) {
+			break
+		}
+		target := sparse[i]
+
+		source.Population--
+		target.Population++
+		changed = append(changed, source, target)
+	}
+	return changed
+}
+
+// colonyShip returns the key of a colony ship in the fleet
+func (s *ColonySystem) colonyShip(fleet *types.Fleet) (string, bool) {
+	for shipType, count := range fleet.Ships {
+		if count <= 0 {
+			continue
+		}
+		if st, exists := s.assets.ShipTypeByKey(shipType); exists && st.Colonizer {
+			return shipType, true
+		}
+	}
+	return "", false
+}
+
+func (s *ColonySystem) habitability(planet *types.PlanetState) float64 {
+	if pt, exists := s.assets.PlanetTypeByName(planet.Type); exists {
+		return pt.Habitability
+	}
+	return 0
+}
+
+// syncPlanet copies the colony population to its planet for clients
+func (s *ColonySystem) syncPlanet(colony *types.Colony) {
+	system, exists := s.worldState.Galaxy.GetSystem(colony.SystemID)
+	if !exists {
+		return
+	}
+	for _, planet := range system.Planets {
+		if planet.ID == colony.PlanetID {
+			planet.Population = colony.Population
+		}
+	}
+}
+
+func fillRatio(colony *types.Colony) float64 {
+	return float64(colony.Population) / float64(colony.MaxPopulation)
+}
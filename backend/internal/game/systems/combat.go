@@ -2,12 +2,21 @@ package systems
 
 import (
 	"log"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/gr4vediggr/stellarlight/internal/domain/tech"
 	"github.com/gr4vediggr/stellarlight/internal/game/events"
 	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
+)
+
+const (
+	defaultShipSpeed       = 20.0  // distance units per in-game day
+	defaultHyperlaneLength = 100.0 // used if a hyperlane length is unknown
+	minJumpDays            = 0.1
 )
 
 // CombatSystem handles fleet movement and combat
@@ -15,17 +24,19 @@ type CombatSystem struct {
 	name       string
 	eventBus   *events.EventBus
 	worldState *types.WorldState
+	assets     *resource.Assets
 
-	subscriptions []func()
+	subscriptions []events.SubscriptionID
 	mu            sync.RWMutex
 }
 
-func NewCombatSystem(eventBus *events.EventBus, worldState *types.WorldState) *CombatSystem {
+func NewCombatSystem(eventBus *events.EventBus, worldState *types.WorldState, assets *resource.Assets) *CombatSystem {
 	return &CombatSystem{
 		name:          "CombatSystem",
 		eventBus:      eventBus,
 		worldState:    worldState,
-		subscriptions: make([]func(), 0),
+		assets:        assets,
+		subscriptions: make([]events.SubscriptionID, 0),
 	}
 }
 
@@ -33,8 +44,8 @@ func (s *CombatSystem) Initialize() error {
 	log.Printf("Initializing %s", s.name)
 
 	s.subscriptions = append(s.subscriptions,
-		s.eventBus.Subscribe("game_tick", s.handleGameTick),
-		s.eventBus.Subscribe("fleet_move_command", s.handleFleetMoveCommand),
+		events.Subscribe(s.eventBus, "game_tick", s.handleGameTick),
+		events.Subscribe(s.eventBus, "fleet_move_command", s.handleFleetMoveCommand),
 	)
 
 	return nil
@@ -43,9 +54,7 @@ func (s *CombatSystem) Initialize() error {
 func (s *CombatSystem) Shutdown() error {
 	log.Printf("Shutting down %s", s.name)
 
-	for _, unsubscribe := range s.subscriptions {
-		unsubscribe()
-	}
+	s.eventBus.Unsubscribe(s.subscriptions...)
 	s.subscriptions = nil
 
 	return nil
@@ -55,37 +64,15 @@ func (s *CombatSystem) GetName() string {
 	return s.name
 }
 
-func (s *CombatSystem) handleGameTick(event events.GameEvent) {
+func (s *CombatSystem) handleGameTick(tickEvent *types.GameTickEvent) {
 	// Check for arriving fleets every tick
-	s.processFleetArrivals()
+	s.processFleetArrivals(tickEvent.SessionID, tickEvent.Now)
 }
 
-func (s *CombatSystem) handleFleetMoveCommand(event events.GameEvent) {
-	moveEvent := event.(*types.FleetMoveCommandEvent)
-
-	fleetIDStr, ok := moveEvent.Data["fleet_id"].(string)
-	if !ok {
-		return
-	}
-
-	fleetID, err := uuid.Parse(fleetIDStr)
-	if err != nil {
-		return
-	}
-
-	targetSystemIDStr, ok := moveEvent.Data["target_system"].(string)
-	if !ok {
-		return
-	}
-
-	targetSystemID, err := uuid.Parse(targetSystemIDStr)
-	if err != nil {
-		return
-	}
-
+func (s *CombatSystem) handleFleetMoveCommand(moveEvent *types.FleetMoveCommandEvent) {
 	// Find the fleet and move it
-	if fleet := s.findFleet(fleetID, moveEvent.PlayerID); fleet != nil {
-		s.moveFleet(fleet, targetSystemID, moveEvent.SessionID)
+	if fleet := s.findFleet(moveEvent.FleetID, moveEvent.PlayerID); fleet != nil {
+		s.moveFleet(fleet, moveEvent.TargetSystemID, moveEvent.SessionID, moveEvent.PlayerID)
 	}
 }
 
@@ -105,42 +92,116 @@ func (s *CombatSystem) findFleet(fleetID, playerID uuid.UUID) *types.Fleet {
 	return nil
 }
 
-func (s *CombatSystem) moveFleet(fleet *types.Fleet, targetSystemID, SessionID uuid.UUID) {
-	// Calculate travel time (simplified)
-	travelTime := s.calculateTravelTime(fleet.Location, targetSystemID)
-	arrivalTime := time.Now().Add(travelTime).Unix()
+func (s *CombatSystem) moveFleet(fleet *types.Fleet, targetSystemID, sessionID uuid.UUID, playerID uuid.UUID) {
+	s.worldState.AcquireLock()
+
+	// A fleet already in transit finishes its current jump first
+	origin := fleet.Location
+	if fleet.Destination != nil {
+		origin = *fleet.Destination
+	}
+
+	var path []uuid.UUID
+	if origin != targetSystemID {
+		path = s.worldState.Galaxy.FindPath(origin, targetSystemID)
+		if len(path) == 0 {
+			s.worldState.ReleaseLock()
+			rejectCommand(s.eventBus, sessionID, playerID, ErrCodeUnreachable, "destination cannot be reached through hyperlanes")
+			return
+		}
+	}
+
+	fleet.Path = path
+	if fleet.Destination != nil {
+		s.worldState.ReleaseLock()
+		return
+	}
+
+	event := s.startNextJump(fleet, sessionID, s.worldState.Clock.Days)
+	s.worldState.ReleaseLock()
+
+	if event != nil {
+		s.eventBus.Publish(event)
+	}
+}
+
+// startNextJump sends the fleet along the next hyperlane of its path, starting
+// on in-game day now. Must be called with the world lock held; the returned
+// event is published by the caller.
+func (s *CombatSystem) startNextJump(fleet *types.Fleet, sessionID uuid.UUID, now float64) *types.FleetMovedEvent {
+	if len(fleet.Path) == 0 {
+		fleet.Destination = nil
+		fleet.ArrivalTime = nil
+		fleet.Path = nil
+		return nil
+	}
+
+	next := fleet.Path[0]
+	fleet.Path = fleet.Path[1:]
 
-	// Update fleet destination
-	fleet.Destination = &targetSystemID
+	arrivalTime := now + s.calculateTravelTime(fleet, fleet.Location, next)
+
+	fleet.Destination = &next
 	fleet.ArrivalTime = &arrivalTime
 
-	// Publish fleet moved event
-	s.eventBus.Publish(&types.FleetMovedEvent{
+	return &types.FleetMovedEvent{
 		BaseEvent: types.BaseEvent{
-			SessionID: SessionID,
+			SessionID: sessionID,
 			Type:      "fleet_moved",
 			Timestamp: time.Now().UnixNano(),
 		},
 		FleetID:     fleet.ID,
+		Owner:       fleet.Owner,
 		FromSystem:  fleet.Location,
-		ToSystem:    targetSystemID,
+		ToSystem:    next,
 		ArrivalTime: arrivalTime,
-	})
+	}
 }
 
-func (s *CombatSystem) processFleetArrivals() {
-	currentTime := time.Now().Unix()
-
+func (s *CombatSystem) processFleetArrivals(sessionID uuid.UUID, now float64) {
 	s.worldState.AcquireLock()
-	defer s.worldState.ReleaseLock()
+	var pending []events.GameEvent
+	for _, fleet := range s.arrivingFleets(now) {
+		s.processFleetArrival(fleet)
+		if event := s.startNextJump(fleet, sessionID, now); event != nil {
+			pending = append(pending, event)
+		} else {
+			// Final stop, battles are only fought by fleets that stay
+			pending = append(pending, &types.FleetArrivedEvent{
+				BaseEvent: types.BaseEvent{
+					SessionID: sessionID,
+					Type:      "fleet_arrived",
+					Timestamp: time.Now().UnixNano(),
+				},
+				FleetID:  fleet.ID,
+				SystemID: fleet.Location,
+				Owner:    fleet.Owner,
+			})
+		}
+	}
+	s.worldState.ReleaseLock()
+
+	for _, event := range pending {
+		s.eventBus.Publish(event)
+	}
+}
 
+// arrivingFleets returns the fleets that reach their next system by now,
+// sorted by ID so that arrivals are handled in the same order every time.
+// Must be called with the world lock held.
+func (s *CombatSystem) arrivingFleets(now float64) []*types.Fleet {
+	var arriving []*types.Fleet
 	for _, empire := range s.worldState.Empires {
 		for _, fleet := range empire.TotalFleets {
-			if fleet.ArrivalTime != nil && *fleet.ArrivalTime <= currentTime {
-				s.processFleetArrival(fleet)
+			if fleet.ArrivalTime != nil && *fleet.ArrivalTime <= now {
+				arriving = append(arriving, fleet)
 			}
 		}
 	}
+	sort.Slice(arriving, func(i, j int) bool {
+		return arriving[i].ID.String() < arriving[j].ID.String()
+	})
+	return arriving
 }
 
 func (s *CombatSystem) processFleetArrival(fleet *types.Fleet) {
@@ -162,12 +223,51 @@ func (s *CombatSystem) processFleetArrival(fleet *types.Fleet) {
 	// Clear destination and arrival time
 	fleet.Destination = nil
 	fleet.ArrivalTime = nil
+}
 
-	// TODO: Check for combat if enemy fleets present
+// calculateTravelTime returns how many in-game days the fleet takes to cross
+// the hyperlane between two adjacent systems. The slowest ship sets the pace
+// of the fleet.
+func (s *CombatSystem) calculateTravelTime(fleet *types.Fleet, from, to uuid.UUID) float64 {
+	length, ok := s.worldState.Galaxy.HyperlaneLength(from, to)
+	if !ok {
+		length = defaultHyperlaneLength
+	}
+
+	days := length / s.getFleetSpeed(fleet)
+	if days < minJumpDays {
+		days = minJumpDays
+	}
+
+	return days
 }
 
-func (s *CombatSystem) calculateTravelTime(from, to uuid.UUID) time.Duration {
-	// Simplified travel time calculation
-	// In a real game, this would consider distance, fleet speed, etc.
-	return 30 * time.Second
+func (s *CombatSystem) getFleetSpeed(fleet *types.Fleet) float64 {
+	empire, _ := s.worldState.GetEmpireByID(fleet.Owner)
+
+	speed := 0.0
+	for shipType, count := range fleet.Ships {
+		if count <= 0 {
+			continue
+		}
+		if shipSpeed := s.getShipSpeed(empire, shipType); speed == 0 || shipSpeed < speed {
+			speed = shipSpeed
+		}
+	}
+	if speed <= 0 {
+		speed = defaultShipSpeed
+	}
+
+	return speed * techModifier(s.assets, empire, tech.EffectTravelSpeed, "", "")
+}
+
+// getShipSpeed returns the speed of a ship type in distance units per in-game day,
+// including the technology bonuses of the owning empire
+func (s *CombatSystem) getShipSpeed(empire *types.EmpireState, shipType string) float64 {
+	speed := defaultShipSpeed
+	if st, exists := s.assets.ShipTypeByKey(shipType); exists && st.Speed > 0 {
+		speed = st.Speed
+	}
+
+	return speed * techModifier(s.assets, empire, tech.EffectShipStat, tech.StatSpeed, shipType)
 }
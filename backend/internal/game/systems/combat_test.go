@@ -0,0 +1,173 @@
+package systems_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/domain/galaxy"
+	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/systems"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
+)
+
+func loadAssets(t *testing.T) *resource.Assets {
+	t.Helper()
+	assets, err := resource.LoadAssetsFromDirs([]string{"../../../assets/"})
+	if err != nil {
+		t.Fatalf("Failed to load assets: %v", err)
+	}
+	return assets
+}
+
+// addFleet stations a new fleet of an empire in a system
+func addFleet(world *types.WorldState, empire *types.EmpireState, system *types.StarSystemState, ships map[string]int) *types.Fleet {
+	fleet := &types.Fleet{ID: uuid.New(), Owner: empire.ID, Ships: ships, Location: system.ID}
+	fleet.Ref = world.IDs.Register(fleet.ID)
+	empire.TotalFleets[fleet.ID] = fleet
+	system.AddFleet(fleet)
+	return fleet
+}
+
+func tick(eventBus *events.EventBus, sessionID uuid.UUID, now, days float64) {
+	eventBus.Publish(&types.GameTickEvent{
+		BaseEvent: types.BaseEvent{SessionID: sessionID, Type: "game_tick"},
+		Days:      days,
+		Now:       now,
+	})
+}
+
+// movementWorld returns a world with four systems connected in a line, one
+// fighter jump (a day) apart, and a fifth system without hyperlanes
+func movementWorld() (*types.WorldState, []uuid.UUID) {
+	g := galaxy.NewGalaxy("Test Galaxy")
+	systems := make([]*galaxy.StarSystem, 5)
+	for i := range systems {
+		systems[i] = &galaxy.StarSystem{LocationX: float64(i) * 40}
+		g.AddStarSystem(systems[i])
+	}
+	for i := 1; i < 4; i++ {
+		systems[i-1].ConnectedSystems = append(systems[i-1].ConnectedSystems, systems[i].ID)
+	}
+
+	world := types.NewWorldState()
+	world.Galaxy = types.NewGalaxyStateFromGalaxy(g)
+
+	ids := make([]uuid.UUID, len(systems))
+	for i, system := range systems {
+		ids[i] = system.ID
+	}
+	return world, ids
+}
+
+func TestFleetMovesOneHyperlanePerJump(t *testing.T) {
+	world, route := movementWorld()
+	eventBus := events.NewEventBus()
+	combat := systems.NewCombatSystem(eventBus, world, loadAssets(t))
+	if err := combat.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize: %v", err)
+	}
+	defer combat.Shutdown()
+
+	empire := types.NewEmpireState(uuid.New(), "Mover")
+	world.Empires[empire.PlayerID] = empire
+	start, _ := world.Galaxy.GetSystem(route[0])
+	fleet := addFleet(world, empire, start, map[string]int{"fighter": 2})
+
+	var moved []*types.FleetMovedEvent
+	events.Subscribe(eventBus, "fleet_moved", func(e *types.FleetMovedEvent) {
+		moved = append(moved, e)
+	})
+	var arrived []*types.FleetArrivedEvent
+	events.Subscribe(eventBus, "fleet_arrived", func(e *types.FleetArrivedEvent) {
+		arrived = append(arrived, e)
+	})
+
+	sessionID := uuid.New()
+	eventBus.Publish(&types.FleetMoveCommandEvent{
+		BaseEvent:      types.BaseEvent{SessionID: sessionID, Type: "fleet_move_command"},
+		PlayerID:       empire.PlayerID,
+		FleetID:        fleet.ID,
+		TargetSystemID: route[3],
+	})
+
+	for jump := 1; jump <= 3; jump++ {
+		if len(moved) != jump {
+			t.Fatalf("Expected %d fleet moved events before jump %d ends, got %d", jump, jump, len(moved))
+		}
+		event := moved[jump-1]
+		if event.FromSystem != route[jump-1] || event.ToSystem != route[jump] {
+			t.Errorf("Expected jump %d to go from system %d to %d", jump, jump-1, jump)
+		}
+		if event.ArrivalTime != float64(jump) {
+			t.Errorf("Expected jump %d to arrive on day %d, got %v", jump, jump, event.ArrivalTime)
+		}
+		if fleet.Location != route[jump-1] || fleet.Destination == nil || *fleet.Destination != route[jump] {
+			t.Errorf("Expected the fleet to be on the hyperlane of jump %d", jump)
+		}
+
+		tick(eventBus, sessionID, float64(jump), 1)
+
+		if fleet.Location != route[jump] {
+			t.Errorf("Expected the fleet to reach system %d after jump %d", jump, jump)
+		}
+	}
+
+	if len(moved) != 3 {
+		t.Errorf("Expected one fleet moved event per jump, got %d", len(moved))
+	}
+	if len(arrived) != 1 || arrived[0].SystemID != route[3] {
+		t.Errorf("Expected a single arrival at the destination, got %d", len(arrived))
+	}
+	if fleet.Destination != nil || fleet.ArrivalTime != nil || len(fleet.Path) != 0 {
+		t.Error("Expected the fleet to stop at the destination")
+	}
+	if destination, _ := world.Galaxy.GetSystem(route[3]); destination.Fleets[fleet.ID] != fleet {
+		t.Error("Expected the destination to hold the fleet")
+	}
+	if start.Fleets[fleet.ID] != nil {
+		t.Error("Expected the fleet to have left its start system")
+	}
+}
+
+func TestFleetMoveToUnreachableSystem(t *testing.T) {
+	world, route := movementWorld()
+	eventBus := events.NewEventBus()
+	combat := systems.NewCombatSystem(eventBus, world, loadAssets(t))
+	if err := combat.Initialize(); err != nil {
+		t.Fatalf("Failed to initialize: %v", err)
+	}
+	defer combat.Shutdown()
+
+	empire := types.NewEmpireState(uuid.New(), "Mover")
+	world.Empires[empire.PlayerID] = empire
+	start, _ := world.Galaxy.GetSystem(route[0])
+	fleet := addFleet(world, empire, start, map[string]int{"fighter": 2})
+
+	var moved int
+	events.Subscribe(eventBus, "fleet_moved", func(e *types.FleetMovedEvent) {
+		moved++
+	})
+	var rejected []*types.CommandRejectedEvent
+	events.Subscribe(eventBus, "command_rejected", func(e *types.CommandRejectedEvent) {
+		rejected = append(rejected, e)
+	})
+
+	eventBus.Publish(&types.FleetMoveCommandEvent{
+		BaseEvent:      types.BaseEvent{SessionID: uuid.New(), Type: "fleet_move_command"},
+		PlayerID:       empire.PlayerID,
+		FleetID:        fleet.ID,
+		TargetSystemID: route[4],
+	})
+
+	if len(rejected) != 1 || rejected[0].ErrorCode != systems.ErrCodeUnreachable || rejected[0].PlayerID != empire.PlayerID {
+		t.Fatalf("Expected the order to be rejected as unreachable, got %+v", rejected)
+	}
+	if moved != 0 {
+		t.Errorf("Expected no fleet moved events, got %d", moved)
+	}
+	if fleet.Destination != nil || fleet.ArrivalTime != nil || fleet.Location != route[0] {
+		t.Error("Expected the fleet to stay where it was")
+	}
+}
@@ -0,0 +1,462 @@
+package systems
+
+import (
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
+)
+
+const (
+	// MaxBuildQuantity is the largest number of units a single build item can hold
+	MaxBuildQuantity = 100
+
+	// shipyardSpeedBonus is the ship build speed gained per shipyard level
+	shipyardSpeedBonus = 0.25
+)
+
+// ConstructionSystem manages the build queues of all empires. Every empire has
+// a queue that decides in which order items are paid for, and every colony has
+// a queue that decides in which order items are built. A unit is paid for the
+// moment construction starts, so items wait in the queue until the empire can
+// afford them.
+type ConstructionSystem struct {
+	name       string
+	eventBus   *events.EventBus
+	worldState *types.WorldState
+	assets     *resource.Assets
+
+	// Subscriptions
+	subscriptions []events.SubscriptionID
+	mu            sync.RWMutex
+}
+
+func NewConstructionSystem(eventBus *events.EventBus, worldState *types.WorldState, assets *resource.Assets) *ConstructionSystem {
+	return &ConstructionSystem{
+		name:          "ConstructionSystem",
+		eventBus:      eventBus,
+		worldState:    worldState,
+		assets:        assets,
+		subscriptions: make([]events.SubscriptionID, 0),
+	}
+}
+
+func (s *ConstructionSystem) Initialize() error {
+	log.Printf("Initializing %s", s.name)
+
+	s.subscriptions = append(s.subscriptions,
+		events.Subscribe(s.eventBus, "game_tick", s.handleGameTick),
+		events.Subscribe(s.eventBus, "build_ship_command", s.handleBuildShipCommand),
+		events.Subscribe(s.eventBus, "build_building_command", s.handleBuildBuildingCommand),
+		events.Subscribe(s.eventBus, "reorder_build_queue_command", s.handleReorderCommand),
+		events.Subscribe(s.eventBus, "cancel_build_command", s.handleCancelCommand),
+	)
+
+	return nil
+}
+
+func (s *ConstructionSystem) Shutdown() error {
+	log.Printf("Shutting down %s", s.name)
+
+	s.eventBus.Unsubscribe(s.subscriptions...)
+	s.subscriptions = nil
+
+	return nil
+}
+
+func (s *ConstructionSystem) GetName() string {
+	return s.name
+}
+
+func (s *ConstructionSystem) handleBuildShipCommand(buildEvent *types.BuildShipCommandEvent) {
+	st, exists := s.assets.ShipTypeByKey(buildEvent.ShipType)
+	if !exists {
+		rejectCommand(s.eventBus, buildEvent.SessionID, buildEvent.PlayerID, ErrCodeUnknownShipType, "unknown ship type: "+buildEvent.ShipType)
+		return
+	}
+
+	s.queueItem(buildEvent.SessionID, buildEvent.PlayerID, buildEvent.ColonyID, buildEvent.Quantity, types.BuildKindShip, st.Key, types.NewResourceState(st.Cost), st.BuildTime, 0)
+}
+
+func (s *ConstructionSystem) handleBuildBuildingCommand(buildEvent *types.BuildBuildingCommandEvent) {
+	bt, exists := s.assets.BuildingTypeByKey(buildEvent.BuildingType)
+	if !exists {
+		rejectCommand(s.eventBus, buildEvent.SessionID, buildEvent.PlayerID, ErrCodeUnknownBuildingType, "unknown building type: "+buildEvent.BuildingType)
+		return
+	}
+
+	s.queueItem(buildEvent.SessionID, buildEvent.PlayerID, buildEvent.ColonyID, buildEvent.Quantity, types.BuildKindBuilding, bt.Key, types.NewResourceState(bt.Cost), bt.BuildTime, bt.MaxLevel)
+}
+
+// queueItem appends a new item to the colony and empire queues. For buildings,
+// maxLevel limits the levels that can be built and queued on the colony's planet.
+func (s *ConstructionSystem) queueItem(sessionID, playerID, colonyID uuid.UUID, quantity int, kind types.BuildKind, buildType string, cost types.ResourceState, buildTime float64, maxLevel int) {
+	s.worldState.AcquireLock()
+
+	empire, colony, ok := s.findColony(playerID, colonyID)
+	if !ok {
+		s.worldState.ReleaseLock()
+		rejectCommand(s.eventBus, sessionID, playerID, ErrCodeUnknownColony, "unknown colony")
+		return
+	}
+
+	if kind == types.BuildKindBuilding && !buildingUnlocked(s.assets, empire, buildType) {
+		s.worldState.ReleaseLock()
+		rejectCommand(s.eventBus, sessionID, playerID, ErrCodeBuildingLocked, "research the technology for this building first: "+buildType)
+		return
+	}
+
+	if kind == types.BuildKindBuilding && s.plannedLevel(colony, buildType)+quantity > maxLevel {
+		s.worldState.ReleaseLock()
+		rejectCommand(s.eventBus, sessionID, playerID, ErrCodeMaxLevelReached, "building cannot be upgraded any further: "+buildType)
+		return
+	}
+
+	if kind == types.BuildKindBuilding && s.plannedLevel(colony, "")+quantity > colony.IndustrySlots {
+		s.worldState.ReleaseLock()
+		rejectCommand(s.eventBus, sessionID, playerID, ErrCodeNoIndustrySlots, "the planet has no room for more buildings")
+		return
+	}
+
+	item := types.NewBuildItem(s.worldState.IDs.NewID(), kind, buildType, colony.ID, quantity, cost, buildTime)
+	item.Ref = s.worldState.IDs.Register(item.ID)
+	colony.BuildQueue = append(colony.BuildQueue, item)
+	empire.BuildQueue = append(empire.BuildQueue, item)
+
+	event := s.queueUpdatedEvent(sessionID, empire)
+	s.worldState.ReleaseLock()
+
+	s.eventBus.Publish(event)
+}
+
+func (s *ConstructionSystem) handleReorderCommand(reorderEvent *types.ReorderBuildQueueCommandEvent) {
+	itemID := reorderEvent.ItemID
+	s.worldState.AcquireLock()
+
+	empire, exists := s.worldState.Empires[reorderEvent.PlayerID]
+	if !exists {
+		s.worldState.ReleaseLock()
+		return
+	}
+
+	index := types.IndexOfBuildItem(empire.BuildQueue, itemID)
+	if index < 0 {
+		s.worldState.ReleaseLock()
+		rejectCommand(s.eventBus, reorderEvent.SessionID, reorderEvent.PlayerID, ErrCodeUnknownBuildItem, "unknown build item")
+		return
+	}
+
+	if reorderEvent.EmpireQueue {
+		types.MoveBuildItem(empire.BuildQueue, index, reorderEvent.Position)
+	} else if colony, exists := s.worldState.Colonies[empire.BuildQueue[index].ColonyID]; exists {
+		if from := types.IndexOfBuildItem(colony.BuildQueue, itemID); from >= 0 {
+			types.MoveBuildItem(colony.BuildQueue, from, reorderEvent.Position)
+		}
+	}
+
+	update := s.queueUpdatedEvent(reorderEvent.SessionID, empire)
+	s.worldState.ReleaseLock()
+
+	s.eventBus.Publish(update)
+}
+
+func (s *ConstructionSystem) handleCancelCommand(cancelEvent *types.CancelBuildCommandEvent) {
+	itemID := cancelEvent.ItemID
+	s.worldState.AcquireLock()
+
+	empire, exists := s.worldState.Empires[cancelEvent.PlayerID]
+	if !exists {
+		s.worldState.ReleaseLock()
+		return
+	}
+
+	index := types.IndexOfBuildItem(empire.BuildQueue, itemID)
+	if index < 0 {
+		s.worldState.ReleaseLock()
+		rejectCommand(s.eventBus, cancelEvent.SessionID, cancelEvent.PlayerID, ErrCodeUnknownBuildItem, "unknown build item")
+		return
+	}
+
+	s.removeItem(empire, index)
+
+	update := s.queueUpdatedEvent(cancelEvent.SessionID, empire)
+	s.worldState.ReleaseLock()
+
+	s.eventBus.Publish(update)
+}
+
+func (s *ConstructionSystem) handleGameTick(tickEvent *types.GameTickEvent) {
+	now := time.Now()
+	delta := tickEvent.Days
+
+	s.worldState.AcquireLock()
+	var pending []events.GameEvent
+	for _, empire := range s.worldState.SortedEmpires() {
+		changed := s.fundItems(empire)
+
+		for _, colonyID := range empire.Colonies {
+			colony, exists := s.worldState.Colonies[colonyID]
+			if !exists || len(colony.BuildQueue) == 0 || !colony.BuildQueue[0].Funded {
+				continue
+			}
+
+			item := colony.BuildQueue[0]
+			item.Progress += delta * s.buildSpeed(colony, item)
+			if item.Progress < item.BuildTime {
+				continue
+			}
+
+			built := s.completeUnit(tickEvent.SessionID, empire, colony, item, now)
+			if built == nil {
+				// The unit has nowhere to go, so the item is dropped and refunded
+				if index := types.IndexOfBuildItem(empire.BuildQueue, item.ID); index >= 0 {
+					s.removeItem(empire, index)
+				}
+				changed = true
+				continue
+			}
+			pending = append(pending, built)
+			item.Quantity--
+			item.Progress = 0
+			item.Funded = false
+			if item.Quantity <= 0 {
+				colony.BuildQueue = colony.BuildQueue[1:]
+				if index := types.IndexOfBuildItem(empire.BuildQueue, item.ID); index >= 0 {
+					empire.BuildQueue = types.RemoveBuildItem(empire.BuildQueue, index)
+				}
+			}
+			changed = true
+		}
+
+		if changed {
+			pending = append(pending, s.queueUpdatedEvent(tickEvent.SessionID, empire))
+		}
+	}
+	s.worldState.ReleaseLock()
+
+	for _, e := range pending {
+		s.eventBus.Publish(e)
+	}
+}
+
+// fundItems pays for the next unit of every item at the front of a colony
+// queue, in empire queue order. Funding stops at the first item the empire
+// cannot afford so that cheaper items further back never starve it. Must be
+// called with the world lock held. Reports whether the queue changed.
+func (s *ConstructionSystem) fundItems(empire *types.EmpireState) bool {
+	changed := false
+	for i := 0; i < len(empire.BuildQueue); i++ {
+		item := empire.BuildQueue[i]
+
+		colony, exists := s.worldState.Colonies[item.ColonyID]
+		if !exists || colony.Owner != empire.ID {
+			// The colony was lost, its queue goes with it
+			s.removeItem(empire, i)
+			i--
+			changed = true
+			continue
+		}
+
+		if item.Funded || colony.BuildQueue[0] != item {
+			continue
+		}
+		if !empire.SpendResources(item.Cost) {
+			break
+		}
+		item.Funded = true
+		changed = true
+	}
+	return changed
+}
+
+// removeItem drops the item at index from the empire queue and its colony
+// queue, refunding the unit under construction. Must be called with the world lock held.
+func (s *ConstructionSystem) removeItem(empire *types.EmpireState, index int) {
+	item := empire.BuildQueue[index]
+	empire.BuildQueue = types.RemoveBuildItem(empire.BuildQueue, index)
+
+	if colony, exists := s.worldState.Colonies[item.ColonyID]; exists {
+		if i := types.IndexOfBuildItem(colony.BuildQueue, item.ID); i >= 0 {
+			colony.BuildQueue = types.RemoveBuildItem(colony.BuildQueue, i)
+		}
+	}
+
+	if item.Funded {
+		empire.AddResources(item.Cost)
+		item.Funded = false
+	}
+}
+
+// completeUnit places a finished unit in the world. Must be called with the world lock held.
+func (s *ConstructionSystem) completeUnit(sessionID uuid.UUID, empire *types.EmpireState, colony *types.Colony, item *types.BuildItem, now time.Time) events.GameEvent {
+	base := types.BaseEvent{
+		SessionID: sessionID,
+		Timestamp: now.UnixNano(),
+	}
+
+	system, exists := s.worldState.Galaxy.GetSystem(colony.SystemID)
+	if !exists {
+		log.Printf("Colony %s is in unknown system %s", colony.ID, colony.SystemID)
+		return nil
+	}
+
+	if item.Kind == types.BuildKindBuilding {
+		level := addBuildingLevel(system, colony.PlanetID, item.Type)
+		base.Type = "building_built"
+		return &types.BuildingBuiltEvent{
+			BaseEvent:    base,
+			PlayerID:     empire.PlayerID,
+			SystemID:     system.ID,
+			ColonyID:     colony.ID,
+			PlanetID:     colony.PlanetID,
+			BuildingType: item.Type,
+			Level:        level,
+			Remaining:    item.Quantity - 1,
+		}
+	}
+
+	fleet := s.garrisonFleet(empire, colony, system)
+	fleet.Ships[item.Type]++
+
+	base.Type = "ship_built"
+	return &types.ShipBuiltEvent{
+		BaseEvent: base,
+		PlayerID:  empire.PlayerID,
+		SystemID:  system.ID,
+		ColonyID:  colony.ID,
+		FleetID:   fleet.ID,
+		ShipType:  item.Type,
+		ShipID:    s.worldState.IDs.NewID(),
+		Remaining: item.Quantity - 1,
+	}
+}
+
+// garrisonFleet returns the stationary fleet new ships join, creating one if
+// the empire has no fleet waiting in the system. Must be called with the world lock held.
+func (s *ConstructionSystem) garrisonFleet(empire *types.EmpireState, colony *types.Colony, system *types.StarSystemState) *types.Fleet {
+	var candidates []*types.Fleet
+	for _, fleet := range system.Fleets {
+		if fleet.Owner == empire.ID && !fleet.InTransit() && len(fleet.Path) == 0 {
+			candidates = append(candidates, fleet)
+		}
+	}
+	if len(candidates) > 0 {
+		sort.Slice(candidates, func(i, j int) bool {
+			return candidates[i].ID.String() < candidates[j].ID.String()
+		})
+		return candidates[0]
+	}
+
+	fleet := &types.Fleet{
+		ID:       s.worldState.IDs.NewID(),
+		Name:     colony.Name + " Fleet",
+		Owner:    empire.ID,
+		Ships:    make(map[string]int),
+		Location: system.ID,
+	}
+	fleet.Ref = s.worldState.IDs.Register(fleet.ID)
+	system.AddFleet(fleet)
+	empire.TotalFleets[fleet.ID] = fleet
+
+	return fleet
+}
+
+// buildSpeed returns how many seconds of progress an item makes per second
+func (s *ConstructionSystem) buildSpeed(colony *types.Colony, item *types.BuildItem) float64 {
+	if item.Kind != types.BuildKindShip {
+		return 1
+	}
+
+	system, exists := s.worldState.Galaxy.GetSystem(colony.SystemID)
+	if !exists {
+		return 1
+	}
+
+	return 1 + shipyardSpeedBonus*float64(buildingLevel(system, colony.PlanetID, "shipyard"))
+}
+
+// plannedLevel returns the level a building reaches on the colony's planet once
+// every queued upgrade is finished. An empty building type counts the levels
+// of all buildings, which is what the industry slots of a planet limit.
+func (s *ConstructionSystem) plannedLevel(colony *types.Colony, buildingType string) int {
+	level := 0
+	if system, exists := s.worldState.Galaxy.GetSystem(colony.SystemID); exists {
+		for _, b := range system.Buildings {
+			if b.PlanetID == colony.PlanetID && (buildingType == "" || b.Type == buildingType) {
+				level += b.Level
+			}
+		}
+	}
+
+	for _, item := range colony.BuildQueue {
+		if item.Kind == types.BuildKindBuilding && (buildingType == "" || item.Type == buildingType) {
+			level += item.Quantity
+		}
+	}
+	return level
+}
+
+// findColony looks up a colony owned by the player's empire. Must be called with the world lock held.
+func (s *ConstructionSystem) findColony(playerID, colonyID uuid.UUID) (*types.EmpireState, *types.Colony, bool) {
+	empire, exists := s.worldState.Empires[playerID]
+	if !exists {
+		return nil, nil, false
+	}
+
+	colony, exists := s.worldState.Colonies[colonyID]
+	if !exists || colony.Owner != empire.ID {
+		return nil, nil, false
+	}
+
+	return empire, colony, true
+}
+
+// queueUpdatedEvent snapshots the empire's build queue. Must be called with the world lock held.
+func (s *ConstructionSystem) queueUpdatedEvent(sessionID uuid.UUID, empire *types.EmpireState) *types.BuildQueueUpdatedEvent {
+	queue := make([]types.BuildItem, len(empire.BuildQueue))
+	for i, item := range empire.BuildQueue {
+		queue[i] = *item
+	}
+
+	return &types.BuildQueueUpdatedEvent{
+		BaseEvent: types.BaseEvent{
+			SessionID: sessionID,
+			Type:      "build_queue_updated",
+			Timestamp: time.Now().UnixNano(),
+		},
+		PlayerID: empire.PlayerID,
+		Queue:    queue,
+	}
+}
+
+// buildingLevel returns the level of a building on a planet, 0 if it does not exist
+func buildingLevel(system *types.StarSystemState, planetID uuid.UUID, buildingType string) int {
+	for _, b := range system.Buildings {
+		if b.PlanetID == planetID && b.Type == buildingType {
+			return b.Level
+		}
+	}
+	return 0
+}
+
+// addBuildingLevel builds or upgrades a building on a planet and returns its new level
+func addBuildingLevel(system *types.StarSystemState, planetID uuid.UUID, buildingType string) int {
+	for i, b := range system.Buildings {
+		if b.PlanetID == planetID && b.Type == buildingType {
+			system.Buildings[i].Level++
+			return system.Buildings[i].Level
+		}
+	}
+
+	system.Buildings = append(system.Buildings, types.BuildingState{
+		Type:     buildingType,
+		Level:    1,
+		PlanetID: planetID,
+	})
+	return 1
+}
@@ -6,8 +6,29 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
 	"github.com/gr4vediggr/stellarlight/internal/game/events"
 	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
+)
+
+const (
+	// EconomyIntervalDays is the number of in-game days between two economy updates
+	EconomyIntervalDays = 1
+
+	// populationCredits is the credits a single population unit pays in taxes
+	populationCredits = 10
+
+	// populationResearch is the research points a single population unit produces
+	populationResearch = 2
+)
+
+var (
+	// systemYield is the income of every owned system, planets aside
+	systemYield = types.ResourceState{Credits: 10, Energy: 10}
+
+	// capitalYield is the extra income of an empire's home system
+	capitalYield = types.ResourceState{Credits: 50, Minerals: 30, Energy: 30, Research: 20}
 )
 
 // EconomySystem handles resource generation and management
@@ -15,18 +36,20 @@ type EconomySystem struct {
 	name       string
 	eventBus   *events.EventBus
 	worldState *types.WorldState
+	assets     *resource.Assets
 
 	// Subscriptions
-	subscriptions []func()
+	subscriptions []events.SubscriptionID
 	mu            sync.RWMutex
 }
 
-func NewEconomySystem(eventBus *events.EventBus, worldState *types.WorldState) *EconomySystem {
+func NewEconomySystem(eventBus *events.EventBus, worldState *types.WorldState, assets *resource.Assets) *EconomySystem {
 	return &EconomySystem{
 		name:          "EconomySystem",
 		eventBus:      eventBus,
 		worldState:    worldState,
-		subscriptions: make([]func(), 0),
+		assets:        assets,
+		subscriptions: make([]events.SubscriptionID, 0),
 	}
 }
 
@@ -35,8 +58,7 @@ func (s *EconomySystem) Initialize() error {
 
 	// Subscribe to relevant events
 	s.subscriptions = append(s.subscriptions,
-		s.eventBus.Subscribe("game_tick", s.handleGameTick),
-		s.eventBus.Subscribe("build_ship_command", s.handleBuildShipCommand),
+		events.Subscribe(s.eventBus, "game_tick", s.handleGameTick),
 	)
 
 	return nil
@@ -46,9 +68,7 @@ func (s *EconomySystem) Shutdown() error {
 	log.Printf("Shutting down %s", s.name)
 
 	// Unsubscribe from all events
-	for _, unsubscribe := range s.subscriptions {
-		unsubscribe()
-	}
+	s.eventBus.Unsubscribe(s.subscriptions...)
 	s.subscriptions = nil
 
 	return nil
@@ -58,85 +78,97 @@ func (s *EconomySystem) GetName() string {
 	return s.name
 }
 
-func (s *EconomySystem) handleGameTick(event events.GameEvent) {
-	tickEvent := event.(*types.GameTickEvent)
-
-	// Generate resources every 10 ticks (1 second at 10 TPS)
-	if tickEvent.Tick%10 == 0 {
-		s.generateResources()
+func (s *EconomySystem) handleGameTick(tickEvent *types.GameTickEvent) {
+	// Generate resources at the start of every in-game day
+	if tickEvent.Crossed(EconomyIntervalDays) {
+		s.generateResources(tickEvent.SessionID)
 	}
 }
 
-func (s *EconomySystem) handleBuildShipCommand(event events.GameEvent) {
-	buildEvent := event.(*types.BuildShipCommandEvent)
+func (s *EconomySystem) generateResources(sessionID uuid.UUID) {
+	now := time.Now()
 
-	// Get empire
 	s.worldState.AcquireLock()
-	empire, exists := s.worldState.Empires[buildEvent.PlayerID]
+	pending := make([]events.GameEvent, 0, len(s.worldState.Empires))
+	for _, empire := range s.worldState.SortedEmpires() {
+		income := s.calculateIncome(empire)
+		empire.ApplyIncome(income)
+
+		pending = append(pending, &types.EmpireIncomeEvent{
+			BaseEvent: types.BaseEvent{
+				SessionID: sessionID,
+				Type:      "empire_income",
+				Timestamp: now.UnixNano(),
+			},
+			PlayerID:  empire.PlayerID,
+			EmpireID:  empire.ID,
+			Income:    income,
+			Resources: empire.GetResources(),
+		})
+	}
 	s.worldState.ReleaseLock()
 
-	if !exists {
-		return
+	for _, e := range pending {
+		s.eventBus.Publish(e)
 	}
+}
 
-	// Get ship cost (example)
-	shipType, ok := buildEvent.Data["ship_type"].(string)
-	if !ok {
-		return
-	}
+// calculateIncome works out what an empire earns and pays in one economy
+// update. Must be called with the world lock held.
+func (s *EconomySystem) calculateIncome(empire *types.EmpireState) types.IncomeBreakdown {
+	var income types.IncomeBreakdown
 
-	cost := s.getShipCost(shipType)
-
-	// Check if empire can afford it
-	if empire.CanAfford(cost) {
-		if empire.SpendResources(cost) {
-			// Create ship built event
-			s.eventBus.Publish(&types.ShipBuiltEvent{
-				BaseEvent: types.BaseEvent{
-					SessionID: buildEvent.SessionID,
-					Type:      "ship_built",
-					Timestamp: time.Now().UnixNano(),
-				},
-				PlayerID: buildEvent.PlayerID,
-				ShipType: shipType,
-				ShipID:   uuid.New(),
-			})
+	for _, systemID := range empire.Systems {
+		system, exists := s.worldState.Galaxy.GetSystem(systemID)
+		if !exists || system.Owner == nil || *system.Owner != empire.ID {
+			continue
 		}
-	}
-}
 
-func (s *EconomySystem) generateResources() {
-	s.worldState.AcquireLock()
-	defer s.worldState.ReleaseLock()
+		income.Systems = income.Systems.Add(systemYield)
+		if system.ID == empire.HomeSystem {
+			income.Systems = income.Systems.Add(capitalYield)
+		}
 
-	for _, empire := range s.worldState.Empires {
-		// Calculate resource generation based on empire's systems
-		generation := s.calculateResourceGeneration(empire)
-		empire.AddResources(generation)
-	}
-}
+		for _, planet := range system.Planets {
+			if pt, exists := s.assets.PlanetTypeByName(planet.Type); exists {
+				income.Planets = income.Planets.Add(types.NewResourceState(pt.Yield))
+			}
+		}
 
-func (s *EconomySystem) calculateResourceGeneration(empire *types.EmpireState) types.ResourceState {
-	// Example calculation - in real game this would be more complex
-	return types.ResourceState{
-		Credits:  10,
-		Minerals: 5,
-		Energy:   5,
-		Research: 2,
+		for _, building := range system.Buildings {
+			if bt, exists := s.assets.BuildingTypeByKey(building.Type); exists {
+				income.Buildings = income.Buildings.Add(types.NewResourceState(bt.Production).Scale(int64(building.Level)))
+			}
+		}
 	}
-}
 
-func (s *EconomySystem) getShipCost(shipType string) types.ResourceState {
-	costs := map[string]types.ResourceState{
-		"fighter":     {Credits: 100, Minerals: 50, Energy: 25},
-		"cruiser":     {Credits: 500, Minerals: 200, Energy: 100},
-		"dreadnought": {Credits: 2000, Minerals: 1000, Energy: 500},
+	for _, colonyID := range empire.Colonies {
+		if colony, exists := s.worldState.Colonies[colonyID]; exists {
+			income.Population = income.Population.Add(populationYield(colony.Population))
+		}
 	}
 
-	if cost, exists := costs[shipType]; exists {
-		return cost
+	for _, fleet := range empire.TotalFleets {
+		for shipType, count := range fleet.Ships {
+			if st, exists := s.assets.ShipTypeByKey(shipType); exists {
+				income.Upkeep = income.Upkeep.Add(types.NewResourceState(st.Upkeep).Scale(int64(count)))
+			}
+		}
 	}
 
-	// Default cost
-	return types.ResourceState{Credits: 100, Minerals: 50, Energy: 25}
+	income.Net = income.Systems.
+		Add(income.Planets).
+		Add(income.Population).
+		Add(income.Buildings).
+		Sub(income.Upkeep)
+
+	return income
+}
+
+// populationYield returns what a colony's population produces
+func populationYield(population int64) types.ResourceState {
+	return types.ResourceState{
+		Credits:  population * populationCredits,
+		Research: population * populationResearch,
+	}
 }
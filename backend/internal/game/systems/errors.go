@@ -0,0 +1,50 @@
+package systems
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+)
+
+// Error codes sent to clients when a command is rejected
+const (
+	ErrCodeInvalidCommand      = "INVALID_COMMAND"
+	ErrCodeNoEmpire            = "NO_EMPIRE"
+	ErrCodeNotOwner            = "NOT_OWNER"
+	ErrCodeUnknownFleet        = "UNKNOWN_FLEET"
+	ErrCodeUnknownSystem       = "UNKNOWN_SYSTEM"
+	ErrCodeUnreachable         = "DESTINATION_UNREACHABLE"
+	ErrCodeUnknownShipType     = "UNKNOWN_SHIP_TYPE"
+	ErrCodeUnknownBuildingType = "UNKNOWN_BUILDING_TYPE"
+	ErrCodeUnknownColony       = "UNKNOWN_COLONY"
+	ErrCodeUnknownBuildItem    = "UNKNOWN_BUILD_ITEM"
+	ErrCodeInvalidQuantity     = "INVALID_QUANTITY"
+	ErrCodeMaxLevelReached     = "BUILDING_MAX_LEVEL"
+	ErrCodeNoIndustrySlots     = "NO_INDUSTRY_SLOTS"
+	ErrCodeUnknownPlanet       = "UNKNOWN_PLANET"
+	ErrCodeNoColonyShip        = "NO_COLONY_SHIP"
+	ErrCodeNotInSystem         = "FLEET_NOT_IN_SYSTEM"
+	ErrCodeAlreadyColonized    = "PLANET_COLONIZED"
+	ErrCodeUninhabitable       = "PLANET_UNINHABITABLE"
+	ErrCodeSystemOwned         = "SYSTEM_OWNED"
+	ErrCodeUnknownTechnology   = "UNKNOWN_TECHNOLOGY"
+	ErrCodeTechResearched      = "TECH_RESEARCHED"
+	ErrCodeTechPrerequisites   = "TECH_PREREQUISITES"
+	ErrCodeBuildingLocked      = "BUILDING_LOCKED"
+)
+
+// rejectCommand notifies a player that one of their commands was rejected
+func rejectCommand(eventBus *events.EventBus, sessionID, playerID uuid.UUID, code, message string) {
+	eventBus.Publish(&types.CommandRejectedEvent{
+		BaseEvent: types.BaseEvent{
+			SessionID: sessionID,
+			Type:      "command_rejected",
+			Timestamp: time.Now().UnixNano(),
+		},
+		PlayerID:  playerID,
+		ErrorCode: code,
+		Message:   message,
+	})
+}
@@ -0,0 +1,179 @@
+package systems
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/internal/interfaces"
+	"github.com/gr4vediggr/stellarlight/internal/notifications"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
+)
+
+// InboxSystem leaves notifications for players who are offline when a battle
+// involves their empire, a build finishes or one of their systems is lost.
+// Connected players follow these through their update stream instead.
+type InboxSystem struct {
+	name       string
+	eventBus   *events.EventBus
+	worldState *types.WorldState
+	assets     *resource.Assets
+	clients    interfaces.ClientRegistry
+	notifier   notifications.Notifier
+
+	// Subscriptions
+	subscriptions []events.SubscriptionID
+	mu            sync.RWMutex
+}
+
+func NewInboxSystem(eventBus *events.EventBus, worldState *types.WorldState, assets *resource.Assets, clients interfaces.ClientRegistry, notifier notifications.Notifier) *InboxSystem {
+	return &InboxSystem{
+		name:          "InboxSystem",
+		eventBus:      eventBus,
+		worldState:    worldState,
+		assets:        assets,
+		clients:       clients,
+		notifier:      notifier,
+		subscriptions: make([]events.SubscriptionID, 0),
+	}
+}
+
+func (s *InboxSystem) Initialize() error {
+	log.Printf("Initializing %s", s.name)
+
+	s.subscriptions = append(s.subscriptions,
+		events.Subscribe(s.eventBus, "battle_ended", s.handleBattleEnded),
+		events.Subscribe(s.eventBus, "ship_built", s.handleShipBuilt),
+		events.Subscribe(s.eventBus, "building_built", s.handleBuildingBuilt),
+		events.Subscribe(s.eventBus, "system_owner_changed", s.handleSystemOwnerChanged),
+	)
+
+	return nil
+}
+
+func (s *InboxSystem) Shutdown() error {
+	log.Printf("Shutting down %s", s.name)
+
+	s.eventBus.Unsubscribe(s.subscriptions...)
+	s.subscriptions = nil
+
+	return nil
+}
+
+func (s *InboxSystem) GetName() string {
+	return s.name
+}
+
+func (s *InboxSystem) handleBattleEnded(battleEvent *types.BattleEndedEvent) {
+	s.worldState.AcquireLock()
+	systemName := s.systemName(battleEvent.SystemID)
+	var players []uuid.UUID
+	for _, empireID := range battleEvent.Participants {
+		if empire, exists := s.worldState.GetEmpireByID(empireID); exists {
+			players = append(players, empire.PlayerID)
+		}
+	}
+	var winner string
+	if battleEvent.Winner != nil {
+		if empire, exists := s.worldState.GetEmpireByID(*battleEvent.Winner); exists {
+			winner = empire.Name
+		}
+	}
+	s.worldState.ReleaseLock()
+
+	outcome := "No fleet survived the battle."
+	if winner != "" {
+		outcome = fmt.Sprintf("%s won the battle.", winner)
+	}
+
+	for _, playerID := range players {
+		s.notify(playerID, battleEvent.SessionID, notifications.KindBattle,
+			fmt.Sprintf("Battle at %s", systemName),
+			fmt.Sprintf("Your fleets fought for %d rounds at %s. %s", battleEvent.Rounds, systemName, outcome))
+	}
+}
+
+func (s *InboxSystem) handleShipBuilt(builtEvent *types.ShipBuiltEvent) {
+	if builtEvent.Remaining > 0 {
+		return
+	}
+
+	name := builtEvent.ShipType
+	if shipType, exists := s.assets.ShipTypeByKey(builtEvent.ShipType); exists {
+		name = shipType.Name
+	}
+	s.notifyBuildComplete(builtEvent.PlayerID, builtEvent.SessionID, builtEvent.SystemID, name)
+}
+
+func (s *InboxSystem) handleBuildingBuilt(builtEvent *types.BuildingBuiltEvent) {
+	if builtEvent.Remaining > 0 {
+		return
+	}
+
+	name := builtEvent.BuildingType
+	if buildingType, exists := s.assets.BuildingTypeByKey(builtEvent.BuildingType); exists {
+		name = buildingType.Name
+	}
+	s.notifyBuildComplete(builtEvent.PlayerID, builtEvent.SessionID, builtEvent.SystemID, name)
+}
+
+func (s *InboxSystem) notifyBuildComplete(playerID, sessionID, systemID uuid.UUID, name string) {
+	s.worldState.AcquireLock()
+	systemName := s.systemName(systemID)
+	s.worldState.ReleaseLock()
+
+	s.notify(playerID, sessionID, notifications.KindBuildComplete,
+		fmt.Sprintf("%s completed", name),
+		fmt.Sprintf("Construction of %s finished at %s.", name, systemName))
+}
+
+func (s *InboxSystem) handleSystemOwnerChanged(ownerEvent *types.SystemOwnerChangedEvent) {
+	if ownerEvent.PreviousOwner == nil {
+		return
+	}
+
+	s.worldState.AcquireLock()
+	previous, exists := s.worldState.GetEmpireByID(*ownerEvent.PreviousOwner)
+	if !exists {
+		s.worldState.ReleaseLock()
+		return
+	}
+	playerID := previous.PlayerID
+	systemName := s.systemName(ownerEvent.SystemID)
+	body := fmt.Sprintf("Your empire no longer controls %s.", systemName)
+	if ownerEvent.Owner != nil {
+		if owner, exists := s.worldState.GetEmpireByID(*ownerEvent.Owner); exists {
+			body = fmt.Sprintf("%s was taken by %s.", systemName, owner.Name)
+		}
+	}
+	s.worldState.ReleaseLock()
+
+	s.notify(playerID, ownerEvent.SessionID, notifications.KindSystemLost,
+		fmt.Sprintf("%s lost", systemName), body)
+}
+
+// notify leaves a notification for the player unless they are connected
+func (s *InboxSystem) notify(playerID, sessionID uuid.UUID, kind notifications.Kind, title, body string) {
+	if _, connected := s.clients.GetClient(playerID); connected {
+		return
+	}
+
+	s.notifier.Notify(&notifications.Notification{
+		UserID:    playerID,
+		SessionID: &sessionID,
+		Kind:      kind,
+		Title:     title,
+		Body:      body,
+	})
+}
+
+// systemName returns the name of a system. Must be called with the world lock held.
+func (s *InboxSystem) systemName(systemID uuid.UUID) string {
+	if system, exists := s.worldState.Galaxy.GetSystem(systemID); exists && system.Name != "" {
+		return system.Name
+	}
+	return "an unknown system"
+}
@@ -0,0 +1,278 @@
+package systems
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gr4vediggr/stellarlight/internal/domain/tech"
+	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
+)
+
+// ResearchSystem spends the research points of every empire on the first
+// technology of its research queue. Points earned while the queue is empty
+// stay in the stockpile until something is queued.
+type ResearchSystem struct {
+	name       string
+	eventBus   *events.EventBus
+	worldState *types.WorldState
+	assets     *resource.Assets
+
+	// Subscriptions
+	subscriptions []events.SubscriptionID
+	mu            sync.RWMutex
+}
+
+func NewResearchSystem(eventBus *events.EventBus, worldState *types.WorldState, assets *resource.Assets) *ResearchSystem {
+	return &ResearchSystem{
+		name:          "ResearchSystem",
+		eventBus:      eventBus,
+		worldState:    worldState,
+		assets:        assets,
+		subscriptions: make([]events.SubscriptionID, 0),
+	}
+}
+
+func (s *ResearchSystem) Initialize() error {
+	log.Printf("Initializing %s", s.name)
+
+	s.subscriptions = append(s.subscriptions,
+		events.Subscribe(s.eventBus, "game_tick", s.handleGameTick),
+		events.Subscribe(s.eventBus, "research_command", s.handleResearchCommand),
+	)
+
+	return nil
+}
+
+func (s *ResearchSystem) Shutdown() error {
+	log.Printf("Shutting down %s", s.name)
+
+	s.eventBus.Unsubscribe(s.subscriptions...)
+	s.subscriptions = nil
+
+	return nil
+}
+
+func (s *ResearchSystem) GetName() string {
+	return s.name
+}
+
+func (s *ResearchSystem) handleResearchCommand(researchEvent *types.ResearchCommandEvent) {
+	reject := func(code, message string) {
+		rejectCommand(s.eventBus, researchEvent.SessionID, researchEvent.PlayerID, code, message)
+	}
+
+	t, exists := s.assets.TechnologyByKey(researchEvent.Technology)
+	if !exists {
+		reject(ErrCodeUnknownTechnology, "unknown technology: "+researchEvent.Technology)
+		return
+	}
+
+	s.worldState.AcquireLock()
+
+	empire, exists := s.worldState.Empires[researchEvent.PlayerID]
+	if !exists {
+		s.worldState.ReleaseLock()
+		return
+	}
+
+	if researchEvent.Remove {
+		s.dequeue(empire, t.Key)
+	} else {
+		if empire.HasTechnology(t.Key) {
+			s.worldState.ReleaseLock()
+			reject(ErrCodeTechResearched, "technology already researched: "+t.Name)
+			return
+		}
+
+		for _, prerequisite := range t.Prerequisites {
+			if !empire.HasTechnology(prerequisite) && indexOf(empire.Research, prerequisite) < 0 {
+				s.worldState.ReleaseLock()
+				reject(ErrCodeTechPrerequisites, "research or queue the prerequisites of "+t.Name+" first")
+				return
+			}
+		}
+
+		if indexOf(empire.Research, t.Key) < 0 {
+			empire.Research = append(empire.Research, t.Key)
+		}
+	}
+
+	update := s.researchUpdatedEvent(researchEvent.SessionID, empire)
+	s.worldState.ReleaseLock()
+
+	s.eventBus.Publish(update)
+}
+
+func (s *ResearchSystem) handleGameTick(tickEvent *types.GameTickEvent) {
+	now := time.Now().UnixNano()
+
+	s.worldState.AcquireLock()
+	var pending []events.GameEvent
+	for _, empire := range s.worldState.SortedEmpires() {
+		if len(empire.Research) == 0 {
+			continue
+		}
+
+		points := empire.TakeResearch()
+		if points == 0 {
+			continue
+		}
+
+		for points > 0 && len(empire.Research) > 0 {
+			key := empire.Research[0]
+			t, exists := s.assets.TechnologyByKey(key)
+			if !exists {
+				// The technology was removed from the assets
+				empire.Research = empire.Research[1:]
+				continue
+			}
+
+			level := empire.Technologies[key]
+			needed := t.Cost - level.Progress
+			if points < needed {
+				level.Progress += points
+				level.Researching = true
+				empire.Technologies[key] = level
+				points = 0
+				break
+			}
+
+			points -= needed
+			empire.Technologies[key] = types.TechnologyLevel{Level: 1, Progress: t.Cost}
+			empire.Research = empire.Research[1:]
+
+			pending = append(pending, &types.TechResearchedEvent{
+				BaseEvent: types.BaseEvent{
+					SessionID: tickEvent.SessionID,
+					Type:      "tech_researched",
+					Timestamp: now,
+				},
+				PlayerID:   empire.PlayerID,
+				EmpireID:   empire.ID,
+				Technology: t.Key,
+				Name:       t.Name,
+			})
+		}
+
+		// Points left after the queue ran out are kept for later
+		if points > 0 {
+			empire.AddResources(types.ResourceState{Research: points})
+		}
+
+		pending = append(pending, s.researchUpdatedEvent(tickEvent.SessionID, empire))
+	}
+	s.worldState.ReleaseLock()
+
+	for _, e := range pending {
+		s.eventBus.Publish(e)
+	}
+}
+
+// dequeue removes a technology and every queued technology depending on it
+// from the research queue. Progress made so far is kept. Must be called with the world lock held.
+func (s *ResearchSystem) dequeue(empire *types.EmpireState, key string) {
+	removed := map[string]bool{key: true}
+	queue := make([]string, 0, len(empire.Research))
+	for _, queued := range empire.Research {
+		if !removed[queued] {
+			if t, exists := s.assets.TechnologyByKey(queued); exists {
+				for _, prerequisite := range t.Prerequisites {
+					if removed[prerequisite] {
+						removed[queued] = true
+					}
+				}
+			}
+		}
+
+		if removed[queued] {
+			level := empire.Technologies[queued]
+			level.Researching = false
+			empire.Technologies[queued] = level
+			continue
+		}
+		queue = append(queue, queued)
+	}
+	empire.Research = queue
+}
+
+// researchUpdatedEvent snapshots the research state of an empire. Must be called with the world lock held.
+func (s *ResearchSystem) researchUpdatedEvent(sessionID uuid.UUID, empire *types.EmpireState) *types.ResearchUpdatedEvent {
+	technologies := make(map[string]types.TechnologyLevel, len(empire.Technologies))
+	for key, level := range empire.Technologies {
+		technologies[key] = level
+	}
+
+	return &types.ResearchUpdatedEvent{
+		BaseEvent: types.BaseEvent{
+			SessionID: sessionID,
+			Type:      "research_updated",
+			Timestamp: time.Now().UnixNano(),
+		},
+		PlayerID:     empire.PlayerID,
+		Queue:        append([]string(nil), empire.Research...),
+		Technologies: technologies,
+	}
+}
+
+// techModifier returns the multiplier the researched technologies of an empire
+// apply to a ship stat or, for travel_speed, to hyperlane travel. Must be
+// called with the world lock held.
+func techModifier(assets *resource.Assets, empire *types.EmpireState, effectType, stat, shipType string) float64 {
+	modifier := 1.0
+	if empire == nil {
+		return modifier
+	}
+
+	for key, level := range empire.Technologies {
+		if level.Level == 0 {
+			continue
+		}
+		t, exists := assets.TechnologyByKey(key)
+		if !exists {
+			continue
+		}
+
+		for _, effect := range t.Effects {
+			if effect.Type != effectType || effect.Stat != stat {
+				continue
+			}
+			if effect.ShipType != "" && effect.ShipType != shipType {
+				continue
+			}
+			modifier += effect.Modifier
+		}
+	}
+	return modifier
+}
+
+// buildingUnlocked reports whether an empire may construct a building type.
+// Buildings not unlocked by any technology are always available. Must be
+// called with the world lock held.
+func buildingUnlocked(assets *resource.Assets, empire *types.EmpireState, buildingType string) bool {
+	locked := false
+	for _, t := range assets.Technologies {
+		for _, effect := range t.Effects {
+			if effect.Type != tech.EffectUnlockBuilding || effect.Building != buildingType {
+				continue
+			}
+			if empire.HasTechnology(t.Key) {
+				return true
+			}
+			locked = true
+		}
+	}
+	return !locked
+}
+
+func indexOf(keys []string, key string) int {
+	for i, k := range keys {
+		if k == key {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,196 @@
+package systems
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/pkg/messages"
+)
+
+// buildSnapshot collects everything an empire can see. Systems in vision are
+// shown as they are now, systems out of sight as the empire last saw them and
+// systems never seen without any contents. Must be called with the world lock held.
+func buildSnapshot(world *types.WorldState, empire *types.EmpireState) *messages.GameSnapshotMessage {
+	now := time.Now().Unix()
+
+	systems := make([]*types.StarSystemState, 0, len(world.Galaxy.Systems))
+	for _, system := range world.Galaxy.Systems {
+		systems = append(systems, system)
+	}
+	sort.Slice(systems, func(i, j int) bool {
+		return systems[i].Ref < systems[j].Ref
+	})
+
+	snapshot := &messages.GameSnapshotMessage{
+		Empire:       empireSnapshot(world, empire),
+		Systems:      make([]*messages.SystemSnapshot, 0, len(systems)),
+		Fleets:       make([]*messages.FleetSnapshot, 0, len(empire.TotalFleets)),
+		Date:         gameDate(world.Clock.Days),
+		YearsPerHour: world.Clock.YearsPerHour,
+		IsPaused:     world.Clock.Paused,
+	}
+
+	for _, fleet := range empire.TotalFleets {
+		snapshot.Fleets = append(snapshot.Fleets, fleetSnapshot(world.IDs, fleet))
+	}
+
+	for _, system := range systems {
+		visible := empire.CanSee(system.ID)
+		intel := empire.Intel[system.ID]
+		if visible {
+			intel = types.NewSystemIntel(system, now)
+		}
+		snapshot.Systems = append(snapshot.Systems, systemSnapshot(world, empire, system, intel, visible))
+
+		if intel == nil {
+			continue
+		}
+		for _, fleet := range intel.Fleets {
+			if fleet.Owner == empire.ID {
+				continue
+			}
+			if visible {
+				if live, exists := system.Fleets[fleet.ID]; exists {
+					snapshot.Fleets = append(snapshot.Fleets, fleetSnapshot(world.IDs, live))
+				}
+				continue
+			}
+			snapshot.Fleets = append(snapshot.Fleets, fleetIntelSnapshot(fleet, system.Ref))
+		}
+	}
+
+	return snapshot
+}
+
+// empireSnapshot converts the state of the player's own empire. Must be called
+// with the world lock held.
+func empireSnapshot(world *types.WorldState, empire *types.EmpireState) *messages.EmpireSnapshot {
+	snapshot := &messages.EmpireSnapshot{
+		EmpireId:      empire.ID.String(),
+		Name:          empire.Name,
+		Color:         empire.Color,
+		HomeSystemId:  refOf(world.IDs, empire.HomeSystem),
+		Resources:     resourceAmounts(empire.GetResources()),
+		ResearchQueue: append([]string(nil), empire.Research...),
+	}
+
+	for key := range empire.Technologies {
+		if empire.HasTechnology(key) {
+			snapshot.Technologies = append(snapshot.Technologies, key)
+		}
+	}
+	sort.Strings(snapshot.Technologies)
+
+	return snapshot
+}
+
+// systemSnapshot converts a system with the contents known from intel. The
+// layout of the galaxy is known to every player. Must be called with the world lock held.
+func systemSnapshot(world *types.WorldState, empire *types.EmpireState, system *types.StarSystemState, intel *types.SystemIntel, visible bool) *messages.SystemSnapshot {
+	snapshot := &messages.SystemSnapshot{
+		Id:         system.Ref,
+		Name:       system.Name,
+		StarType:   system.StarType,
+		X:          system.Position.X,
+		Y:          system.Position.Y,
+		Hyperlanes: make([]uint64, 0, len(system.Hyperlanes)),
+		Planets:    make([]*messages.PlanetSnapshot, 0, len(system.Planets)),
+		Visible:    visible,
+	}
+
+	for _, id := range system.Hyperlanes {
+		snapshot.Hyperlanes = append(snapshot.Hyperlanes, refOf(world.IDs, id))
+	}
+
+	if intel != nil {
+		snapshot.LastSeen = intel.LastSeen
+		if intel.Owner != nil {
+			snapshot.OwnerEmpireId = intel.Owner.String()
+		}
+	}
+
+	for _, planet := range system.Planets {
+		p := &messages.PlanetSnapshot{
+			Id:   planet.Ref,
+			Name: planet.Name,
+			Type: planet.Type,
+			Size: int32(planet.Size),
+		}
+		if intel != nil {
+			p.Population = intel.Planets[planet.ID]
+		}
+		if planet.ColonyID != nil {
+			if colony, exists := world.Colonies[*planet.ColonyID]; exists && colony.Owner == empire.ID {
+				p.ColonyId = colony.Ref
+			}
+		}
+		snapshot.Planets = append(snapshot.Planets, p)
+	}
+
+	return snapshot
+}
+
+func fleetSnapshot(ids *types.IDRegistry, fleet *types.Fleet) *messages.FleetSnapshot {
+	snapshot := &messages.FleetSnapshot{
+		Id:            fleet.Ref,
+		OwnerEmpireId: fleet.Owner.String(),
+		Name:          fleet.Name,
+		Ships:         shipCounts(fleet.Ships),
+		LocationId:    refOf(ids, fleet.Location),
+	}
+	if fleet.Destination != nil {
+		snapshot.DestinationId = refOf(ids, *fleet.Destination)
+	}
+	if fleet.ArrivalTime != nil {
+		snapshot.ArrivalTime = *fleet.ArrivalTime
+	}
+	return snapshot
+}
+
+func fleetIntelSnapshot(fleet types.FleetIntel, location uint64) *messages.FleetSnapshot {
+	return &messages.FleetSnapshot{
+		Id:            fleet.Ref,
+		OwnerEmpireId: fleet.Owner.String(),
+		Ships:         shipCounts(fleet.Ships),
+		LocationId:    location,
+		LastKnown:     true,
+	}
+}
+
+func shipCounts(ships map[string]int) map[string]int32 {
+	counts := make(map[string]int32, len(ships))
+	for shipType, count := range ships {
+		counts[shipType] = int32(count)
+	}
+	return counts
+}
+
+func resourceAmounts(r types.ResourceState) *messages.ResourceAmounts {
+	return &messages.ResourceAmounts{
+		Credits:    r.Credits,
+		Minerals:   r.Minerals,
+		Energy:     r.Energy,
+		Research:   r.Research,
+		Population: r.Population,
+	}
+}
+
+// gameDate converts an in-game instant to the date sent to clients
+func gameDate(days float64) *messages.GameDate {
+	date := types.DateOf(days)
+	return &messages.GameDate{
+		Year:        int32(date.Year),
+		Month:       int32(date.Month),
+		Day:         int32(date.Day),
+		ElapsedDays: days,
+	}
+}
+
+// refOf returns the numeric reference of an entity, 0 if it has none
+func refOf(ids *types.IDRegistry, id uuid.UUID) uint64 {
+	ref, _ := ids.Ref(id)
+	return ref
+}
@@ -0,0 +1,154 @@
+package systems
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+	"github.com/gr4vediggr/stellarlight/internal/resource"
+)
+
+// VisionIntervalDays is the number of in-game days between two vision updates
+const VisionIntervalDays = 0.05
+
+// VisionSystem works out which star systems every empire can see. Empires see
+// the systems they own and the systems their fleets are in or travelling to,
+// each with a radius of hyperlane hops set by the sensor range.
+type VisionSystem struct {
+	name       string
+	eventBus   *events.EventBus
+	worldState *types.WorldState
+	assets     *resource.Assets
+
+	// Subscriptions
+	subscriptions []events.SubscriptionID
+	mu            sync.RWMutex
+}
+
+func NewVisionSystem(eventBus *events.EventBus, worldState *types.WorldState, assets *resource.Assets) *VisionSystem {
+	return &VisionSystem{
+		name:          "VisionSystem",
+		eventBus:      eventBus,
+		worldState:    worldState,
+		assets:        assets,
+		subscriptions: make([]events.SubscriptionID, 0),
+	}
+}
+
+func (s *VisionSystem) Initialize() error {
+	log.Printf("Initializing %s", s.name)
+
+	s.subscriptions = append(s.subscriptions,
+		s.eventBus.Subscribe("game_started", s.handleGameStarted),
+		events.Subscribe(s.eventBus, "game_tick", s.handleGameTick),
+	)
+
+	return nil
+}
+
+func (s *VisionSystem) Shutdown() error {
+	log.Printf("Shutting down %s", s.name)
+
+	s.eventBus.Unsubscribe(s.subscriptions...)
+	s.subscriptions = nil
+
+	return nil
+}
+
+func (s *VisionSystem) GetName() string {
+	return s.name
+}
+
+// handleGameStarted gives every empire its starting vision. Clients receive it
+// with their first snapshot, so no vision changes are published.
+func (s *VisionSystem) handleGameStarted(event events.GameEvent) {
+	now := time.Now().Unix()
+
+	s.worldState.AcquireLock()
+	defer s.worldState.ReleaseLock()
+
+	for _, empire := range s.worldState.SortedEmpires() {
+		s.worldState.UpdateVision(empire, s.visibleSystems(empire), now)
+	}
+}
+
+func (s *VisionSystem) handleGameTick(tickEvent *types.GameTickEvent) {
+	if !tickEvent.Crossed(VisionIntervalDays) {
+		return
+	}
+
+	now := time.Now()
+
+	s.worldState.AcquireLock()
+	var pending []events.GameEvent
+	for _, empire := range s.worldState.SortedEmpires() {
+		revealed, hidden := s.worldState.UpdateVision(empire, s.visibleSystems(empire), now.Unix())
+		if len(revealed) == 0 && len(hidden) == 0 {
+			continue
+		}
+
+		intel := make([]types.SystemIntel, 0, len(revealed))
+		for _, systemID := range revealed {
+			intel = append(intel, *empire.Intel[systemID])
+		}
+
+		pending = append(pending, &types.VisionChangedEvent{
+			BaseEvent: types.BaseEvent{
+				SessionID: tickEvent.SessionID,
+				Type:      "vision_changed",
+				Timestamp: now.UnixNano(),
+			},
+			PlayerID: empire.PlayerID,
+			EmpireID: empire.ID,
+			Revealed: intel,
+			Hidden:   hidden,
+		})
+	}
+	s.worldState.ReleaseLock()
+
+	for _, e := range pending {
+		s.eventBus.Publish(e)
+	}
+}
+
+// visibleSystems returns every system the empire currently sees. Must be
+// called with the world lock held.
+func (s *VisionSystem) visibleSystems(empire *types.EmpireState) map[uuid.UUID]bool {
+	visible := make(map[uuid.UUID]bool)
+	reveal := func(origin uuid.UUID, hops int) {
+		for _, id := range s.worldState.Galaxy.SystemsWithin(origin, hops) {
+			visible[id] = true
+		}
+	}
+
+	for _, systemID := range empire.Systems {
+		reveal(systemID, types.SystemSensorRange)
+	}
+
+	for _, fleet := range empire.TotalFleets {
+		hops := s.sensorRange(fleet)
+		reveal(fleet.Location, hops)
+		if fleet.Destination != nil {
+			reveal(*fleet.Destination, hops)
+		}
+	}
+
+	return visible
+}
+
+// sensorRange returns the largest sensor range of the ships in a fleet
+func (s *VisionSystem) sensorRange(fleet *types.Fleet) int {
+	hops := 0
+	for shipType, count := range fleet.Ships {
+		if count <= 0 {
+			continue
+		}
+		if st, exists := s.assets.ShipTypeByKey(shipType); exists && st.SensorRange > hops {
+			hops = st.SensorRange
+		}
+	}
+	return hops
+}
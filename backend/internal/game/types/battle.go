@@ -0,0 +1,43 @@
+package types
+
+import (
+	"github.com/google/uuid"
+)
+
+// Battle is an ongoing fight between hostile fleets in a star system. Battles
+// are resolved in rounds and last until only one side is left in the system.
+type Battle struct {
+	ID           uuid.UUID             `json:"id"`
+	SystemID     uuid.UUID             `json:"system_id"`
+	StartedAt    float64               `json:"started_at"` // in-game day
+	Round        int                   `json:"round"`
+	Participants map[uuid.UUID]bool    `json:"participants"` // every empire that took part
+	Fleets       map[uuid.UUID]bool    `json:"fleets"`       // every fleet that took part
+	Damage       map[uuid.UUID]float64 `json:"damage"`       // damage carried over per fleet
+}
+
+func NewBattle(id, systemID uuid.UUID, startedAt float64) *Battle {
+	return &Battle{
+		ID:           id,
+		SystemID:     systemID,
+		StartedAt:    startedAt,
+		Participants: make(map[uuid.UUID]bool),
+		Fleets:       make(map[uuid.UUID]bool),
+		Damage:       make(map[uuid.UUID]float64),
+	}
+}
+
+// Join adds a fleet and its owner to the battle
+func (b *Battle) Join(fleet *Fleet) {
+	b.Fleets[fleet.ID] = true
+	b.Participants[fleet.Owner] = true
+}
+
+// ParticipantIDs returns the empire IDs of every side that took part
+func (b *Battle) ParticipantIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(b.Participants))
+	for id := range b.Participants {
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// In-game calendar. All months have the same length to keep dates simple.
+const (
+	DaysPerMonth  = 30
+	MonthsPerYear = 12
+	DaysPerYear   = DaysPerMonth * MonthsPerYear
+
+	// StartYear is the year every game starts in
+	StartYear = 2200
+
+	// DefaultYearsPerHour is the default rate of the clock: one real hour is one in-game year
+	DefaultYearsPerHour = 1.0
+)
+
+// GameDate is a date on the in-game calendar. Months and days start at 1.
+type GameDate struct {
+	Year  int `json:"year"`
+	Month int `json:"month"`
+	Day   int `json:"day"`
+}
+
+// DateOf returns the date of an in-game instant given in days since the start of the game
+func DateOf(days float64) GameDate {
+	whole := int(math.Floor(days))
+	return GameDate{
+		Year:  StartYear + whole/DaysPerYear,
+		Month: whole%DaysPerYear/DaysPerMonth + 1,
+		Day:   whole%DaysPerMonth + 1,
+	}
+}
+
+func (d GameDate) String() string {
+	return fmt.Sprintf("%d.%02d.%02d", d.Year, d.Month, d.Day)
+}
+
+// GameClock turns wall-clock time into in-game time. In-game instants are
+// measured in days since the start of the game, with fractions of a day, and
+// every duration in the simulation (build times, travel times, growth rates)
+// is given in in-game days. A paused clock does not advance.
+type GameClock struct {
+	Days         float64 `json:"days"`           // in-game days since the start of the game
+	YearsPerHour float64 `json:"years_per_hour"` // in-game years per real hour
+	Paused       bool    `json:"paused"`
+}
+
+// NewGameClock creates a clock at the start of the game. A rate of 0 uses DefaultYearsPerHour.
+func NewGameClock(yearsPerHour float64) GameClock {
+	if yearsPerHour <= 0 {
+		yearsPerHour = DefaultYearsPerHour
+	}
+	return GameClock{YearsPerHour: yearsPerHour}
+}
+
+// DaysPerSecond returns the number of in-game days that pass in one real second
+func (c GameClock) DaysPerSecond() float64 {
+	return c.YearsPerHour * DaysPerYear / time.Hour.Seconds()
+}
+
+// Advance moves the clock forward by a real duration and returns the in-game days that passed
+func (c *GameClock) Advance(elapsed time.Duration) float64 {
+	if c.Paused {
+		return 0
+	}
+	days := elapsed.Seconds() * c.DaysPerSecond()
+	c.Days += days
+	return days
+}
+
+// Date returns the current in-game date
+func (c GameClock) Date() GameDate {
+	return DateOf(c.Days)
+}
+
+// RealDuration returns how long a number of in-game days takes at the current rate
+func (c GameClock) RealDuration(days float64) time.Duration {
+	perSecond := c.DaysPerSecond()
+	if perSecond <= 0 {
+		return 0
+	}
+	return time.Duration(days / perSecond * float64(time.Second))
+}
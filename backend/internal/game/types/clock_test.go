@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+)
+
+func TestGameClock(t *testing.T) {
+	clock := types.NewGameClock(0)
+	if clock.YearsPerHour != types.DefaultYearsPerHour {
+		t.Fatalf("Expected the default rate, got %v", clock.YearsPerHour)
+	}
+
+	if date := clock.Date(); date != (types.GameDate{Year: types.StartYear, Month: 1, Day: 1}) {
+		t.Errorf("Expected the game to start on the first day of %d, got %s", types.StartYear, date)
+	}
+
+	// One real hour is one in-game year
+	if days := clock.Advance(time.Hour); days != types.DaysPerYear {
+		t.Errorf("Expected an hour to take %d days, took %v", types.DaysPerYear, days)
+	}
+	if date := clock.Date(); date != (types.GameDate{Year: types.StartYear + 1, Month: 1, Day: 1}) {
+		t.Errorf("Expected a year to have passed, got %s", date)
+	}
+
+	clock.Advance(clock.RealDuration(2*types.DaysPerMonth + 4.5))
+	if date := clock.Date(); date != (types.GameDate{Year: types.StartYear + 1, Month: 3, Day: 5}) {
+		t.Errorf("Expected %d.03.05, got %s", types.StartYear+1, date)
+	}
+
+	// A paused clock stands still
+	clock.Paused = true
+	if days := clock.Advance(time.Hour); days != 0 || clock.Date().Day != 5 {
+		t.Errorf("Expected a paused clock not to advance, advanced %v days", days)
+	}
+
+	// A faster clock covers the same days in less real time
+	fast := types.NewGameClock(4)
+	if fast.RealDuration(types.DaysPerYear) != 15*time.Minute {
+		t.Errorf("Expected a year to take 15 minutes, took %v", fast.RealDuration(types.DaysPerYear))
+	}
+}
+
+func TestGameTickCrossed(t *testing.T) {
+	tick := &types.GameTickEvent{Days: 0.4, Now: 2.1}
+	if !tick.Crossed(1) {
+		t.Error("Expected a tick from day 1.7 to 2.1 to start a new day")
+	}
+	if tick.Crossed(3) {
+		t.Error("Expected a tick from day 1.7 to 2.1 not to reach day 3")
+	}
+
+	tick = &types.GameTickEvent{Days: 0.05, Now: 2.15}
+	if tick.Crossed(1) {
+		t.Error("Expected a tick within a day not to start a new day")
+	}
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"math"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// PopulationPerSize is the population a perfectly habitable planet of size 1
+	// (a tenth of earth) can hold
+	PopulationPerSize = 2
+
+	// SizePerIndustrySlot is the planet size needed for each building level
+	SizePerIndustrySlot = 2
+)
+
+// Colony represents a settled planet that can construct ships and buildings
+type Colony struct {
+	ID            uuid.UUID    `json:"id"`
+	Ref           uint64       `json:"ref"`
+	Name          string       `json:"name"`
+	Owner         uuid.UUID    `json:"owner"` // empire ID
+	SystemID      uuid.UUID    `json:"system_id"`
+	PlanetID      uuid.UUID    `json:"planet_id"`
+	Population    int64        `json:"population"`
+	MaxPopulation int64        `json:"max_population"`
+	IndustrySlots int          `json:"industry_slots"` // building levels the planet has room for
+	Growth        float64      `json:"growth"`         // population grown since the last full unit
+	BuildQueue    []*BuildItem `json:"build_queue"`    // constructed in order, only the first item makes progress
+}
+
+func NewColony(id uuid.UUID, name string, owner, systemID, planetID uuid.UUID) *Colony {
+	return &Colony{
+		ID:         id,
+		Name:       name,
+		Owner:      owner,
+		SystemID:   systemID,
+		PlanetID:   planetID,
+		BuildQueue: make([]*BuildItem, 0),
+	}
+}
+
+// ColonyCapacity returns the population a planet can hold
+func ColonyCapacity(size int, habitability float64) int64 {
+	return int64(math.Round(float64(size) * habitability * PopulationPerSize))
+}
+
+// IndustrySlots returns the building levels a planet has room for, at least one
+func IndustrySlots(size int) int {
+	return max(size/SizePerIndustrySlot, 1)
+}
+
+// FoundColony settles a planet for an empire and registers the colony in the
+// world. Must be called with the world lock held.
+func (w *WorldState) FoundColony(empire *EmpireState, system *StarSystemState, planet *PlanetState, habitability float64, population int64) *Colony {
+	colony := NewColony(w.IDs.NewID(), planet.Name, empire.ID, system.ID, planet.ID)
+	colony.Ref = w.IDs.Register(colony.ID)
+	colony.Population = population
+	colony.MaxPopulation = max(ColonyCapacity(planet.Size, habitability), population)
+	colony.IndustrySlots = IndustrySlots(planet.Size)
+
+	planet.Population = population
+	planet.ColonyID = &colony.ID
+
+	w.Colonies[colony.ID] = colony
+	empire.Colonies = append(empire.Colonies, colony.ID)
+
+	return colony
+}
+
+// ClaimSystem makes an empire the owner of a system. It returns the previous
+// owner and whether the owner changed. Must be called with the world lock held.
+func (w *WorldState) ClaimSystem(empire *EmpireState, system *StarSystemState) (*uuid.UUID, bool) {
+	previous := system.Owner
+	if previous != nil && *previous == empire.ID {
+		return previous, false
+	}
+
+	if previous != nil {
+		if owner, exists := w.GetEmpireByID(*previous); exists {
+			owner.Systems = removeID(owner.Systems, system.ID)
+		}
+	}
+
+	system.SetOwner(empire.ID)
+	empire.Systems = append(empire.Systems, system.ID)
+
+	return previous, true
+}
+
+func removeID(ids []uuid.UUID, id uuid.UUID) []uuid.UUID {
+	for i, existing := range ids {
+		if existing == id {
+			return append(ids[:i], ids[i+1:]...)
+		}
+	}
+	return ids
+}
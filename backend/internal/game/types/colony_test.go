@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+)
+
+func TestFoundColonyClaimsSystem(t *testing.T) {
+	world := types.NewWorldState()
+
+	planet := &types.PlanetState{ID: uuid.New(), Name: "Test 1", Size: 10}
+	system := &types.StarSystemState{ID: uuid.New(), Planets: []*types.PlanetState{planet}}
+	world.Galaxy.AddSystem(system)
+
+	first := types.NewEmpireState(uuid.New(), "First")
+	second := types.NewEmpireState(uuid.New(), "Second")
+	world.Empires[first.PlayerID] = first
+	world.Empires[second.PlayerID] = second
+
+	colony := world.FoundColony(first, system, planet, 0.5, 2)
+	if colony.MaxPopulation != types.ColonyCapacity(10, 0.5) || colony.MaxPopulation != 10 {
+		t.Errorf("Expected capacity of 10, got %d", colony.MaxPopulation)
+	}
+	if colony.IndustrySlots != 5 {
+		t.Errorf("Expected 5 industry slots, got %d", colony.IndustrySlots)
+	}
+	if planet.ColonyID == nil || *planet.ColonyID != colony.ID || planet.Population != 2 {
+		t.Error("Expected planet to reference the colony")
+	}
+	if id, ok := world.IDs.Resolve(colony.Ref); !ok || id != colony.ID {
+		t.Error("Expected colony to be registered")
+	}
+
+	if _, claimed := world.ClaimSystem(first, system); !claimed {
+		t.Fatal("Expected unowned system to be claimed")
+	}
+	if _, claimed := world.ClaimSystem(first, system); claimed {
+		t.Error("Expected claiming an owned system again to be a no-op")
+	}
+
+	previous, claimed := world.ClaimSystem(second, system)
+	if !claimed || previous == nil || *previous != first.ID {
+		t.Fatal("Expected ownership to change from the first empire")
+	}
+	if len(first.Systems) != 0 || len(second.Systems) != 1 {
+		t.Errorf("Expected system to move between empires, got %v and %v", first.Systems, second.Systems)
+	}
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"github.com/google/uuid"
+)
+
+// BuildKind tells what a build item produces
+type BuildKind string
+
+const (
+	BuildKindShip     BuildKind = "ship"
+	BuildKindBuilding BuildKind = "building"
+)
+
+// BuildItem is an entry in a colony build queue. An item builds Quantity units
+// one after another. Each unit is paid for when its construction starts.
+type BuildItem struct {
+	ID        uuid.UUID     `json:"id"`
+	Ref       uint64        `json:"ref"`
+	Kind      BuildKind     `json:"kind"`
+	Type      string        `json:"type"` // ship or building type key
+	ColonyID  uuid.UUID     `json:"colony_id"`
+	Quantity  int           `json:"quantity"`   // units left, including the one under construction
+	Cost      ResourceState `json:"cost"`       // cost of a single unit
+	BuildTime float64       `json:"build_time"` // in-game days to build a single unit
+	Progress  float64       `json:"progress"`   // in-game days spent on the current unit
+	Funded    bool          `json:"funded"`     // whether the current unit has been paid for
+}
+
+func NewBuildItem(id uuid.UUID, kind BuildKind, buildType string, colonyID uuid.UUID, quantity int, cost ResourceState, buildTime float64) *BuildItem {
+	return &BuildItem{
+		ID:        id,
+		Kind:      kind,
+		Type:      buildType,
+		ColonyID:  colonyID,
+		Quantity:  quantity,
+		Cost:      cost,
+		BuildTime: buildTime,
+	}
+}
+
+// IndexOfBuildItem returns the position of an item in a queue or -1
+func IndexOfBuildItem(queue []*BuildItem, itemID uuid.UUID) int {
+	for i, item := range queue {
+		if item.ID == itemID {
+			return i
+		}
+	}
+	return -1
+}
+
+// MoveBuildItem moves the item at index from to position to, clamping to the
+// bounds of the queue. The queue is modified in place.
+func MoveBuildItem(queue []*BuildItem, from, to int) {
+	if to >= len(queue) {
+		to = len(queue) - 1
+	}
+	if to < 0 || from == to {
+		return
+	}
+
+	item := queue[from]
+	if from < to {
+		copy(queue[from:to], queue[from+1:to+1])
+	} else {
+		copy(queue[to+1:from+1], queue[to:from])
+	}
+	queue[to] = item
+}
+
+// RemoveBuildItem returns the queue without the item at index i
+func RemoveBuildItem(queue []*BuildItem, i int) []*BuildItem {
+	return append(queue[:i], queue[i+1:]...)
+}
@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+)
+
+func TestMoveBuildItem(t *testing.T) {
+	colonyID := uuid.New()
+	queue := make([]*types.BuildItem, 4)
+	for i := range queue {
+		queue[i] = types.NewBuildItem(uuid.New(), types.BuildKindShip, "fighter", colonyID, 1, types.ResourceState{}, 60)
+	}
+	a, b, c, d := queue[0], queue[1], queue[2], queue[3]
+
+	types.MoveBuildItem(queue, 3, 0)
+	assertOrder(t, queue, d, a, b, c)
+
+	types.MoveBuildItem(queue, 0, 10)
+	assertOrder(t, queue, a, b, c, d)
+
+	types.MoveBuildItem(queue, 1, 2)
+	assertOrder(t, queue, a, c, b, d)
+
+	if i := types.IndexOfBuildItem(queue, b.ID); i != 2 {
+		t.Errorf("Expected item at index 2, got %d", i)
+	}
+
+	queue = types.RemoveBuildItem(queue, 0)
+	assertOrder(t, queue, c, b, d)
+}
+
+func assertOrder(t *testing.T, queue []*types.BuildItem, expected ...*types.BuildItem) {
+	t.Helper()
+	if len(queue) != len(expected) {
+		t.Fatalf("Expected %d items, got %d", len(expected), len(queue))
+	}
+	for i := range expected {
+		if queue[i] != expected[i] {
+			t.Errorf("Unexpected item at index %d", i)
+		}
+	}
+}
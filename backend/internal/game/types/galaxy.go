@@ -0,0 +1,87 @@
+package types
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/domain/galaxy"
+)
+
+// NewGalaxyStateFromGalaxy builds the runtime galaxy state from a generated galaxy.
+// System and planet IDs are kept, so the returned state and g.FindPath refer to the
+// same systems.
+func NewGalaxyStateFromGalaxy(g *galaxy.Galaxy) *GalaxyState {
+	if len(g.Adjacency) != len(g.StarSystems) {
+		g.BuildAdjacencyList()
+	}
+
+	state := NewGalaxyState()
+	state.Map = g
+
+	for _, system := range g.StarSystems {
+		state.Systems[system.ID] = newStarSystemState(system, g.Adjacency[system.ID])
+	}
+
+	return state
+}
+
+func newStarSystemState(system *galaxy.StarSystem, adjacent map[uuid.UUID]float64) *StarSystemState {
+	state := &StarSystemState{
+		ID:         system.ID,
+		Name:       system.Name,
+		Position:   Coordinates{X: system.LocationX, Y: system.LocationY},
+		Hyperlanes: make([]uuid.UUID, 0, len(adjacent)),
+		Planets:    make([]*PlanetState, 0),
+		Fleets:     make(map[uuid.UUID]*Fleet),
+		Buildings:  make([]BuildingState, 0),
+	}
+
+	// Adjacency is deduplicated and symmetric, unlike ConnectedSystems.
+	// Sorted so that the same galaxy always gives the same state.
+	for id := range adjacent {
+		state.Hyperlanes = append(state.Hyperlanes, id)
+	}
+	sort.Slice(state.Hyperlanes, func(i, j int) bool {
+		return state.Hyperlanes[i].String() < state.Hyperlanes[j].String()
+	})
+
+	for _, star := range system.Stars {
+		if state.StarType == "" {
+			state.StarType = star.Type.Name
+		}
+		for _, planet := range star.Planets {
+			state.Planets = append(state.Planets, &PlanetState{
+				ID:   planet.ID,
+				Name: planet.Name,
+				Type: planet.Type,
+				Size: planet.Size,
+			})
+		}
+	}
+
+	return state
+}
+
+// FindPath returns the hyperlane route from one system to another, excluding the
+// start system. It returns nil if there is no route.
+func (g *GalaxyState) FindPath(from, to uuid.UUID) []uuid.UUID {
+	if g.Map == nil {
+		return nil
+	}
+	return g.Map.FindPath(from, to)
+}
+
+// IsConnected reports whether two systems are directly connected by a hyperlane
+func (g *GalaxyState) IsConnected(from, to uuid.UUID) bool {
+	_, ok := g.HyperlaneLength(from, to)
+	return ok
+}
+
+// HyperlaneLength returns the length of the hyperlane between two connected systems
+func (g *GalaxyState) HyperlaneLength(from, to uuid.UUID) (float64, bool) {
+	if g.Map == nil {
+		return 0, false
+	}
+	return g.Map.HyperlaneLength(from, to)
+}
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/gr4vediggr/stellarlight/internal/domain/galaxy"
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+)
+
+func TestNewGalaxyStateFromGalaxy(t *testing.T) {
+	g := galaxy.NewGalaxy("Test Galaxy")
+
+	a := &galaxy.StarSystem{Name: "A", LocationX: 0, LocationY: 0}
+	b := &galaxy.StarSystem{Name: "B", LocationX: 1, LocationY: 0}
+	c := &galaxy.StarSystem{Name: "C", LocationX: 2, LocationY: 0}
+	for _, system := range []*galaxy.StarSystem{a, b, c} {
+		g.AddStarSystem(system)
+	}
+
+	a.Stars = []galaxy.Star{{
+		Type:    galaxy.StarType{Name: "Red Dwarf"},
+		Planets: []galaxy.Planet{{Name: "A 1", Type: "Terrestrial", Size: 10}},
+	}}
+
+	// Duplicate connections are produced by the triangulation
+	a.ConnectedSystems = append(a.ConnectedSystems, b.ID, b.ID)
+	b.ConnectedSystems = append(b.ConnectedSystems, a.ID, c.ID)
+	c.ConnectedSystems = append(c.ConnectedSystems, b.ID)
+
+	state := types.NewGalaxyStateFromGalaxy(g)
+
+	if len(state.Systems) != 3 {
+		t.Fatalf("Expected 3 systems, got %d", len(state.Systems))
+	}
+
+	systemA, ok := state.GetSystem(a.ID)
+	if !ok {
+		t.Fatal("Expected system IDs to be kept")
+	}
+	if len(systemA.Hyperlanes) != 1 || systemA.Hyperlanes[0] != b.ID {
+		t.Errorf("Expected A to have a single hyperlane to B, got %v", systemA.Hyperlanes)
+	}
+	if len(systemA.Planets) != 1 || systemA.Planets[0].Size != 10 {
+		t.Errorf("Expected planet to be mapped, got %v", systemA.Planets)
+	}
+	if systemA.Fleets == nil {
+		t.Error("Expected fleets map to be initialised")
+	}
+
+	systemB, _ := state.GetSystem(b.ID)
+	if len(systemB.Hyperlanes) != 2 {
+		t.Errorf("Expected B to have 2 hyperlanes, got %d", len(systemB.Hyperlanes))
+	}
+
+	if !state.IsConnected(c.ID, b.ID) || state.IsConnected(a.ID, c.ID) {
+		t.Error("Unexpected hyperlane connectivity")
+	}
+
+	path := state.FindPath(a.ID, c.ID)
+	if len(path) != 2 || path[0] != b.ID || path[1] != c.ID {
+		t.Errorf("Expected path A -> B -> C, got %v", path)
+	}
+	for _, id := range path {
+		if _, ok := state.GetSystem(id); !ok {
+			t.Errorf("Path system %s not found in galaxy state", id)
+		}
+	}
+}
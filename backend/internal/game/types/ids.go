@@ -0,0 +1,133 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// IDRegistry maps world entity IDs to the numeric references used by the
+// protobuf messages. References are assigned in registration order, starting
+// at 1 so that an unset protobuf field never resolves to an entity.
+//
+// The registry also hands out the IDs of entities created during the game.
+// They are derived from a namespace and a counter, so a game replayed from
+// the same seed creates the same IDs.
+type IDRegistry struct {
+	next   uint64
+	byRef  map[uint64]uuid.UUID
+	byUUID map[uuid.UUID]uint64
+
+	namespace uuid.UUID
+	minted    uint64 // IDs handed out so far
+
+	mu sync.RWMutex
+}
+
+func NewIDRegistry() *IDRegistry {
+	return &IDRegistry{
+		next:      1,
+		byRef:     make(map[uint64]uuid.UUID),
+		byUUID:    make(map[uuid.UUID]uint64),
+		namespace: uuid.New(),
+	}
+}
+
+// Seed makes the IDs handed out by NewID depend only on seed
+func (r *IDRegistry) Seed(seed int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var data [8]byte
+	binary.BigEndian.PutUint64(data[:], uint64(seed))
+	r.namespace = uuid.NewSHA1(uuid.NameSpaceOID, data[:])
+	r.minted = 0
+}
+
+// NewID returns the ID of a new entity. IDs are not registered.
+func (r *IDRegistry) NewID() uuid.UUID {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.minted++
+	var data [8]byte
+	binary.BigEndian.PutUint64(data[:], r.minted)
+	return uuid.NewSHA1(r.namespace, data[:])
+}
+
+// Register returns the reference of an entity, assigning a new one if needed
+func (r *IDRegistry) Register(id uuid.UUID) uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if ref, exists := r.byUUID[id]; exists {
+		return ref
+	}
+
+	ref := r.next
+	r.next++
+	r.byRef[ref] = id
+	r.byUUID[id] = ref
+
+	return ref
+}
+
+// Resolve returns the entity ID a reference was assigned to
+func (r *IDRegistry) Resolve(ref uint64) (uuid.UUID, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	id, exists := r.byRef[ref]
+	return id, exists
+}
+
+// Ref returns the reference assigned to an entity ID
+func (r *IDRegistry) Ref(id uuid.UUID) (uint64, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ref, exists := r.byUUID[id]
+	return ref, exists
+}
+
+// registryJSON is the stored form of an IDRegistry
+type registryJSON struct {
+	Next      uint64               `json:"next"`
+	Refs      map[uint64]uuid.UUID `json:"refs"`
+	Namespace uuid.UUID            `json:"namespace"`
+	Minted    uint64               `json:"minted"`
+}
+
+// MarshalJSON stores the assigned references, so entities keep their
+// references when a world is restored
+func (r *IDRegistry) MarshalJSON() ([]byte, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return json.Marshal(registryJSON{Next: r.next, Refs: r.byRef, Namespace: r.namespace, Minted: r.minted})
+}
+
+func (r *IDRegistry) UnmarshalJSON(data []byte) error {
+	var stored registryJSON
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.next = max(stored.Next, 1)
+	r.minted = stored.Minted
+	if stored.Namespace != uuid.Nil {
+		r.namespace = stored.Namespace
+	}
+	r.byRef = make(map[uint64]uuid.UUID, len(stored.Refs))
+	r.byUUID = make(map[uuid.UUID]uint64, len(stored.Refs))
+	for ref, id := range stored.Refs {
+		r.byRef[ref] = id
+		r.byUUID[id] = ref
+		r.next = max(r.next, ref+1)
+	}
+	return nil
+}
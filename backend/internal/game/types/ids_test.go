@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+)
+
+func TestIDRegistry(t *testing.T) {
+	registry := types.NewIDRegistry()
+
+	a, b := uuid.New(), uuid.New()
+	refA := registry.Register(a)
+	refB := registry.Register(b)
+
+	if refA == 0 || refB == 0 || refA == refB {
+		t.Fatalf("Expected distinct non-zero references, got %d and %d", refA, refB)
+	}
+
+	if again := registry.Register(a); again != refA {
+		t.Errorf("Expected registering twice to return %d, got %d", refA, again)
+	}
+
+	if id, ok := registry.Resolve(refB); !ok || id != b {
+		t.Errorf("Expected %d to resolve to %s, got %s", refB, b, id)
+	}
+
+	if ref, ok := registry.Ref(a); !ok || ref != refA {
+		t.Errorf("Expected %s to have reference %d, got %d", a, refA, ref)
+	}
+
+	if _, ok := registry.Resolve(0); ok {
+		t.Error("Expected reference 0 to be unresolvable")
+	}
+}
@@ -1,18 +1,28 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
 	"sync"
-	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/domain/empire"
+	"github.com/gr4vediggr/stellarlight/internal/domain/galaxy"
 )
 
 // WorldState holds all game world data
 type WorldState struct {
 	Galaxy   *GalaxyState
-	Empires  map[uuid.UUID]*EmpireState
-	Turn     int
-	GameTime time.Time
+	Empires  map[uuid.UUID]*EmpireState // keyed by player ID
+	Battles  map[uuid.UUID]*Battle      // ongoing battles keyed by star system ID
+	Colonies map[uuid.UUID]*Colony
+	IDs      *IDRegistry // numeric references used in protobuf commands
+	Turn     int         // ticks simulated so far
+	Clock    GameClock
 
 	// Add other world state as needed
 	mu sync.RWMutex
@@ -23,8 +33,11 @@ func NewWorldState() *WorldState {
 	return &WorldState{
 		Galaxy:   NewGalaxyState(),
 		Empires:  make(map[uuid.UUID]*EmpireState),
+		Battles:  make(map[uuid.UUID]*Battle),
+		Colonies: make(map[uuid.UUID]*Colony),
+		IDs:      NewIDRegistry(),
 		Turn:     0,
-		GameTime: time.Now(),
+		Clock:    NewGameClock(DefaultYearsPerHour),
 	}
 }
 
@@ -36,22 +49,141 @@ func (w *WorldState) ReleaseLock() {
 	w.mu.Unlock()
 }
 
+// Copy returns a deep copy of the world, so it can be stored without holding
+// the lock. The generated galaxy map does not change during a game and is
+// shared. Vision is not copied and is worked out again by the vision system.
+// Must be called with the lock held.
+func (w *WorldState) Copy() (*WorldState, error) {
+	data, err := json.Marshal(w)
+	if err != nil {
+		return nil, err
+	}
+
+	copied := NewWorldState()
+	if err := json.Unmarshal(data, copied); err != nil {
+		return nil, err
+	}
+	copied.Galaxy.Map = w.Galaxy.Map
+	copied.Relink()
+
+	return copied, nil
+}
+
+// Checksum returns a hash of the simulated state of the world. Two worlds
+// that went through the same ticks and commands from the same seed have the
+// same checksum. The wall-clock time at which empires last saw a system is
+// left out. Must be called with the lock held.
+func (w *WorldState) Checksum() (string, error) {
+	data, err := json.Marshal(w)
+	if err != nil {
+		return "", err
+	}
+
+	// Decode into maps, whose keys are encoded in sorted order
+	var state map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&state); err != nil {
+		return "", err
+	}
+	empires, _ := state["Empires"].(map[string]any)
+	for _, empire := range empires {
+		intel, _ := empire.(map[string]any)["intel"].(map[string]any)
+		for _, system := range intel {
+			delete(system.(map[string]any), "last_seen")
+		}
+	}
+
+	canonical, err := json.Marshal(state)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// SortedEmpires returns the empires ordered by empire ID, for systems that
+// must handle them in the same order every time. Must be called with the lock held.
+func (w *WorldState) SortedEmpires() []*EmpireState {
+	empires := make([]*EmpireState, 0, len(w.Empires))
+	for _, empire := range w.Empires {
+		empires = append(empires, empire)
+	}
+	sort.Slice(empires, func(i, j int) bool {
+		return empires[i].ID.String() < empires[j].ID.String()
+	})
+	return empires
+}
+
+// Relink restores the references a world shares between its parts after it
+// was decoded: systems hold the fleets of their empires and colonies the
+// build items of their empire's queue. Must be called with the lock held.
+func (w *WorldState) Relink() {
+	for _, system := range w.Galaxy.Systems {
+		system.Fleets = make(map[uuid.UUID]*Fleet)
+	}
+
+	items := make(map[uuid.UUID]*BuildItem)
+	for _, empire := range w.Empires {
+		if empire.Visible == nil {
+			empire.Visible = make(map[uuid.UUID]bool)
+		}
+
+		for _, fleet := range empire.TotalFleets {
+			if system, exists := w.Galaxy.Systems[fleet.Location]; exists {
+				system.Fleets[fleet.ID] = fleet
+			}
+		}
+
+		for _, item := range empire.BuildQueue {
+			items[item.ID] = item
+		}
+	}
+
+	for _, colony := range w.Colonies {
+		queue := make([]*BuildItem, 0, len(colony.BuildQueue))
+		for _, item := range colony.BuildQueue {
+			if shared, exists := items[item.ID]; exists {
+				queue = append(queue, shared)
+			}
+		}
+		colony.BuildQueue = queue
+	}
+}
+
+// GetEmpireByID looks up an empire by its empire ID. Must be called with the lock held.
+func (w *WorldState) GetEmpireByID(empireID uuid.UUID) (*EmpireState, bool) {
+	for _, empire := range w.Empires {
+		if empire.ID == empireID {
+			return empire, true
+		}
+	}
+	return nil, false
+}
+
 // GalaxyState represents the state of the game galaxy
 type GalaxyState struct {
 	Systems map[uuid.UUID]*StarSystemState `json:"systems"`
-	mu      sync.RWMutex
+
+	// Map is the generated galaxy the state was built from. It shares its
+	// system IDs with Systems and is used for pathfinding along hyperlanes.
+	Map *galaxy.Galaxy `json:"-"`
+	mu  sync.RWMutex
 }
 
 // StarSystemState represents a star system in the game
 type StarSystemState struct {
-	ID        uuid.UUID            `json:"id"`
-	Name      string               `json:"name"`
-	Position  Coordinates          `json:"position"`
-	Owner     *uuid.UUID           `json:"owner,omitempty"`
-	Planets   []*PlanetState       `json:"planets"`
-	Fleets    map[uuid.UUID]*Fleet `json:"fleets"`
-	Resources ResourceState        `json:"resources"`
-	Buildings []BuildingState      `json:"buildings"`
+	ID         uuid.UUID            `json:"id"`
+	Ref        uint64               `json:"ref"`
+	Name       string               `json:"name"`
+	StarType   string               `json:"star_type"`
+	Position   Coordinates          `json:"position"`
+	Owner      *uuid.UUID           `json:"owner,omitempty"`
+	Hyperlanes []uuid.UUID          `json:"hyperlanes"` // IDs of systems connected by a hyperlane
+	Planets    []*PlanetState       `json:"planets"`
+	Fleets     map[uuid.UUID]*Fleet `json:"-"` // encoded with the fleets of their empires, see Relink
+	Resources  ResourceState        `json:"resources"`
+	Buildings  []BuildingState      `json:"buildings"`
 
 	mu sync.RWMutex
 }
@@ -64,9 +196,15 @@ type EmpireState struct {
 	Color        string                     `json:"color"`
 	HomeSystem   uuid.UUID                  `json:"home_system"`
 	Systems      []uuid.UUID                `json:"systems"`
+	Colonies     []uuid.UUID                `json:"colonies"`
+	BuildQueue   []*BuildItem               `json:"build_queue"` // all queued items in the order they are funded
 	TotalFleets  map[uuid.UUID]*Fleet       `json:"total_fleets"`
 	Resources    ResourceState              `json:"resources"`
+	Income       IncomeBreakdown            `json:"income"` // income of the last economy update
 	Technologies map[string]TechnologyLevel `json:"technologies"`
+	Research     []string                   `json:"research"` // technologies queued for research, in order
+	Visible      map[uuid.UUID]bool         `json:"-"`        // systems currently in vision
+	Intel        map[uuid.UUID]*SystemIntel `json:"intel"`    // last known contents of every system ever seen
 
 	mu sync.RWMutex
 }
@@ -80,22 +218,26 @@ type Coordinates struct {
 // PlanetState represents a planet
 type PlanetState struct {
 	ID         uuid.UUID     `json:"id"`
+	Ref        uint64        `json:"ref"`
 	Name       string        `json:"name"`
 	Type       string        `json:"type"`
 	Size       int           `json:"size"`
-	Population int64         `json:"population"`
+	Population int64         `json:"population"` // mirrors the population of the colony on the planet
+	ColonyID   *uuid.UUID    `json:"colony_id,omitempty"`
 	Resources  ResourceState `json:"resources"`
 }
 
 // Fleet represents a collection of ships
 type Fleet struct {
 	ID          uuid.UUID      `json:"id"`
+	Ref         uint64         `json:"ref"`
 	Name        string         `json:"name"`
-	Owner       uuid.UUID      `json:"owner"`
+	Owner       uuid.UUID      `json:"owner"` // empire ID
 	Ships       map[string]int `json:"ships"` // ship_type -> count
 	Location    uuid.UUID      `json:"location"`
-	Destination *uuid.UUID     `json:"destination,omitempty"`
-	ArrivalTime *int64         `json:"arrival_time,omitempty"`
+	Destination *uuid.UUID     `json:"destination,omitempty"`  // next system on the route
+	ArrivalTime *float64       `json:"arrival_time,omitempty"` // in-game day of arrival at Destination
+	Path        []uuid.UUID    `json:"path,omitempty"`         // remaining route after Destination
 }
 
 // ResourceState represents resources
@@ -107,6 +249,53 @@ type ResourceState struct {
 	Population int64 `json:"population"`
 }
 
+// NewResourceState converts resources defined in assets to a resource state
+func NewResourceState(r empire.Resources) ResourceState {
+	return ResourceState{
+		Credits:  r.Credits,
+		Minerals: r.Minerals,
+		Energy:   r.Energy,
+		Research: r.Research,
+	}
+}
+
+// Add returns the sum of two resource states
+func (r ResourceState) Add(o ResourceState) ResourceState {
+	return ResourceState{
+		Credits:    r.Credits + o.Credits,
+		Minerals:   r.Minerals + o.Minerals,
+		Energy:     r.Energy + o.Energy,
+		Research:   r.Research + o.Research,
+		Population: r.Population + o.Population,
+	}
+}
+
+// Sub returns the difference of two resource states
+func (r ResourceState) Sub(o ResourceState) ResourceState {
+	return r.Add(o.Scale(-1))
+}
+
+// Scale returns the resource state multiplied by n
+func (r ResourceState) Scale(n int64) ResourceState {
+	return ResourceState{
+		Credits:    r.Credits * n,
+		Minerals:   r.Minerals * n,
+		Energy:     r.Energy * n,
+		Research:   r.Research * n,
+		Population: r.Population * n,
+	}
+}
+
+// IncomeBreakdown shows where an empire's resources come from and go
+type IncomeBreakdown struct {
+	Systems    ResourceState `json:"systems"`    // owned systems and the capital
+	Planets    ResourceState `json:"planets"`    // planets in owned systems
+	Population ResourceState `json:"population"` // work done by the population
+	Buildings  ResourceState `json:"buildings"`
+	Upkeep     ResourceState `json:"upkeep"` // fleet upkeep, deducted from the income
+	Net        ResourceState `json:"net"`
+}
+
 // BuildingState represents a building on a planet
 type BuildingState struct {
 	Type     string    `json:"type"`
@@ -134,9 +323,14 @@ func NewEmpireState(playerID uuid.UUID, name string) *EmpireState {
 		PlayerID:     playerID,
 		Name:         name,
 		Systems:      make([]uuid.UUID, 0),
+		Colonies:     make([]uuid.UUID, 0),
+		BuildQueue:   make([]*BuildItem, 0),
 		TotalFleets:  make(map[uuid.UUID]*Fleet),
 		Resources:    ResourceState{Credits: 1000, Minerals: 500, Energy: 500},
 		Technologies: make(map[string]TechnologyLevel),
+		Research:     make([]string, 0),
+		Visible:      make(map[uuid.UUID]bool),
+		Intel:        make(map[uuid.UUID]*SystemIntel),
 	}
 }
 
@@ -154,6 +348,20 @@ func (g *GalaxyState) GetSystem(id uuid.UUID) (*StarSystemState, bool) {
 	return system, exists
 }
 
+// FindPlanet returns a planet and the system it orbits in
+func (g *GalaxyState) FindPlanet(planetID uuid.UUID) (*StarSystemState, *PlanetState, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	for _, system := range g.Systems {
+		for _, planet := range system.Planets {
+			if planet.ID == planetID {
+				return system, planet, true
+			}
+		}
+	}
+	return nil, nil, false
+}
+
 // Empire operations
 func (e *EmpireState) AddResources(resources ResourceState) {
 	e.mu.Lock()
@@ -166,6 +374,41 @@ func (e *EmpireState) AddResources(resources ResourceState) {
 	e.Resources.Population += resources.Population
 }
 
+// ApplyIncome adds the net income to the stockpile. Stockpiles never drop
+// below zero when upkeep exceeds income.
+func (e *EmpireState) ApplyIncome(income IncomeBreakdown) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.Income = income
+	e.Resources.Credits = max(e.Resources.Credits+income.Net.Credits, 0)
+	e.Resources.Minerals = max(e.Resources.Minerals+income.Net.Minerals, 0)
+	e.Resources.Energy = max(e.Resources.Energy+income.Net.Energy, 0)
+	e.Resources.Research = max(e.Resources.Research+income.Net.Research, 0)
+}
+
+// TakeResearch removes all research points from the stockpile and returns them
+func (e *EmpireState) TakeResearch() int64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	points := e.Resources.Research
+	e.Resources.Research = 0
+	return points
+}
+
+// HasTechnology reports whether the empire has researched a technology
+func (e *EmpireState) HasTechnology(key string) bool {
+	return e.Technologies[key].Level > 0
+}
+
+// GetResources returns a copy of the empire's stockpile
+func (e *EmpireState) GetResources() ResourceState {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.Resources
+}
+
 func (e *EmpireState) CanAfford(cost ResourceState) bool {
 	e.mu.RLock()
 	defer e.mu.RUnlock()
@@ -179,7 +422,9 @@ func (e *EmpireState) SpendResources(cost ResourceState) bool {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
-	if !e.CanAfford(cost) {
+	if e.Resources.Credits < cost.Credits ||
+		e.Resources.Minerals < cost.Minerals ||
+		e.Resources.Energy < cost.Energy {
 		return false
 	}
 
@@ -208,3 +453,19 @@ func (s *StarSystemState) RemoveFleet(fleetID uuid.UUID) {
 	defer s.mu.Unlock()
 	delete(s.Fleets, fleetID)
 }
+
+// Fleet operations
+
+// ShipCount returns the total number of ships in the fleet
+func (f *Fleet) ShipCount() int {
+	total := 0
+	for _, count := range f.Ships {
+		total += count
+	}
+	return total
+}
+
+// InTransit reports whether the fleet is travelling along a hyperlane
+func (f *Fleet) InTransit() bool {
+	return f.Destination != nil
+}
@@ -0,0 +1,133 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+)
+
+func TestApplyIncome(t *testing.T) {
+	empire := types.NewEmpireState(uuid.New(), "Test")
+	empire.Resources = types.ResourceState{Credits: 10, Minerals: 10}
+
+	income := types.IncomeBreakdown{
+		Planets: types.ResourceState{Credits: 5, Minerals: 2},
+		Upkeep:  types.ResourceState{Credits: 20},
+	}
+	income.Net = income.Planets.Sub(income.Upkeep)
+
+	empire.ApplyIncome(income)
+
+	resources := empire.GetResources()
+	if resources.Credits != 0 {
+		t.Errorf("Expected credits to stop at 0, got %d", resources.Credits)
+	}
+	if resources.Minerals != 12 {
+		t.Errorf("Expected 12 minerals, got %d", resources.Minerals)
+	}
+	if empire.Income.Net.Credits != -15 {
+		t.Errorf("Expected net income of -15 credits, got %d", empire.Income.Net.Credits)
+	}
+}
+
+func TestWorldStateCopy(t *testing.T) {
+	world := types.NewWorldState()
+	systems := chain(world, 2)
+	for _, system := range systems {
+		system.Ref = world.IDs.Register(system.ID)
+	}
+
+	empire := types.NewEmpireState(uuid.New(), "Copied")
+	world.Empires[empire.PlayerID] = empire
+
+	fleet := &types.Fleet{ID: uuid.New(), Owner: empire.ID, Ships: map[string]int{"fighter": 2}, Location: systems[0].ID}
+	fleet.Ref = world.IDs.Register(fleet.ID)
+	empire.TotalFleets[fleet.ID] = fleet
+	systems[0].AddFleet(fleet)
+
+	planet := &types.PlanetState{ID: uuid.New(), Name: "Home", Size: 5}
+	systems[1].Planets = append(systems[1].Planets, planet)
+	colony := world.FoundColony(empire, systems[1], planet, 1, 10)
+	item := types.NewBuildItem(uuid.New(), types.BuildKindShip, "fighter", colony.ID, 3, types.ResourceState{Credits: 10}, 5)
+	colony.BuildQueue = append(colony.BuildQueue, item)
+	empire.BuildQueue = append(empire.BuildQueue, item)
+
+	copied, err := world.Copy()
+	if err != nil {
+		t.Fatalf("Failed to copy world: %v", err)
+	}
+
+	copiedEmpire := copied.Empires[empire.PlayerID]
+	copiedFleet := copiedEmpire.TotalFleets[fleet.ID]
+	if copiedFleet == nil || copiedFleet == fleet || copiedFleet.Ships["fighter"] != 2 {
+		t.Fatalf("Expected a copy of the fleet, got %+v", copiedFleet)
+	}
+	if copied.Galaxy.Systems[systems[0].ID].Fleets[fleet.ID] != copiedFleet {
+		t.Error("Expected the system and the empire to share the copied fleet")
+	}
+	if copied.Colonies[colony.ID].BuildQueue[0] != copiedEmpire.BuildQueue[0] {
+		t.Error("Expected the colony and the empire to share the copied build item")
+	}
+
+	if ref, ok := copied.IDs.Ref(fleet.ID); !ok || ref != fleet.Ref {
+		t.Errorf("Expected the fleet to keep reference %d, got %d", fleet.Ref, ref)
+	}
+	if next := copied.IDs.Register(uuid.New()); next != world.IDs.Register(uuid.New()) {
+		t.Errorf("Expected new references to continue where the original left off, got %d", next)
+	}
+
+	copiedFleet.Ships["fighter"] = 5
+	if fleet.Ships["fighter"] != 2 {
+		t.Error("Expected changes to the copy to leave the original unchanged")
+	}
+}
+
+func TestChecksumWithFleetsInSight(t *testing.T) {
+	systemIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	watcherID, otherID := uuid.New(), uuid.New()
+	watcherEmpireID, otherEmpireID := uuid.New(), uuid.New()
+	fleetIDs := make([]uuid.UUID, 5)
+	for i := range fleetIDs {
+		fleetIDs[i] = uuid.New()
+	}
+
+	// The same world is built again and again, its maps are visited in a
+	// different order every time
+	checksum := func() string {
+		world := types.NewWorldState()
+		world.IDs.Seed(1)
+		systems := make([]*types.StarSystemState, len(systemIDs))
+		for i, id := range systemIDs {
+			systems[i] = &types.StarSystemState{ID: id, Fleets: make(map[uuid.UUID]*types.Fleet)}
+			world.Galaxy.AddSystem(systems[i])
+		}
+
+		watcher := types.NewEmpireState(watcherID, "Watcher")
+		other := types.NewEmpireState(otherID, "Other")
+		watcher.ID, other.ID = watcherEmpireID, otherEmpireID
+		world.Empires[watcher.PlayerID] = watcher
+		world.Empires[other.PlayerID] = other
+
+		for _, id := range fleetIDs {
+			fleet := &types.Fleet{ID: id, Owner: other.ID, Ships: map[string]int{"fighter": 1}, Location: systems[1].ID}
+			other.TotalFleets[fleet.ID] = fleet
+			systems[1].AddFleet(fleet)
+		}
+		world.UpdateVision(watcher, map[uuid.UUID]bool{systems[0].ID: true, systems[1].ID: true}, 100)
+
+		sum, err := world.Checksum()
+		if err != nil {
+			t.Fatalf("Failed to compute checksum: %v", err)
+		}
+		return sum
+	}
+
+	expected := checksum()
+	for i := 0; i < 20; i++ {
+		if sum := checksum(); sum != expected {
+			t.Fatalf("Expected the same checksum for the same world, got %s and %s", expected, sum)
+		}
+	}
+}
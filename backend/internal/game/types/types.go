@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math"
 	"time"
 
 	"github.com/google/uuid"
@@ -41,45 +42,241 @@ type GameTickEvent struct {
 	BaseEvent
 	Tick      int           `json:"tick"`
 	DeltaTime time.Duration `json:"delta_time"` // in milliseconds
+	Days      float64       `json:"days"`       // in-game days since the previous tick
+	Now       float64       `json:"now"`        // in-game day at the end of the tick
+}
+
+// Crossed reports whether the tick reached the next multiple of an interval
+// in in-game days. Systems use it to run on an in-game schedule.
+func (e *GameTickEvent) Crossed(interval float64) bool {
+	return math.Floor(e.Now/interval) > math.Floor((e.Now-e.Days)/interval)
 }
 
 type GameStartedEvent struct {
 	BaseEvent
 }
 
+// ClockChangedEvent is published when the game is paused, resumed or its
+// speed changes. Reason tells who paused the game.
+type ClockChangedEvent struct {
+	BaseEvent
+	Paused       bool    `json:"paused"`
+	Reason       string  `json:"reason"`
+	YearsPerHour float64 `json:"years_per_hour"`
+	Now          float64 `json:"now"`
+}
+
+// ResyncRequestedEvent asks for a player's client to be brought up to date,
+// after they (re)connected or when their client lost track of the update
+// stream. LastSequence is the last message the client applied, 0 if it has
+// no state yet.
+type ResyncRequestedEvent struct {
+	BaseEvent
+	PlayerID     uuid.UUID `json:"player_id"`
+	LastSequence uint64    `json:"last_sequence"`
+}
+
 type PlayerJoinedEvent struct {
 	BaseEvent
 	Player *Player `json:"player"`
 }
 
-// Command Events (converted from commands)
+// Command Events (translated from protobuf game commands, IDs are resolved
+// and ownership is checked before they are published)
 type FleetMoveCommandEvent struct {
 	BaseEvent
-	PlayerID uuid.UUID              `json:"player_id"`
-	Data     map[string]interface{} `json:"data"`
+	PlayerID       uuid.UUID `json:"player_id"`
+	FleetID        uuid.UUID `json:"fleet_id"`
+	TargetSystemID uuid.UUID `json:"target_system_id"`
 }
 
 type BuildShipCommandEvent struct {
 	BaseEvent
-	PlayerID uuid.UUID              `json:"player_id"`
-	Data     map[string]interface{} `json:"data"`
+	PlayerID uuid.UUID `json:"player_id"`
+	ColonyID uuid.UUID `json:"colony_id"`
+	ShipType string    `json:"ship_type"`
+	Quantity int       `json:"quantity"`
+}
+
+type BuildBuildingCommandEvent struct {
+	BaseEvent
+	PlayerID     uuid.UUID `json:"player_id"`
+	ColonyID     uuid.UUID `json:"colony_id"`
+	BuildingType string    `json:"building_type"`
+	Quantity     int       `json:"quantity"`
+}
+
+type ReorderBuildQueueCommandEvent struct {
+	BaseEvent
+	PlayerID    uuid.UUID `json:"player_id"`
+	ItemID      uuid.UUID `json:"item_id"`
+	Position    int       `json:"position"`
+	EmpireQueue bool      `json:"empire_queue"`
+}
+
+type CancelBuildCommandEvent struct {
+	BaseEvent
+	PlayerID uuid.UUID `json:"player_id"`
+	ItemID   uuid.UUID `json:"item_id"`
+}
+
+type ColonizeCommandEvent struct {
+	BaseEvent
+	PlayerID uuid.UUID `json:"player_id"`
+	FleetID  uuid.UUID `json:"fleet_id"`
+	PlanetID uuid.UUID `json:"planet_id"`
+}
+
+type ResearchCommandEvent struct {
+	BaseEvent
+	PlayerID   uuid.UUID `json:"player_id"`
+	Technology string    `json:"technology"`
+	Remove     bool      `json:"remove"`
 }
 
 // System-generated Events
 type FleetMovedEvent struct {
 	BaseEvent
 	FleetID     uuid.UUID `json:"fleet_id"`
+	Owner       uuid.UUID `json:"owner"`
 	FromSystem  uuid.UUID `json:"from_system"`
 	ToSystem    uuid.UUID `json:"to_system"`
-	ArrivalTime int64     `json:"arrival_time"`
+	ArrivalTime float64   `json:"arrival_time"` // in-game day
 }
 
-type ShipBuiltEvent struct {
+// CommandRejectedEvent is published when a system refuses a player command
+type CommandRejectedEvent struct {
 	BaseEvent
-	PlayerID uuid.UUID `json:"player_id"`
+	PlayerID  uuid.UUID `json:"player_id"`
+	ErrorCode string    `json:"error_code"`
+	Message   string    `json:"message"`
+	Context   string    `json:"context,omitempty"` // command that was rejected
+}
+
+type FleetArrivedEvent struct {
+	BaseEvent
+	FleetID  uuid.UUID `json:"fleet_id"`
 	SystemID uuid.UUID `json:"system_id"`
-	ShipType string    `json:"ship_type"`
-	ShipID   uuid.UUID `json:"ship_id"`
+	Owner    uuid.UUID `json:"owner"`
+}
+
+// Battle Events
+type BattleStartedEvent struct {
+	BaseEvent
+	BattleID     uuid.UUID   `json:"battle_id"`
+	SystemID     uuid.UUID   `json:"system_id"`
+	Participants []uuid.UUID `json:"participants"` // empire IDs
+}
+
+type BattleRoundEvent struct {
+	BaseEvent
+	BattleID     uuid.UUID                    `json:"battle_id"`
+	SystemID     uuid.UUID                    `json:"system_id"`
+	Round        int                          `json:"round"`
+	Participants []uuid.UUID                  `json:"participants"` // empire IDs
+	Losses       map[uuid.UUID]map[string]int `json:"losses"`       // fleet ID -> ship type -> ships lost
+	Destroyed    []uuid.UUID                  `json:"destroyed"`    // fleets destroyed this round
+}
+
+type BattleEndedEvent struct {
+	BaseEvent
+	BattleID     uuid.UUID   `json:"battle_id"`
+	SystemID     uuid.UUID   `json:"system_id"`
+	Rounds       int         `json:"rounds"`
+	Participants []uuid.UUID `json:"participants"`     // empire IDs
+	Winner       *uuid.UUID  `json:"winner,omitempty"` // nil if every side was destroyed
+}
+
+type ShipBuiltEvent struct {
+	BaseEvent
+	PlayerID  uuid.UUID `json:"player_id"`
+	SystemID  uuid.UUID `json:"system_id"`
+	ColonyID  uuid.UUID `json:"colony_id"`
+	FleetID   uuid.UUID `json:"fleet_id"` // fleet the ship was added to
+	ShipType  string    `json:"ship_type"`
+	ShipID    uuid.UUID `json:"ship_id"`
+	Remaining int       `json:"remaining"` // units of the build item still to be built
+}
+
+type BuildingBuiltEvent struct {
+	BaseEvent
+	PlayerID     uuid.UUID `json:"player_id"`
+	SystemID     uuid.UUID `json:"system_id"`
+	ColonyID     uuid.UUID `json:"colony_id"`
+	PlanetID     uuid.UUID `json:"planet_id"`
+	BuildingType string    `json:"building_type"`
+	Level        int       `json:"level"`
+	Remaining    int       `json:"remaining"` // units of the build item still to be built
+}
+
+type ColonyFoundedEvent struct {
+	BaseEvent
+	PlayerID   uuid.UUID `json:"player_id"`
+	EmpireID   uuid.UUID `json:"empire_id"`
+	ColonyID   uuid.UUID `json:"colony_id"`
+	ColonyRef  uint64    `json:"colony_ref"`
+	SystemID   uuid.UUID `json:"system_id"`
+	PlanetID   uuid.UUID `json:"planet_id"`
+	Population int64     `json:"population"`
+}
+
+// ColonyUpdatedEvent is published when the population of a colony changes
+type ColonyUpdatedEvent struct {
+	BaseEvent
+	PlayerID      uuid.UUID `json:"player_id"`
+	ColonyID      uuid.UUID `json:"colony_id"`
+	Population    int64     `json:"population"`
+	MaxPopulation int64     `json:"max_population"`
+}
+
+type SystemOwnerChangedEvent struct {
+	BaseEvent
+	SystemID      uuid.UUID  `json:"system_id"`
+	PreviousOwner *uuid.UUID `json:"previous_owner,omitempty"` // empire ID
+	Owner         *uuid.UUID `json:"owner,omitempty"`          // empire ID, nil if the system was abandoned
+}
+
+type TechResearchedEvent struct {
+	BaseEvent
+	PlayerID   uuid.UUID `json:"player_id"`
+	EmpireID   uuid.UUID `json:"empire_id"`
+	Technology string    `json:"technology"`
+	Name       string    `json:"name"`
+}
+
+// ResearchUpdatedEvent carries a copy of an empire's research queue and progress
+type ResearchUpdatedEvent struct {
+	BaseEvent
+	PlayerID     uuid.UUID                  `json:"player_id"`
+	Queue        []string                   `json:"queue"`
+	Technologies map[string]TechnologyLevel `json:"technologies"`
+}
+
+// VisionChangedEvent is published when systems come into or go out of an
+// empire's vision. Revealed carries the current contents of the newly visible
+// systems; the empire keeps its last intel on the hidden ones.
+type VisionChangedEvent struct {
+	BaseEvent
+	PlayerID uuid.UUID     `json:"player_id"`
+	EmpireID uuid.UUID     `json:"empire_id"`
+	Revealed []SystemIntel `json:"revealed"`
+	Hidden   []uuid.UUID   `json:"hidden"`
+}
+
+// EmpireIncomeEvent is published after every economy update
+type EmpireIncomeEvent struct {
+	BaseEvent
+	PlayerID  uuid.UUID       `json:"player_id"`
+	EmpireID  uuid.UUID       `json:"empire_id"`
+	Income    IncomeBreakdown `json:"income"`
+	Resources ResourceState   `json:"resources"` // stockpile after the income was applied
+}
+
+// BuildQueueUpdatedEvent carries a copy of an empire's build queue after it changed
+type BuildQueueUpdatedEvent struct {
+	BaseEvent
+	PlayerID uuid.UUID   `json:"player_id"`
+	Queue    []BuildItem `json:"queue"`
 }
 
 // Client Update Events
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// SystemSensorRange is the number of hyperlane hops an empire sees around the
+// systems it owns
+const SystemSensorRange = 1
+
+// SystemIntel is what an empire knows about the contents of a star system. For
+// systems in vision it is refreshed on every vision update, for systems out of
+// sight it is what the empire saw last.
+type SystemIntel struct {
+	SystemID  uuid.UUID           `json:"system_id"`
+	Ref       uint64              `json:"ref"`
+	Owner     *uuid.UUID          `json:"owner,omitempty"`
+	Fleets    []FleetIntel        `json:"fleets"`
+	Planets   map[uuid.UUID]int64 `json:"planets"` // population per planet
+	Buildings []BuildingState     `json:"buildings"`
+	LastSeen  int64               `json:"last_seen"` // unix seconds
+}
+
+// FleetIntel is a fleet as seen by another empire
+type FleetIntel struct {
+	ID    uuid.UUID      `json:"id"`
+	Ref   uint64         `json:"ref"`
+	Owner uuid.UUID      `json:"owner"`
+	Ships map[string]int `json:"ships"`
+}
+
+// NewSystemIntel records the current contents of a system
+func NewSystemIntel(system *StarSystemState, seenAt int64) *SystemIntel {
+	intel := &SystemIntel{
+		SystemID:  system.ID,
+		Ref:       system.Ref,
+		Fleets:    make([]FleetIntel, 0, len(system.Fleets)),
+		Planets:   make(map[uuid.UUID]int64, len(system.Planets)),
+		Buildings: append([]BuildingState(nil), system.Buildings...),
+		LastSeen:  seenAt,
+	}
+
+	if system.Owner != nil {
+		owner := *system.Owner
+		intel.Owner = &owner
+	}
+
+	for _, fleet := range system.Fleets {
+		ships := make(map[string]int, len(fleet.Ships))
+		for shipType, count := range fleet.Ships {
+			ships[shipType] = count
+		}
+		intel.Fleets = append(intel.Fleets, FleetIntel{
+			ID:    fleet.ID,
+			Ref:   fleet.Ref,
+			Owner: fleet.Owner,
+			Ships: ships,
+		})
+	}
+	// Sorted so that the intel, and with it the world checksum, is the same
+	// whatever order the fleets of the system are visited in
+	sort.Slice(intel.Fleets, func(i, j int) bool {
+		return intel.Fleets[i].ID.String() < intel.Fleets[j].ID.String()
+	})
+
+	for _, planet := range system.Planets {
+		if planet.Population > 0 {
+			intel.Planets[planet.ID] = planet.Population
+		}
+	}
+
+	return intel
+}
+
+// CanSee reports whether a system is in the empire's vision. Must be called
+// with the world lock held.
+func (e *EmpireState) CanSee(systemID uuid.UUID) bool {
+	return e.Visible[systemID]
+}
+
+// UpdateVision replaces the set of systems an empire sees and refreshes its
+// intel on all of them. Intel on systems that fell out of sight is kept.
+// Returns the systems that came into and went out of vision. Must be called
+// with the world lock held.
+func (w *WorldState) UpdateVision(empire *EmpireState, visible map[uuid.UUID]bool, now int64) (revealed, hidden []uuid.UUID) {
+	for systemID := range visible {
+		system, exists := w.Galaxy.GetSystem(systemID)
+		if !exists {
+			continue
+		}
+		if !empire.Visible[systemID] {
+			revealed = append(revealed, systemID)
+		}
+		empire.Intel[systemID] = NewSystemIntel(system, now)
+	}
+
+	for systemID := range empire.Visible {
+		if !visible[systemID] {
+			hidden = append(hidden, systemID)
+		}
+	}
+
+	empire.Visible = visible
+	return revealed, hidden
+}
+
+// Observers returns the players whose empires see at least one of the given
+// systems. Must be called with the world lock held.
+func (w *WorldState) Observers(systemIDs ...uuid.UUID) []uuid.UUID {
+	players := make([]uuid.UUID, 0, len(w.Empires))
+	for playerID, empire := range w.Empires {
+		for _, systemID := range systemIDs {
+			if empire.CanSee(systemID) {
+				players = append(players, playerID)
+				break
+			}
+		}
+	}
+	return players
+}
+
+// SystemsWithin returns every system at most hops hyperlane jumps away from the
+// origin, including the origin itself
+func (g *GalaxyState) SystemsWithin(origin uuid.UUID, hops int) []uuid.UUID {
+	if _, exists := g.GetSystem(origin); !exists {
+		return nil
+	}
+
+	seen := map[uuid.UUID]bool{origin: true}
+	result := []uuid.UUID{origin}
+	frontier := []uuid.UUID{origin}
+	for hop := 0; hop < hops && len(frontier) > 0; hop++ {
+		var next []uuid.UUID
+		for _, id := range frontier {
+			system, exists := g.GetSystem(id)
+			if !exists {
+				continue
+			}
+			for _, neighbour := range system.Hyperlanes {
+				if !seen[neighbour] {
+					seen[neighbour] = true
+					result = append(result, neighbour)
+					next = append(next, neighbour)
+				}
+			}
+		}
+		frontier = next
+	}
+	return result
+}
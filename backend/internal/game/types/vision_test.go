@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/game/types"
+)
+
+// chain adds systems connected in a line by hyperlanes
+func chain(world *types.WorldState, length int) []*types.StarSystemState {
+	systems := make([]*types.StarSystemState, length)
+	for i := range systems {
+		systems[i] = &types.StarSystemState{ID: uuid.New(), Fleets: make(map[uuid.UUID]*types.Fleet)}
+		world.Galaxy.AddSystem(systems[i])
+	}
+	for i := 1; i < length; i++ {
+		systems[i-1].Hyperlanes = append(systems[i-1].Hyperlanes, systems[i].ID)
+		systems[i].Hyperlanes = append(systems[i].Hyperlanes, systems[i-1].ID)
+	}
+	return systems
+}
+
+func TestSystemsWithin(t *testing.T) {
+	world := types.NewWorldState()
+	systems := chain(world, 5)
+
+	if within := world.Galaxy.SystemsWithin(systems[2].ID, 0); len(within) != 1 {
+		t.Errorf("Expected only the origin at 0 hops, got %d systems", len(within))
+	}
+	if within := world.Galaxy.SystemsWithin(systems[2].ID, 1); len(within) != 3 {
+		t.Errorf("Expected 3 systems at 1 hop, got %d", len(within))
+	}
+	if within := world.Galaxy.SystemsWithin(systems[0].ID, 10); len(within) != 5 {
+		t.Errorf("Expected every system at 10 hops, got %d", len(within))
+	}
+	if within := world.Galaxy.SystemsWithin(uuid.New(), 1); within != nil {
+		t.Errorf("Expected no systems around an unknown origin, got %v", within)
+	}
+}
+
+func TestUpdateVisionKeepsIntel(t *testing.T) {
+	world := types.NewWorldState()
+	systems := chain(world, 3)
+
+	watcher := types.NewEmpireState(uuid.New(), "Watcher")
+	other := types.NewEmpireState(uuid.New(), "Other")
+	world.Empires[watcher.PlayerID] = watcher
+	world.Empires[other.PlayerID] = other
+
+	fleet := &types.Fleet{ID: uuid.New(), Owner: other.ID, Ships: map[string]int{"fighter": 3}, Location: systems[1].ID}
+	systems[1].AddFleet(fleet)
+
+	revealed, hidden := world.UpdateVision(watcher, map[uuid.UUID]bool{systems[0].ID: true, systems[1].ID: true}, 100)
+	if len(revealed) != 2 || len(hidden) != 0 {
+		t.Fatalf("Expected 2 revealed systems, got %d revealed and %d hidden", len(revealed), len(hidden))
+	}
+	if intel := watcher.Intel[systems[1].ID]; intel == nil || len(intel.Fleets) != 1 || intel.Fleets[0].Ships["fighter"] != 3 {
+		t.Fatalf("Expected intel on the fleet, got %+v", intel)
+	}
+
+	if observers := world.Observers(systems[1].ID); len(observers) != 1 || observers[0] != watcher.PlayerID {
+		t.Errorf("Expected only the watcher to observe the system, got %v", observers)
+	}
+	if observers := world.Observers(systems[2].ID); len(observers) != 0 {
+		t.Errorf("Expected nobody to observe the unseen system, got %v", observers)
+	}
+
+	// The fleet leaves after the system went out of sight
+	revealed, hidden = world.UpdateVision(watcher, map[uuid.UUID]bool{systems[0].ID: true}, 200)
+	systems[1].RemoveFleet(fleet.ID)
+	if len(revealed) != 0 || len(hidden) != 1 || hidden[0] != systems[1].ID {
+		t.Fatalf("Expected system to be hidden, got %v revealed and %v hidden", revealed, hidden)
+	}
+	if watcher.CanSee(systems[1].ID) {
+		t.Error("Expected hidden system to be out of vision")
+	}
+
+	intel := watcher.Intel[systems[1].ID]
+	if intel == nil || intel.LastSeen != 100 || len(intel.Fleets) != 1 {
+		t.Errorf("Expected last seen intel to be kept, got %+v", intel)
+	}
+	if watcher.Intel[systems[0].ID].LastSeen != 200 {
+		t.Error("Expected intel on visible systems to be refreshed")
+	}
+}
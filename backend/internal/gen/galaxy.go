@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"sort"
 
 	"github.com/google/uuid"
 	"github.com/gr4vediggr/stellarlight/internal/domain/galaxy"
@@ -13,15 +14,61 @@ import (
 type GalaxyShape string
 
 const (
-	SpiralGalaxy GalaxyShape = "spiral"
+	SpiralGalaxy       GalaxyShape = "spiral"
+	EllipticalGalaxy   GalaxyShape = "elliptical"
+	RingGalaxy         GalaxyShape = "ring"
+	BarredSpiralGalaxy GalaxyShape = "barred_spiral"
+	ClusteredGalaxy    GalaxyShape = "clustered"
+	IrregularGalaxy    GalaxyShape = "irregular"
 )
 
+// GalaxyShapes lists all shapes supported by GenerateGalaxy
+var GalaxyShapes = []GalaxyShape{
+	SpiralGalaxy,
+	EllipticalGalaxy,
+	RingGalaxy,
+	BarredSpiralGalaxy,
+	ClusteredGalaxy,
+	IrregularGalaxy,
+}
+
+// IsValid reports whether the shape is supported by GenerateGalaxy
+func (s GalaxyShape) IsValid() bool {
+	for _, shape := range GalaxyShapes {
+		if s == shape {
+			return true
+		}
+	}
+	return false
+}
+
 type GalaxyGenerationConfig struct {
 	NumStarSystems         int         `json:"numStarSystems"`         // Number of star systems to generate
 	Shape                  GalaxyShape `json:"shape"`                  // Shape of the galaxy (e.g., spiral, elliptical)
+	Params                 ShapeParams `json:"params"`                 // Parameters of the shapes, unset shapes use the defaults
 	HyperlaneDensity       float64     `json:"hyperlaneDensity"`       // Density of hyperlanes in the galaxy, 0.0 to 1.0
 	MaxHyperlanesPerSystem int         `json:"maxHyperlanesPerSystem"` // Maximum number of hyperlanes per star system
+	Seed                   int64       `json:"seed"`                   // Seed for the random generator, 0 picks a random seed
+}
 
+// NewSeed returns a random non-zero seed for a galaxy
+func NewSeed() int64 {
+	for {
+		if seed := rand.Int63(); seed != 0 {
+			return seed
+		}
+	}
+}
+
+// DefaultGalaxyGenerationConfig returns the settings used for new lobbies
+func DefaultGalaxyGenerationConfig() GalaxyGenerationConfig {
+	return GalaxyGenerationConfig{
+		NumStarSystems:         200,
+		Shape:                  SpiralGalaxy,
+		Params:                 DefaultShapeParams(),
+		HyperlaneDensity:       0.5,
+		MaxHyperlanesPerSystem: 5,
+	}
 }
 
 type GalaxyBuilder struct {
@@ -29,27 +76,45 @@ type GalaxyBuilder struct {
 	PlanetTypes []*galaxy.PlanetType // List of planet types to use for generation
 }
 
+// NewGalaxyBuilder creates a builder from loaded asset maps. Types are sorted by ID
+// so that the builder does not depend on map iteration order.
+func NewGalaxyBuilder(starTypes map[uint32]*galaxy.StarType, planetTypes map[uint32]*galaxy.PlanetType) GalaxyBuilder {
+	b := GalaxyBuilder{
+		StarTypes:   make([]*galaxy.StarType, 0, len(starTypes)),
+		PlanetTypes: make([]*galaxy.PlanetType, 0, len(planetTypes)),
+	}
+	for _, st := range starTypes {
+		b.StarTypes = append(b.StarTypes, st)
+	}
+	for _, pt := range planetTypes {
+		b.PlanetTypes = append(b.PlanetTypes, pt)
+	}
+	sort.Slice(b.StarTypes, func(i, j int) bool { return b.StarTypes[i].ID < b.StarTypes[j].ID })
+	sort.Slice(b.PlanetTypes, func(i, j int) bool { return b.PlanetTypes[i].ID < b.PlanetTypes[j].ID })
+	return b
+}
+
+// GenerateGalaxy generates a galaxy from the config. Generating twice with the
+// same non-zero seed gives an identical galaxy, including the IDs.
 func (b GalaxyBuilder) GenerateGalaxy(config GalaxyGenerationConfig) (*galaxy.Galaxy, error) {
+	seed := config.Seed
+	if seed == 0 {
+		seed = NewSeed()
+	}
+	rng := rand.New(rand.NewSource(seed))
+
 	g := galaxy.NewGalaxy("Generated Galaxy")
+	g.ID = newID(rng)
 
 	// Creating shape and connections
-	var t *Triangulation
-	var err error
-	var genFunc func() []Point
-	switch config.Shape {
-	case "spiral":
-		minDistance := 1 / math.Sqrt(float64(config.NumStarSystems))
-		genFunc = func() []Point {
-			return GenerateSpiralGalaxyPointsWithInterarm(config.NumStarSystems, 2, 0.5, 0.2, 2, 0.01, minDistance)
-		}
-	default:
-		return nil, fmt.Errorf("unknown galaxy shape: %s", config.Shape)
-
+	genFunc, err := shapeGenerator(rng, config)
+	if err != nil {
+		return nil, err
 	}
 
 	maxDistance := 4. / math.Sqrt(float64(config.NumStarSystems))
 
-	t, err = GenerateValidGalaxy(BaseGenerationConfig{
+	t, err := GenerateValidGalaxy(rng, BaseGenerationConfig{
 		NumPoints:    config.NumStarSystems,
 		MaxDistance:  maxDistance,
 		MaxDegree:    config.MaxHyperlanesPerSystem,
@@ -68,7 +133,7 @@ func (b GalaxyBuilder) GenerateGalaxy(config GalaxyGenerationConfig) (*galaxy.Ga
 		// Randomly select a point from the triangulation
 		location := t.Points[i]
 
-		system := b.GenerateStarSystem(location.X*scale, location.Y*scale)
+		system := b.GenerateStarSystem(rng, location.X*scale, location.Y*scale)
 		g.AddStarSystem(&system)
 		ids = append(ids, system.ID)
 	}
@@ -85,8 +150,37 @@ func (b GalaxyBuilder) GenerateGalaxy(config GalaxyGenerationConfig) (*galaxy.Ga
 	return g, err
 }
 
-func (b GalaxyBuilder) GenerateStarSystem(locationX, locationY float64) galaxy.StarSystem {
-	id := uuid.New()
+// shapeGenerator returns the point generator for the shape in the config. All
+// shapes fit in a circle of about unit radius and keep the same minimum
+// distance between stars, so that they share the triangulation settings.
+func shapeGenerator(rng *rand.Rand, config GalaxyGenerationConfig) (func() []Point, error) {
+	n := config.NumStarSystems
+	minDistance := 1 / math.Sqrt(float64(n))
+	params := config.Params.withDefaults()
+
+	switch config.Shape {
+	case SpiralGalaxy:
+		p := params.Spiral
+		return func() []Point {
+			return GenerateSpiralGalaxyPointsWithInterarm(rng, n, p.NumArms, p.ArmSpread, p.MinRadius, p.Twist, minDistance, p.InterarmChance)
+		}, nil
+	case EllipticalGalaxy:
+		return func() []Point { return GenerateEllipticalGalaxyPoints(rng, n, params.Elliptical, minDistance) }, nil
+	case RingGalaxy:
+		return func() []Point { return GenerateRingGalaxyPoints(rng, n, params.Ring, minDistance) }, nil
+	case BarredSpiralGalaxy:
+		return func() []Point { return GenerateBarredSpiralGalaxyPoints(rng, n, params.BarredSpiral, minDistance) }, nil
+	case ClusteredGalaxy:
+		return func() []Point { return GenerateClusteredGalaxyPoints(rng, n, params.Clustered, minDistance) }, nil
+	case IrregularGalaxy:
+		return func() []Point { return GenerateIrregularGalaxyPoints(rng, n, params.Irregular, minDistance) }, nil
+	default:
+		return nil, fmt.Errorf("unknown galaxy shape: %s", config.Shape)
+	}
+}
+
+func (b GalaxyBuilder) GenerateStarSystem(rng *rand.Rand, locationX, locationY float64) galaxy.StarSystem {
+	id := newID(rng)
 	// Create a new star system with a unique ID and default properties
 	starSystem := galaxy.StarSystem{
 		ID:        id,
@@ -97,51 +191,55 @@ func (b GalaxyBuilder) GenerateStarSystem(locationX, locationY float64) galaxy.S
 	}
 
 	// Generate a random star for the system
-	selectedType := utils.WeightedRandomChoice(b.StarTypes)
-	star := b.GenerateStar(locationX, locationY, *selectedType)
+	selectedType := utils.WeightedRandomChoice(rng, b.StarTypes)
+	star := b.GenerateStar(rng, locationX, locationY, *selectedType)
 	starSystem.Stars = append(starSystem.Stars, star)
 	return starSystem
 }
 
-func (b GalaxyBuilder) GenerateStar(locationX, locationY float64, starType galaxy.StarType) galaxy.Star {
-	id := uuid.New()
+func (b GalaxyBuilder) GenerateStar(rng *rand.Rand, locationX, locationY float64, starType galaxy.StarType) galaxy.Star {
+	id := newID(rng)
 	s := galaxy.Star{
 		ID:        id,
 		Name:      "Star " + id.String(),
 		Type:      starType,
 		LocationX: locationX,
 		LocationY: locationY,
-		Size:      utils.RandomFloat(starType.MinSize, starType.MaxSize),
+		Size:      utils.RandomFloat(rng, starType.MinSize, starType.MaxSize),
 		Planets:   []galaxy.Planet{},
 	}
 
 	// Generate planets for the star
 	for i := 0; i < starType.MaxPlanets; i++ {
 
-		if i >= starType.MinPlanets && rand.Float64() > starType.PlanetChance {
+		if i >= starType.MinPlanets && rng.Float64() > starType.PlanetChance {
 			// If min planets is reached and chance condition fails, skip planet generation
 			break
 		}
 
-		planetType := utils.WeightedRandomChoice(b.PlanetTypes)
+		planetType := utils.WeightedRandomChoice(rng, b.PlanetTypes)
 
-		planet := GeneratePlanet(s, i+1, *planetType)
+		planet := GeneratePlanet(rng, s, i+1, *planetType)
 		s.Planets = append(s.Planets, planet)
 	}
 
 	return s
 }
 
-func GeneratePlanet(star galaxy.Star, planetNumber int, planetType galaxy.PlanetType) galaxy.Planet {
-	id := uuid.New()
+func GeneratePlanet(rng *rand.Rand, star galaxy.Star, planetNumber int, planetType galaxy.PlanetType) galaxy.Planet {
+	id := newID(rng)
 
 	orbitRadius := star.Size + float64(planetNumber+1)*star.Size
-	angle := rand.Float64() * 360 // Random angle in degrees
+	angle := rng.Float64() * 360 // Random angle in degrees
+
+	// Planet type sizes are relative to earth, planet sizes are stored in tenths
+	size := int(math.Round(utils.RandomFloat(rng, planetType.MinSize, planetType.MaxSize) * 10))
 
 	return galaxy.Planet{
 		ID:          id,
 		Name:        fmt.Sprintf("%s %d", star.Name, planetNumber),
 		Type:        planetType.Name,
+		Size:        size,
 		OrbitRadius: orbitRadius,
 		Angle:       angle,
 	}
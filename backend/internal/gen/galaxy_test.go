@@ -1,8 +1,11 @@
 package gen_test
 
 import (
+	"reflect"
 	"testing"
 
+	"github.com/google/uuid"
+
 	"github.com/gr4vediggr/stellarlight/internal/domain/galaxy"
 	"github.com/gr4vediggr/stellarlight/internal/gen"
 	"github.com/gr4vediggr/stellarlight/internal/render"
@@ -63,3 +66,88 @@ func TestGenerateGalaxy(t *testing.T) {
 	t.Error("Test completed, check generated_galaxy.png for visual output")
 
 }
+
+func TestGenerateGalaxyIsReproducible(t *testing.T) {
+	assets, err := resource.LoadAssetsFromDirs([]string{
+		"../../assets/",
+	})
+	if err != nil {
+		t.Fatalf("Failed to load assets: %v", err)
+	}
+	builder := gen.NewGalaxyBuilder(assets.StarTypes, assets.PlanetTypes)
+
+	config := gen.DefaultGalaxyGenerationConfig()
+	config.NumStarSystems = 100
+	config.Seed = 42
+
+	first, err := builder.GenerateGalaxy(config)
+	if err != nil {
+		t.Fatalf("Failed to generate galaxy: %v", err)
+	}
+	second, err := builder.GenerateGalaxy(config)
+	if err != nil {
+		t.Fatalf("Failed to generate galaxy: %v", err)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Error("Expected the same seed to generate an identical galaxy")
+	}
+
+	config.Seed = 43
+	other, err := builder.GenerateGalaxy(config)
+	if err != nil {
+		t.Fatalf("Failed to generate galaxy: %v", err)
+	}
+	if other.ID == first.ID || reflect.DeepEqual(first.StarSystems, other.StarSystems) {
+		t.Error("Expected a different seed to generate a different galaxy")
+	}
+}
+
+func TestGenerateGalaxyShapes(t *testing.T) {
+	assets, err := resource.LoadAssetsFromDirs([]string{
+		"../../assets/",
+	})
+	if err != nil {
+		t.Fatalf("Failed to load assets: %v", err)
+	}
+	builder := gen.NewGalaxyBuilder(assets.StarTypes, assets.PlanetTypes)
+
+	for _, shape := range gen.GalaxyShapes {
+		for _, numStars := range []int{50, 300} {
+			config := gen.DefaultGalaxyGenerationConfig()
+			config.Shape = shape
+			config.NumStarSystems = numStars
+			config.Seed = 1
+
+			g, err := builder.GenerateGalaxy(config)
+			if err != nil {
+				t.Errorf("Failed to generate %s galaxy with %d stars: %v", shape, numStars, err)
+				continue
+			}
+			if len(g.StarSystems) != numStars {
+				t.Errorf("Expected %d systems in %s galaxy, got %d", numStars, shape, len(g.StarSystems))
+			}
+
+			// Every system must be reachable over hyperlanes
+			var start uuid.UUID
+			for id := range g.StarSystems {
+				start = id
+				break
+			}
+			reached := map[uuid.UUID]bool{start: true}
+			queue := []uuid.UUID{start}
+			for len(queue) > 0 {
+				system := g.StarSystems[queue[0]]
+				queue = queue[1:]
+				for _, next := range system.ConnectedSystems {
+					if !reached[next] {
+						reached[next] = true
+						queue = append(queue, next)
+					}
+				}
+			}
+			if len(reached) != numStars {
+				t.Errorf("Expected a connected %s galaxy, reached %d of %d systems", shape, len(reached), numStars)
+			}
+		}
+	}
+}
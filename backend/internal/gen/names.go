@@ -1,24 +1,38 @@
 package gen
 
-import "github.com/google/uuid"
+import (
+	"math/rand"
 
-func GenerateStarName() string {
+	"github.com/google/uuid"
+)
+
+// newID draws a UUID from the generator, so that IDs are part of the seed
+func newID(rng *rand.Rand) uuid.UUID {
+	id, err := uuid.NewRandomFromReader(rng)
+	if err != nil {
+		// Reading from a rand.Rand never fails
+		panic(err)
+	}
+	return id
+}
+
+func GenerateStarName(rng *rand.Rand) string {
 	// This function generates a random star name.
 	// For simplicity, we will return a static name here.
 	// In a real application, you might want to use a more complex algorithm or a library.
-	return "Star-" + uuid.New().String()[:8]
+	return "Star-" + newID(rng).String()[:8]
 }
 
-func GeneratePlanetName() string {
+func GeneratePlanetName(rng *rand.Rand) string {
 	// This function generates a random planet name.
 	// For simplicity, we will return a static name here.
 	// In a real application, you might want to use a more complex algorithm or a library.
-	return "Planet-" + uuid.New().String()[:8]
+	return "Planet-" + newID(rng).String()[:8]
 }
 
-func GenerateStarSystemName() string {
+func GenerateStarSystemName(rng *rand.Rand) string {
 	// This function generates a random star system name.
 	// For simplicity, we will return a static name here.
 	// In a real application, you might want to use a more complex algorithm or a library.
-	return "StarSystem-" + uuid.New().String()[:8]
+	return "StarSystem-" + newID(rng).String()[:8]
 }
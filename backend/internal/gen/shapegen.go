@@ -32,7 +32,7 @@ func GenerateSpiralPoints(numPoints int) []Point {
 	return points
 }
 
-func GenerateSpiralGalaxyPoints(numPoints, numArms int, armSpread, minRadius, twist, minDist float64) []Point {
+func GenerateSpiralGalaxyPoints(rng *rand.Rand, numPoints, numArms int, armSpread, minRadius, twist, minDist float64) []Point {
 	points := make([]Point, 0, numPoints)
 	attempts := 0
 	maxAttempts := numPoints * 200 // Prevent infinite loops
@@ -53,8 +53,8 @@ func GenerateSpiralGalaxyPoints(numPoints, numArms int, armSpread, minRadius, tw
 		radius := (minRadius + biasedFrac)
 		// More jitter near the center for a core effect
 		jitterFactor := (1.0 - biasedFrac) // high near center, low at edge
-		jitterAngle := angle + (rand.Float64()-0.5)*2*math.Pi/float64(numArms)*(1-frac)
-		jitterRadius := radius + (rand.Float64()-0.5)*armSpread*(jitterFactor+0.1)*radius
+		jitterAngle := angle + (rng.Float64()-0.5)*2*math.Pi/float64(numArms)*(1-frac)
+		jitterRadius := radius + (rng.Float64()-0.5)*armSpread*(jitterFactor+0.1)*radius
 		x := jitterRadius * math.Cos(jitterAngle)
 		y := jitterRadius * math.Sin(jitterAngle)
 		tooClose := false
@@ -74,12 +74,13 @@ func GenerateSpiralGalaxyPoints(numPoints, numArms int, armSpread, minRadius, tw
 }
 
 // GenerateSpiralGalaxyPointsPoisson generates spiral galaxy points using random sampling and a minimum distance constraint.
+// rng: source of randomness, the same seed gives the same points
 // numPoints: number of points to generate
 // numArms: number of spiral arms
 // armSpread: spread of the arms
 // minRadius: minimum radius for the core
 // minDist: minimum allowed distance between points
-func GenerateSpiralGalaxyPointsPoisson(numPoints, numArms int, armSpread, minRadius, minDist, twist float64) []Point {
+func GenerateSpiralGalaxyPointsPoisson(rng *rand.Rand, numPoints, numArms int, armSpread, minRadius, minDist, twist float64) []Point {
 	points := make([]Point, 0, numPoints)
 	attempts := 0
 	maxAttempts := numPoints * 20 // Prevent infinite loops
@@ -87,8 +88,8 @@ func GenerateSpiralGalaxyPointsPoisson(numPoints, numArms int, armSpread, minRad
 	for len(points) < numPoints && attempts < maxAttempts {
 		attempts++
 		// Sample r with bias towards center
-		r := minRadius + math.Pow(rand.Float64(), 1.5)*(1-minRadius)
-		theta := rand.Float64() * 2 * math.Pi
+		r := minRadius + math.Pow(rng.Float64(), 1.5)*(1-minRadius)
+		theta := rng.Float64() * 2 * math.Pi
 
 		// Nudge strength depends on r: weaker near core, stronger at edge
 		nudgeStrength := math.Min(1, (r-minRadius)/(1-minRadius)) // r ∈ [minRadius, 1], so nudge is weaker near core
@@ -123,6 +124,7 @@ func GenerateSpiralGalaxyPointsPoisson(numPoints, numArms int, armSpread, minRad
 }
 
 // GenerateSpiralGalaxyPointsWithInterarm generates spiral galaxy points with a small chance to place a point between arms.
+// rng: source of randomness, the same seed gives the same points
 // numPoints: number of points to generate
 // numArms: number of spiral arms
 // armSpread: spread of the arms
@@ -130,7 +132,7 @@ func GenerateSpiralGalaxyPointsPoisson(numPoints, numArms int, armSpread, minRad
 // twist: spiral twist
 // minDist: minimum allowed distance between points
 // interarmChance: probability to place a point between arms (e.g. 0.01 for 1%)
-func GenerateSpiralGalaxyPointsWithInterarm(numPoints, numArms int, armSpread, minRadius, twist, minDist, interarmChance float64) []Point {
+func GenerateSpiralGalaxyPointsWithInterarm(rng *rand.Rand, numPoints, numArms int, armSpread, minRadius, twist, minDist, interarmChance float64) []Point {
 	points := make([]Point, 0, numPoints)
 	attempts := 0
 	maxAttempts := numPoints * 200 // Prevent infinite loops
@@ -145,9 +147,9 @@ func GenerateSpiralGalaxyPointsWithInterarm(numPoints, numArms int, armSpread, m
 		biasedFrac := math.Pow(frac, 1.5)
 
 		// Decide if this point is interarm
-		if rand.Float64() < interarmChance && biasedFrac > 0.5 {
+		if rng.Float64() < interarmChance && biasedFrac > 0.5 {
 			// Pick a random angle between arms
-			arm = rand.Intn(numArms)
+			arm = rng.Intn(numArms)
 			armOffset := float64(arm) / float64(numArms) * 2 * math.Pi
 			// Offset by half an arm to be between arms
 			angle = biasedFrac*twist*math.Pi + armOffset + math.Pi/float64(numArms)
@@ -160,8 +162,8 @@ func GenerateSpiralGalaxyPointsWithInterarm(numPoints, numArms int, armSpread, m
 		}
 
 		jitterFactor := (1.0 - biasedFrac)
-		jitterAngle := angle + (rand.Float64()-0.5)*2*math.Pi/float64(numArms)*(jitterFactor)
-		jitterRadius := radius + (rand.Float64()-0.5)*armSpread*(jitterFactor+0.1)*radius
+		jitterAngle := angle + (rng.Float64()-0.5)*2*math.Pi/float64(numArms)*(jitterFactor)
+		jitterRadius := radius + (rng.Float64()-0.5)*armSpread*(jitterFactor+0.1)*radius
 		x := jitterRadius * math.Cos(jitterAngle)
 		y := jitterRadius * math.Sin(jitterAngle)
 
@@ -490,12 +492,17 @@ func (t *Triangulation) RemoveLongEdgesConnected(maxLength float64) []Edge {
 // ReduceHighDegreeEdges removes edges if either endpoint exceeds maxDegree, or probabilistically.
 // Edges are shuffled in-place and each edge is checked only once.
 // Edges are removed immediately if removal keeps the graph connected.
-func (t *Triangulation) ReduceHighDegreeEdges(maxDegree int, removeChance float64, nTries int) {
+func (t *Triangulation) ReduceHighDegreeEdges(rng *rand.Rand, maxDegree int, removeChance float64, nTries int) {
 	fmt.Println("Reducing high degree edges with maxDegree:", maxDegree, "removeChance:", removeChance, "nTries:", nTries)
 	if len(t.Edges) == 0 {
 		return
 	}
 
+	rng.Shuffle(len(t.Edges), func(i, j int) {
+		t.Edges[i], t.Edges[j] = t.Edges[j], t.Edges[i]
+	})
+	t.BuildAdjacencyList()
+
 	idx := 0
 	for idx < len(t.Edges) {
 
@@ -530,7 +537,9 @@ type BaseGenerationConfig struct {
 	RemoveChance float64 // Probability of removing an edge during reduction
 }
 
-func GenerateValidGalaxy(config BaseGenerationConfig, genFunc func() []Point) (*Triangulation, error) {
+// GenerateValidGalaxy generates points until they form a connected graph. The
+// same rng is used for the points and for pruning the edges.
+func GenerateValidGalaxy(rng *rand.Rand, config BaseGenerationConfig, genFunc func() []Point) (*Triangulation, error) {
 	attempts := 0
 	maxAttempts := 1000 // Limit attempts to prevent infinite loops
 	for attempts < maxAttempts {
@@ -552,7 +561,7 @@ func GenerateValidGalaxy(config BaseGenerationConfig, genFunc func() []Point) (*
 			continue // Regenerate if not connected
 		}
 
-		t.ReduceHighDegreeEdges(config.MaxDegree, config.RemoveChance, 10)
+		t.ReduceHighDegreeEdges(rng, config.MaxDegree, config.RemoveChance, 10)
 
 		return t, nil
 
@@ -2,13 +2,14 @@ package gen_test
 
 import (
 	"math"
+	"math/rand"
 	"testing"
 
 	"github.com/gr4vediggr/stellarlight/internal/gen"
 )
 
 func TestGenerateSpiralgenPoints(t *testing.T) {
-	points := gen.GenerateSpiralGalaxyPoints(1000, 2, 0.25, 0.06, 2, 0.01)
+	points := gen.GenerateSpiralGalaxyPoints(rand.New(rand.NewSource(1)), 1000, 2, 0.25, 0.06, 2, 0.01)
 	if len(points) != 2000 {
 		t.Errorf("Expected 2000 points, got %d", len(points))
 	}
@@ -33,14 +34,14 @@ func TestGenerateSpiralgenPoints(t *testing.T) {
 
 	tf.DrawEdges("spiral_gen_edges.png")
 
-	tf.ReduceHighDegreeEdges(5, 0.1, 20)
+	tf.ReduceHighDegreeEdges(rand.New(rand.NewSource(1)), 5, 0.1, 20)
 	tf.DrawEdges("spiral_gen_reduced_edges.png")
 	t.Error("Test completed, check spiral_gen.png for visual output")
 
 }
 
 func TestGenerateSpiralgenPointsInterarm(t *testing.T) {
-	points := gen.GenerateSpiralGalaxyPointsWithInterarm(2000, 2, 0.4, 0.06, 2, 0.01, 0.02)
+	points := gen.GenerateSpiralGalaxyPointsWithInterarm(rand.New(rand.NewSource(1)), 2000, 2, 0.4, 0.06, 2, 0.01, 0.02)
 	if len(points) != 2000 {
 		t.Errorf("Expected 2000 points, got %d", len(points))
 	}
@@ -65,7 +66,7 @@ func TestGenerateSpiralgenPointsInterarm(t *testing.T) {
 
 	tf.DrawEdges("spiral_gen_edges.png")
 
-	tf.ReduceHighDegreeEdges(5, 0.25, 20)
+	tf.ReduceHighDegreeEdges(rand.New(rand.NewSource(1)), 5, 0.25, 20)
 	tf.DrawEdges("spiral_gen_reduced_edges.png")
 	t.Error("Test completed, check spiral_gen.png for visual output")
 
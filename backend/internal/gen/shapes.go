@@ -0,0 +1,277 @@
+package gen
+
+import (
+	"math"
+	"math/rand"
+)
+
+// SpiralParams are the parameters of a spiral galaxy
+type SpiralParams struct {
+	NumArms        int     `json:"numArms"`        // Number of spiral arms
+	ArmSpread      float64 `json:"armSpread"`      // Spread of the arms
+	MinRadius      float64 `json:"minRadius"`      // Minimum radius for the core
+	Twist          float64 `json:"twist"`          // Number of half turns the arms make
+	InterarmChance float64 `json:"interarmChance"` // Probability to place a point between arms
+}
+
+// EllipticalParams are the parameters of an elliptical galaxy
+type EllipticalParams struct {
+	AxisRatio     float64 `json:"axisRatio"`     // Minor axis relative to the major axis, 0.0 to 1.0
+	Concentration float64 `json:"concentration"` // 1 spreads stars evenly, higher values pack them towards the core
+}
+
+// RingParams are the parameters of a ring galaxy
+type RingParams struct {
+	InnerRadius float64 `json:"innerRadius"` // Radius of the empty centre relative to the outer radius
+}
+
+// BarredSpiralParams are the parameters of a barred spiral galaxy. The arms
+// start at the ends of the bar.
+type BarredSpiralParams struct {
+	NumArms      int     `json:"numArms"`      // Number of spiral arms
+	BarLength    float64 `json:"barLength"`    // Half length of the bar relative to the galaxy radius
+	BarWidth     float64 `json:"barWidth"`     // Half width of the bar
+	BarFraction  float64 `json:"barFraction"`  // Fraction of the stars in the bar
+	ArmSpread    float64 `json:"armSpread"`    // Spread of the arms
+	Twist        float64 `json:"twist"`        // Number of half turns the arms make
+	DiskFraction float64 `json:"diskFraction"` // Fraction of the stars spread over the disk between the arms
+}
+
+// ClusteredParams are the parameters of a galaxy made of separate clusters
+// joined by thin bridges of stars
+type ClusteredParams struct {
+	NumClusters   int     `json:"numClusters"`   // Number of clusters
+	ClusterRadius float64 `json:"clusterRadius"` // Radius of a cluster relative to the galaxy radius
+	BridgeSpacing float64 `json:"bridgeSpacing"` // Distance between bridge stars in minimum distances
+}
+
+// IrregularParams are the parameters of an irregular galaxy
+type IrregularParams struct {
+	Irregularity float64 `json:"irregularity"` // 0 spreads stars evenly over a disk, 1 only places them in clumps
+	NumClumps    int     `json:"numClumps"`    // Number of denser regions
+	ClumpRadius  float64 `json:"clumpRadius"`  // Radius of a clump relative to the galaxy radius
+}
+
+// ShapeParams holds the tunable parameters of every shape. Only the
+// parameters of the selected shape are used.
+type ShapeParams struct {
+	Spiral       SpiralParams       `json:"spiral"`
+	Elliptical   EllipticalParams   `json:"elliptical"`
+	Ring         RingParams         `json:"ring"`
+	BarredSpiral BarredSpiralParams `json:"barredSpiral"`
+	Clustered    ClusteredParams    `json:"clustered"`
+	Irregular    IrregularParams    `json:"irregular"`
+}
+
+// DefaultShapeParams returns the parameters used when a shape has none set
+func DefaultShapeParams() ShapeParams {
+	return ShapeParams{
+		Spiral: SpiralParams{
+			NumArms:        2,
+			ArmSpread:      0.5,
+			MinRadius:      0.2,
+			Twist:          2,
+			InterarmChance: 0.01,
+		},
+		Elliptical: EllipticalParams{
+			AxisRatio:     0.6,
+			Concentration: 1.5,
+		},
+		Ring: RingParams{
+			InnerRadius: 0.6,
+		},
+		BarredSpiral: BarredSpiralParams{
+			NumArms:      2,
+			BarLength:    0.4,
+			BarWidth:     0.1,
+			BarFraction:  0.2,
+			ArmSpread:    0.4,
+			Twist:        1,
+			DiskFraction: 0.15,
+		},
+		Clustered: ClusteredParams{
+			NumClusters:   4,
+			ClusterRadius: 0.4,
+			BridgeSpacing: 2,
+		},
+		Irregular: IrregularParams{
+			Irregularity: 0.6,
+			NumClumps:    4,
+			ClumpRadius:  0.35,
+		},
+	}
+}
+
+// withDefaults replaces the parameters of shapes that have none set with the defaults
+func (p ShapeParams) withDefaults() ShapeParams {
+	defaults := DefaultShapeParams()
+	if p.Spiral == (SpiralParams{}) {
+		p.Spiral = defaults.Spiral
+	}
+	if p.Elliptical == (EllipticalParams{}) {
+		p.Elliptical = defaults.Elliptical
+	}
+	if p.Ring == (RingParams{}) {
+		p.Ring = defaults.Ring
+	}
+	if p.BarredSpiral == (BarredSpiralParams{}) {
+		p.BarredSpiral = defaults.BarredSpiral
+	}
+	if p.Clustered == (ClusteredParams{}) {
+		p.Clustered = defaults.Clustered
+	}
+	if p.Irregular == (IrregularParams{}) {
+		p.Irregular = defaults.Irregular
+	}
+	return p
+}
+
+// samplePoints keeps drawing points from sample until numPoints of them are at
+// least minDist apart, giving up after a fixed number of attempts.
+func samplePoints(points []Point, numPoints int, minDist float64, sample func() Point) []Point {
+	attempts := 0
+	maxAttempts := numPoints * 200 // Prevent infinite loops
+	for len(points) < numPoints && attempts < maxAttempts {
+		attempts++
+		p := sample()
+		if !tooClose(points, p, minDist) {
+			points = append(points, p)
+		}
+	}
+	return points
+}
+
+func tooClose(points []Point, p Point, minDist float64) bool {
+	for _, other := range points {
+		dx := p.X - other.X
+		dy := p.Y - other.Y
+		if dx*dx+dy*dy < minDist*minDist {
+			return true
+		}
+	}
+	return false
+}
+
+// pointInDisk returns a uniformly distributed point in a disk
+func pointInDisk(rng *rand.Rand, center Point, radius float64) Point {
+	r := radius * math.Sqrt(rng.Float64())
+	theta := rng.Float64() * 2 * math.Pi
+	return Point{X: center.X + r*math.Cos(theta), Y: center.Y + r*math.Sin(theta)}
+}
+
+// GenerateEllipticalGalaxyPoints generates points in an ellipse with a unit
+// major axis, denser towards the core.
+func GenerateEllipticalGalaxyPoints(rng *rand.Rand, numPoints int, params EllipticalParams, minDist float64) []Point {
+	return samplePoints(make([]Point, 0, numPoints), numPoints, minDist, func() Point {
+		r := math.Pow(rng.Float64(), params.Concentration/2)
+		theta := rng.Float64() * 2 * math.Pi
+		return Point{X: r * math.Cos(theta), Y: r * params.AxisRatio * math.Sin(theta)}
+	})
+}
+
+// GenerateRingGalaxyPoints generates points evenly spread over a ring with a
+// unit outer radius.
+func GenerateRingGalaxyPoints(rng *rand.Rand, numPoints int, params RingParams, minDist float64) []Point {
+	inner2 := params.InnerRadius * params.InnerRadius
+	return samplePoints(make([]Point, 0, numPoints), numPoints, minDist, func() Point {
+		r := math.Sqrt(inner2 + rng.Float64()*(1-inner2))
+		theta := rng.Float64() * 2 * math.Pi
+		return Point{X: r * math.Cos(theta), Y: r * math.Sin(theta)}
+	})
+}
+
+// GenerateBarredSpiralGalaxyPoints generates points along a central bar and
+// spiral arms that start at the ends of the bar. Some points are spread over
+// the disk so that the galaxy still fills up when the arms are crowded.
+func GenerateBarredSpiralGalaxyPoints(rng *rand.Rand, numPoints int, params BarredSpiralParams, minDist float64) []Point {
+	return samplePoints(make([]Point, 0, numPoints), numPoints, minDist, func() Point {
+		roll := rng.Float64()
+		switch {
+		case roll < params.BarFraction:
+			return Point{
+				X: (rng.Float64()*2 - 1) * params.BarLength,
+				Y: (rng.Float64()*2 - 1) * params.BarWidth,
+			}
+		case roll < params.BarFraction+params.DiskFraction:
+			return pointInDisk(rng, Point{}, 1)
+		}
+
+		arms := max(params.NumArms, 1)
+		armOffset := float64(rng.Intn(arms)) / float64(arms) * 2 * math.Pi
+		frac := rng.Float64()
+		angle := armOffset + frac*params.Twist*math.Pi
+		radius := params.BarLength + frac*(1-params.BarLength)
+
+		jitterAngle := angle + (rng.Float64()-0.5)*params.ArmSpread*(1-frac/2)
+		jitterRadius := radius + (rng.Float64()-0.5)*params.ArmSpread*radius
+		return Point{X: jitterRadius * math.Cos(jitterAngle), Y: jitterRadius * math.Sin(jitterAngle)}
+	})
+}
+
+// GenerateClusteredGalaxyPoints generates clusters of points around a circle
+// with a unit radius. Neighbouring clusters are joined by a single line of
+// points spaced a few minimum distances apart, except for the last and the
+// first, so that the clusters are only connected by sparse bridges.
+func GenerateClusteredGalaxyPoints(rng *rand.Rand, numPoints int, params ClusteredParams, minDist float64) []Point {
+	offset := rng.Float64() * 2 * math.Pi
+	centers := make([]Point, max(params.NumClusters, 1))
+	for i := range centers {
+		theta := offset + float64(i)/float64(len(centers))*2*math.Pi
+		centers[i] = Point{X: math.Cos(theta), Y: math.Sin(theta)}
+	}
+
+	points := make([]Point, 0, numPoints)
+	spacing := params.BridgeSpacing * minDist
+	for i := 1; i < len(centers) && len(points) < numPoints; i++ {
+		from, to := centers[i-1], centers[i]
+		dx, dy := to.X-from.X, to.Y-from.Y
+		length := math.Sqrt(dx*dx + dy*dy)
+		gap := length - 2*params.ClusterRadius
+		if gap <= 0 {
+			continue
+		}
+
+		steps := int(math.Ceil(gap / spacing))
+		for step := 0; step <= steps && len(points) < numPoints; step++ {
+			along := (params.ClusterRadius + float64(step)*gap/float64(steps)) / length
+			jitter := (rng.Float64() - 0.5) * minDist / 2
+			p := Point{X: from.X + dx*along - dy/length*jitter, Y: from.Y + dy*along + dx/length*jitter}
+			if !tooClose(points, p, minDist) {
+				points = append(points, p)
+			}
+		}
+	}
+
+	return samplePoints(points, numPoints, minDist, func() Point {
+		return pointInDisk(rng, centers[rng.Intn(len(centers))], params.ClusterRadius)
+	})
+}
+
+// GenerateIrregularGalaxyPoints generates points over a unit disk. With an
+// irregularity above 0 points outside a few random clumps are rejected more
+// often, which gives the galaxy a patchy look; at 0 the disk is uniform.
+func GenerateIrregularGalaxyPoints(rng *rand.Rand, numPoints int, params IrregularParams, minDist float64) []Point {
+	clumps := make([]Point, params.NumClumps)
+	for i := range clumps {
+		clumps[i] = pointInDisk(rng, Point{}, 1-params.ClumpRadius)
+	}
+
+	inClump := func(p Point) bool {
+		for _, c := range clumps {
+			dx, dy := p.X-c.X, p.Y-c.Y
+			if dx*dx+dy*dy < params.ClumpRadius*params.ClumpRadius {
+				return true
+			}
+		}
+		return false
+	}
+
+	return samplePoints(make([]Point, 0, numPoints), numPoints, minDist, func() Point {
+		for {
+			p := pointInDisk(rng, Point{}, 1)
+			if len(clumps) == 0 || inClump(p) || rng.Float64() >= params.Irregularity {
+				return p
+			}
+		}
+	})
+}
@@ -1,16 +1,20 @@
 package interfaces
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/gr4vediggr/stellarlight/internal/game/events"
+	"github.com/gr4vediggr/stellarlight/internal/game/journal"
 	"github.com/gr4vediggr/stellarlight/internal/users"
 	"github.com/gr4vediggr/stellarlight/pkg/messages"
 )
 
 // GameSessionInterface represents a game session without import cycles
 type GameSessionInterface interface {
-	AddClient(client GameClientInterface)
+	AddClient(client GameClientInterface, lastSequence uint64)
 	RemoveClient(userID uuid.UUID)
+	DisconnectClient(client GameClientInterface)
 	GetID() uuid.UUID
 	GetInviteCode() string
 	ProcessCommand(cmd *events.ClientCommandWrapper)
@@ -23,6 +27,11 @@ type GameClientInterface interface {
 	Disconnect()
 }
 
+// ClientRegistry looks up the connected client of a player
+type ClientRegistry interface {
+	GetClient(playerID uuid.UUID) (GameClientInterface, bool)
+}
+
 // SessionManagerInterface manages game sessions
 type SessionManagerInterface interface {
 	GetPlayerSession(playerID uuid.UUID) (GameSessionInterface, error)
@@ -33,4 +42,10 @@ type GameEngineInterface interface {
 	StartGame()
 	Stop()
 	ProcessGameCommand(cmd *events.ClientCommandWrapper) error
+	RequestResync(playerID uuid.UUID, lastSequence uint64) error
+	Pause(reason string) error
+	Resume() error
+	SetSpeed(yearsPerHour float64) error
+	SetJournal(recorder *journal.Recorder)
+	TickRate() time.Duration
 }
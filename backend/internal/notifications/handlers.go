@@ -0,0 +1,103 @@
+package notifications
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 200
+)
+
+type Handler struct {
+	service *NotificationService
+}
+
+func NewHandler(service *NotificationService) *Handler {
+	return &Handler{
+		service: service,
+	}
+}
+
+// List returns the inbox of the user. Supports the query parameters unread,
+// limit and offset.
+func (h *Handler) List(c echo.Context) error {
+	userID, ok := c.Get("userID").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "User not authenticated"})
+	}
+
+	limit, err := queryInt(c, "limit", defaultListLimit)
+	if err != nil || limit <= 0 || limit > maxListLimit {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid limit"})
+	}
+	offset, err := queryInt(c, "offset", 0)
+	if err != nil || offset < 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid offset"})
+	}
+
+	ctx := c.Request().Context()
+
+	var list []*Notification
+	var unread int
+	if c.QueryParam("unread") == "true" {
+		list, unread, err = h.service.Unread(ctx, userID, limit)
+	} else {
+		list, unread, err = h.service.List(ctx, userID, limit, offset)
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"notifications": list,
+		"unreadCount":   unread,
+	})
+}
+
+func (h *Handler) MarkRead(c echo.Context) error {
+	userID, ok := c.Get("userID").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "User not authenticated"})
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid notification ID"})
+	}
+
+	if err := h.service.MarkRead(c.Request().Context(), userID, id); err != nil {
+		if errors.Is(err, ErrNotificationNotFound) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Notification marked as read"})
+}
+
+func (h *Handler) MarkAllRead(c echo.Context) error {
+	userID, ok := c.Get("userID").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "User not authenticated"})
+	}
+
+	if err := h.service.MarkAllRead(c.Request().Context(), userID); err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "All notifications marked as read"})
+}
+
+func queryInt(c echo.Context, name string, fallback int) (int, error) {
+	value := c.QueryParam(name)
+	if value == "" {
+		return fallback, nil
+	}
+	return strconv.Atoi(value)
+}
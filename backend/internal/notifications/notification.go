@@ -0,0 +1,36 @@
+package notifications
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kind identifies what a notification is about
+type Kind string
+
+const (
+	KindBattle        Kind = "battle"
+	KindBuildComplete Kind = "build_complete"
+	KindSystemLost    Kind = "system_lost"
+	KindPrivateChat   Kind = "private_chat"
+)
+
+// Notification is an entry in a player's inbox. Notifications are kept for
+// players who were offline when something happened to their empire.
+type Notification struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    uuid.UUID  `json:"userId"`
+	SessionID *uuid.UUID `json:"sessionId,omitempty"` // nil for notifications outside of a game
+	Kind      Kind       `json:"kind"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	Read      bool       `json:"read"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+// Notifier accepts notifications to be delivered to players. Notify must not
+// block, it is called from the game loop.
+type Notifier interface {
+	Notify(n *Notification)
+}
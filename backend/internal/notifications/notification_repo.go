@@ -0,0 +1,20 @@
+package notifications
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationRepository interface {
+	CreateNotification(ctx context.Context, n *Notification) (*Notification, error)
+	ListNotifications(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*Notification, error)
+	ListUnreadNotifications(ctx context.Context, userID uuid.UUID, limit int) ([]*Notification, error)
+	CountUnreadNotifications(ctx context.Context, userID uuid.UUID) (int, error)
+
+	// MarkNotificationRead reports whether the user had a notification with the given ID
+	MarkNotificationRead(ctx context.Context, id, userID uuid.UUID) (bool, error)
+	MarkAllNotificationsRead(ctx context.Context, userID uuid.UUID) error
+	DeleteReadNotificationsBefore(ctx context.Context, before time.Time) error
+}
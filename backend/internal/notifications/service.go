@@ -0,0 +1,109 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// queueSize is the number of notifications waiting to be stored before new
+	// ones are dropped
+	queueSize = 256
+	// storeTimeout bounds the time spent storing a single notification
+	storeTimeout = 5 * time.Second
+)
+
+var ErrNotificationNotFound = errors.New("notification not found")
+
+// NotificationService stores notifications and serves a player's inbox.
+// Notifications are stored in the background so the game loop never waits on
+// the database.
+type NotificationService struct {
+	repo  NotificationRepository
+	queue chan *Notification
+}
+
+func NewService(repo NotificationRepository) *NotificationService {
+	s := &NotificationService{
+		repo:  repo,
+		queue: make(chan *Notification, queueSize),
+	}
+	go s.run()
+	return s
+}
+
+// Notify queues a notification to be stored (implements Notifier). The
+// notification is dropped if the queue is full.
+func (s *NotificationService) Notify(n *Notification) {
+	if n.ID == uuid.Nil {
+		n.ID = uuid.New()
+	}
+
+	select {
+	case s.queue <- n:
+	default:
+		log.Printf("Notification queue full, dropping %s notification for %s", n.Kind, n.UserID)
+	}
+}
+
+func (s *NotificationService) run() {
+	for n := range s.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+		if _, err := s.repo.CreateNotification(ctx, n); err != nil {
+			log.Printf("Failed to store %s notification for %s: %v", n.Kind, n.UserID, err)
+		}
+		cancel()
+	}
+}
+
+// List returns a page of the notifications of a user, newest first, together
+// with the total number of unread notifications
+func (s *NotificationService) List(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*Notification, int, error) {
+	list, err := s.repo.ListNotifications(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountUnreadNotifications(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return list, count, nil
+}
+
+// Unread returns the most recent unread notifications of a user together with
+// the total number of unread notifications
+func (s *NotificationService) Unread(ctx context.Context, userID uuid.UUID, limit int) ([]*Notification, int, error) {
+	list, err := s.repo.ListUnreadNotifications(ctx, userID, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := s.repo.CountUnreadNotifications(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return list, count, nil
+}
+
+func (s *NotificationService) MarkRead(ctx context.Context, userID, id uuid.UUID) error {
+	found, err := s.repo.MarkNotificationRead(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrNotificationNotFound
+	}
+	return nil
+}
+
+func (s *NotificationService) MarkAllRead(ctx context.Context, userID uuid.UUID) error {
+	return s.repo.MarkAllNotificationsRead(ctx, userID)
+}
+
+// Cleanup deletes read notifications older than the retention period
+func (s *NotificationService) Cleanup(ctx context.Context, retention time.Duration) error {
+	return s.repo.DeleteReadNotificationsBefore(ctx, time.Now().Add(-retention))
+}
@@ -0,0 +1,126 @@
+package notifications_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gr4vediggr/stellarlight/internal/notifications"
+)
+
+// memoryRepo keeps notifications in memory
+type memoryRepo struct {
+	mu      sync.Mutex
+	list    []*notifications.Notification
+	created chan *notifications.Notification
+}
+
+func (r *memoryRepo) CreateNotification(ctx context.Context, n *notifications.Notification) (*notifications.Notification, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored := *n
+	stored.CreatedAt = time.Now()
+	r.list = append(r.list, &stored)
+	r.created <- &stored
+	return &stored, nil
+}
+
+func (r *memoryRepo) ListNotifications(ctx context.Context, userID uuid.UUID, limit, offset int) ([]*notifications.Notification, error) {
+	return r.find(userID, false, limit), nil
+}
+
+func (r *memoryRepo) ListUnreadNotifications(ctx context.Context, userID uuid.UUID, limit int) ([]*notifications.Notification, error) {
+	return r.find(userID, true, limit), nil
+}
+
+func (r *memoryRepo) CountUnreadNotifications(ctx context.Context, userID uuid.UUID) (int, error) {
+	return len(r.find(userID, true, len(r.list))), nil
+}
+
+func (r *memoryRepo) MarkNotificationRead(ctx context.Context, id, userID uuid.UUID) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, n := range r.list {
+		if n.ID == id && n.UserID == userID {
+			n.Read = true
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memoryRepo) MarkAllNotificationsRead(ctx context.Context, userID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, n := range r.list {
+		if n.UserID == userID {
+			n.Read = true
+		}
+	}
+	return nil
+}
+
+func (r *memoryRepo) DeleteReadNotificationsBefore(ctx context.Context, before time.Time) error {
+	return nil
+}
+
+func (r *memoryRepo) find(userID uuid.UUID, unread bool, limit int) []*notifications.Notification {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var found []*notifications.Notification
+	for _, n := range r.list {
+		if n.UserID == userID && !(unread && n.Read) && len(found) < limit {
+			found = append(found, n)
+		}
+	}
+	return found
+}
+
+func TestNotifyStoresInBackground(t *testing.T) {
+	repo := &memoryRepo{created: make(chan *notifications.Notification, 4)}
+	service := notifications.NewService(repo)
+	ctx := context.Background()
+
+	userID, otherID := uuid.New(), uuid.New()
+	for _, id := range []uuid.UUID{userID, userID, otherID} {
+		service.Notify(&notifications.Notification{UserID: id, Kind: notifications.KindBattle, Title: "Battle"})
+	}
+
+	var stored []*notifications.Notification
+	for len(stored) < 3 {
+		select {
+		case n := <-repo.created:
+			if n.ID == uuid.Nil {
+				t.Fatal("Expected notifications to be given an ID")
+			}
+			stored = append(stored, n)
+		case <-time.After(time.Second):
+			t.Fatal("Expected notifications to be stored")
+		}
+	}
+
+	if _, count, err := service.Unread(ctx, userID, 10); err != nil || count != 2 {
+		t.Fatalf("Expected 2 unread notifications, got %d (%v)", count, err)
+	}
+
+	if err := service.MarkRead(ctx, otherID, stored[0].ID); !errors.Is(err, notifications.ErrNotificationNotFound) {
+		t.Errorf("Expected another user's notification to be not found, got %v", err)
+	}
+	if err := service.MarkRead(ctx, userID, stored[0].ID); err != nil {
+		t.Fatalf("Failed to mark notification read: %v", err)
+	}
+	if unread, count, _ := service.Unread(ctx, userID, 10); count != 1 || len(unread) != 1 || unread[0].ID != stored[1].ID {
+		t.Errorf("Expected only the second notification to be unread, got %d", count)
+	}
+
+	if err := service.MarkAllRead(ctx, userID); err != nil {
+		t.Fatalf("Failed to mark all read: %v", err)
+	}
+	if _, count, _ := service.Unread(ctx, otherID, 10); count != 1 {
+		t.Errorf("Expected other user's notification to stay unread, got %d", count)
+	}
+}
@@ -6,7 +6,10 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/gr4vediggr/stellarlight/internal/domain/building"
 	"github.com/gr4vediggr/stellarlight/internal/domain/galaxy"
+	"github.com/gr4vediggr/stellarlight/internal/domain/ship"
+	"github.com/gr4vediggr/stellarlight/internal/domain/tech"
 )
 
 type AssetFile struct {
@@ -15,17 +18,63 @@ type AssetFile struct {
 }
 
 type Assets struct {
-	PlanetTypes map[uint32]*galaxy.PlanetType
-	StarTypes   map[uint32]*galaxy.StarType
+	PlanetTypes   map[uint32]*galaxy.PlanetType
+	StarTypes     map[uint32]*galaxy.StarType
+	ShipTypes     map[uint32]*ship.ShipType
+	BuildingTypes map[uint32]*building.BuildingType
+	Technologies  map[uint32]*tech.Technology
 	// Add more types as needed
 }
 
+// ShipTypeByKey returns the ship type with the given key
+func (a *Assets) ShipTypeByKey(key string) (*ship.ShipType, bool) {
+	for _, st := range a.ShipTypes {
+		if st.Key == key {
+			return st, true
+		}
+	}
+	return nil, false
+}
+
+// PlanetTypeByName returns the planet type with the given name
+func (a *Assets) PlanetTypeByName(name string) (*galaxy.PlanetType, bool) {
+	for _, pt := range a.PlanetTypes {
+		if pt.Name == name {
+			return pt, true
+		}
+	}
+	return nil, false
+}
+
+// BuildingTypeByKey returns the building type with the given key
+func (a *Assets) BuildingTypeByKey(key string) (*building.BuildingType, bool) {
+	for _, bt := range a.BuildingTypes {
+		if bt.Key == key {
+			return bt, true
+		}
+	}
+	return nil, false
+}
+
+// TechnologyByKey returns the technology with the given key
+func (a *Assets) TechnologyByKey(key string) (*tech.Technology, bool) {
+	for _, t := range a.Technologies {
+		if t.Key == key {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
 // Recursively loads all JSON assets from the given base directories and fills the Assets struct as maps.
 // Later IDs overwrite earlier IDs.
 func LoadAssetsFromDirs(baseDirs []string) (*Assets, error) {
 	assets := &Assets{
-		PlanetTypes: make(map[uint32]*galaxy.PlanetType),
-		StarTypes:   make(map[uint32]*galaxy.StarType),
+		PlanetTypes:   make(map[uint32]*galaxy.PlanetType),
+		StarTypes:     make(map[uint32]*galaxy.StarType),
+		ShipTypes:     make(map[uint32]*ship.ShipType),
+		BuildingTypes: make(map[uint32]*building.BuildingType),
+		Technologies:  make(map[uint32]*tech.Technology),
 	}
 	for _, base := range baseDirs {
 		err := filepath.Walk(base, func(path string, info os.FileInfo, err error) error {
@@ -60,6 +109,30 @@ func LoadAssetsFromDirs(baseDirs []string) (*Assets, error) {
 						assets.StarTypes[st.ID] = &st // Overwrite by ID
 					}
 				}
+			case "ShipType":
+				for _, r := range af.Resources {
+					b, _ := json.Marshal(r)
+					var st ship.ShipType
+					if err := json.Unmarshal(b, &st); err == nil {
+						assets.ShipTypes[st.ID] = &st // Overwrite by ID
+					}
+				}
+			case "BuildingType":
+				for _, r := range af.Resources {
+					b, _ := json.Marshal(r)
+					var bt building.BuildingType
+					if err := json.Unmarshal(b, &bt); err == nil {
+						assets.BuildingTypes[bt.ID] = &bt // Overwrite by ID
+					}
+				}
+			case "Technology":
+				for _, r := range af.Resources {
+					b, _ := json.Marshal(r)
+					var t tech.Technology
+					if err := json.Unmarshal(b, &t); err == nil {
+						assets.Technologies[t.ID] = &t // Overwrite by ID
+					}
+				}
 				// Add more cases for other resource types as needed
 			}
 			return nil
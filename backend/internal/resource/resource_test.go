@@ -20,6 +20,53 @@ func TestLoadAssetsFromDirs(t *testing.T) {
 		t.Error("Expected at least one star type, got none")
 	}
 
+	if len(assets.ShipTypes) == 0 {
+		t.Error("Expected at least one ship type, got none")
+	}
+
+	for _, st := range assets.ShipTypes {
+		if st.Key == "" {
+			t.Errorf("ShipType %s has no key", st.Name)
+		}
+		if st.Hull <= 0 || st.Speed <= 0 || st.BuildTime <= 0 {
+			t.Errorf("ShipType %s has invalid stats: hull %f, speed %f, build time %f", st.Name, st.Hull, st.Speed, st.BuildTime)
+		}
+		if found, ok := assets.ShipTypeByKey(st.Key); !ok || found.ID != st.ID {
+			t.Errorf("ShipType %s not found by key %s", st.Name, st.Key)
+		}
+	}
+
+	for _, bt := range assets.BuildingTypes {
+		if bt.BuildTime <= 0 || bt.MaxLevel <= 0 {
+			t.Errorf("BuildingType %s has invalid build time %f or max level %d", bt.Name, bt.BuildTime, bt.MaxLevel)
+		}
+		if found, ok := assets.BuildingTypeByKey(bt.Key); !ok || found.ID != bt.ID {
+			t.Errorf("BuildingType %s not found by key %s", bt.Name, bt.Key)
+		}
+	}
+
+	if len(assets.Technologies) == 0 {
+		t.Error("Expected at least one technology, got none")
+	}
+
+	for _, tech := range assets.Technologies {
+		if tech.Cost <= 0 {
+			t.Errorf("Technology %s has invalid cost %d", tech.Name, tech.Cost)
+		}
+		for _, prerequisite := range tech.Prerequisites {
+			if _, ok := assets.TechnologyByKey(prerequisite); !ok {
+				t.Errorf("Technology %s has unknown prerequisite %s", tech.Name, prerequisite)
+			}
+		}
+		for _, effect := range tech.Effects {
+			if effect.Building != "" {
+				if _, ok := assets.BuildingTypeByKey(effect.Building); !ok {
+					t.Errorf("Technology %s unlocks unknown building %s", tech.Name, effect.Building)
+				}
+			}
+		}
+	}
+
 	for _, pt := range assets.PlanetTypes {
 		if pt.MinSize >= pt.MaxSize {
 			t.Errorf("PlanetType %s has invalid size range: %f - %f", pt.Name, pt.MinSize, pt.MaxSize)
@@ -7,10 +7,17 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+// Roles of users
+const (
+	RolePlayer = "player"
+	RoleAdmin  = "admin" // can inspect and moderate every session
+)
+
 type User struct {
 	ID          uuid.UUID `json:"id"`
 	Email       string    `json:"email"`
 	DisplayName string    `json:"displayName"`
+	Role        string    `json:"role"`
 	Password    string    `json:"-"`
 	CreatedAt   time.Time `json:"createdAt"`
 	UpdatedAt   time.Time `json:"updatedAt"`
@@ -25,6 +32,11 @@ func (u *User) SetPassword(password string) error {
 	return nil
 }
 
+// IsAdmin reports whether the user has the admin role
+func (u *User) IsAdmin() bool {
+	return u.Role == RoleAdmin
+}
+
 func (u *User) CheckPassword(password string) error {
 	return bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password))
 }
@@ -1,12 +1,12 @@
 package utils
 
-import "math/rand/v2"
+import "math/rand"
 
 type WeightedChance interface {
 	GetChoiceWeight() float64
 }
 
-func WeightedRandomChoice[T WeightedChance](choices []T) T {
+func WeightedRandomChoice[T WeightedChance](rng *rand.Rand, choices []T) T {
 	if len(choices) == 0 {
 		var zero T
 		return zero
@@ -17,7 +17,7 @@ func WeightedRandomChoice[T WeightedChance](choices []T) T {
 		totalWeight += choice.GetChoiceWeight()
 	}
 
-	randomValue := rand.Float64() * totalWeight
+	randomValue := rng.Float64() * totalWeight
 	for _, choice := range choices {
 		randomValue -= choice.GetChoiceWeight()
 		if randomValue <= 0 {
@@ -28,16 +28,16 @@ func WeightedRandomChoice[T WeightedChance](choices []T) T {
 	return choices[len(choices)-1] // Fallback in case of rounding errors
 }
 
-func RandomInt(min, max int) int {
+func RandomInt(rng *rand.Rand, min, max int) int {
 	if min >= max {
 		return min
 	}
-	return int(rand.Int32N(int32(max-min))) + min
+	return rng.Intn(max-min) + min
 }
 
-func RandomFloat(min, max float64) float64 {
+func RandomFloat(rng *rand.Rand, min, max float64) float64 {
 	if min >= max {
 		return min
 	}
-	return rand.Float64()*(max-min) + min
+	return rng.Float64()*(max-min) + min
 }
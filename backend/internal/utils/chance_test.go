@@ -1,6 +1,7 @@
 package utils_test
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/gr4vediggr/stellarlight/internal/utils"
@@ -23,9 +24,10 @@ func TestWeightedRandomChance(t *testing.T) {
 		{name: "C", weight: 3.0},
 	}
 
+	rng := rand.New(rand.NewSource(1))
 	results := make(map[string]int)
 	for i := 0; i < 1000; i++ {
-		choice := utils.WeightedRandomChoice(choices)
+		choice := utils.WeightedRandomChoice(rng, choices)
 		results[choice.name]++
 	}
 
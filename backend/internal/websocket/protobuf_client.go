@@ -4,6 +4,7 @@ import (
 	"errors"
 	"log"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,7 +16,8 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-type ClientDisconnectHandler func(userID uuid.UUID)
+// ClientDisconnectHandler is called with the client whose connection closed
+type ClientDisconnectHandler func(client interfaces.GameClientInterface)
 
 var protobufUpgrader = websocket.Upgrader{
 	CheckOrigin: func(r *http.Request) bool {
@@ -23,7 +25,8 @@ var protobufUpgrader = websocket.Upgrader{
 	},
 }
 var (
-	ErrChannelFull = errors.New("send channel is full")
+	ErrChannelFull  = errors.New("send channel is full")
+	ErrClientClosed = errors.New("client is disconnected")
 )
 
 // MessageType constants
@@ -51,6 +54,9 @@ type ProtobufClient struct {
 	user              *users.User
 	sessionManager    interfaces.GameSessionInterface
 	disconnectHandler ClientDisconnectHandler
+
+	mu     sync.Mutex // guards closed and sends on send
+	closed bool
 }
 
 // NewProtobufClient creates a new protobuf websocket client
@@ -72,7 +78,7 @@ func (c *ProtobufClient) Start() {
 func (c *ProtobufClient) readPump() {
 	defer func() {
 		if c.disconnectHandler != nil {
-			c.disconnectHandler(c.user.ID)
+			c.disconnectHandler(c)
 		}
 		c.conn.Close()
 	}()
@@ -168,12 +174,7 @@ func (c *ProtobufClient) SendServerMessage(msg *messages.ServerMessage, messageI
 	}
 	// Send protobuf directly without wrapper
 	log.Printf("ProtobufClient: Sending server message directly, data length: %d bytes", len(data))
-	select {
-	case c.send <- data:
-	default:
-		log.Printf("Send buffer is full, dropping message")
-	}
-	return nil
+	return c.queue(data)
 }
 
 func (c *ProtobufClient) SendLobbyMessage(lobbyMsg *messages.LobbyMessage, messageID string) error {
@@ -217,13 +218,22 @@ func (c *ProtobufClient) SendError(code, message string) error {
 }
 
 // Cleanup methods
+
+// Disconnect closes the connection once the messages already queued are
+// written. It is safe to call more than once.
 func (c *ProtobufClient) Disconnect() {
-	if c.disconnectHandler != nil {
-		c.disconnectHandler(c.user.ID)
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return
 	}
+	c.closed = true
+	close(c.send) // the write pump sends a close frame and closes the connection
+	c.mu.Unlock()
 
-	close(c.send)
-	c.conn.Close()
+	if c.disconnectHandler != nil {
+		c.disconnectHandler(c)
+	}
 }
 
 func (c *ProtobufClient) GetUserID() uuid.UUID {
@@ -242,11 +252,23 @@ func (c *ProtobufClient) SendMessage(msg *messages.ServerMessage) error {
 
 	// Send protobuf directly without wrapper
 	log.Printf("ProtobufClient: Sending legacy message directly, data length: %d bytes", len(data))
+	return c.queue(data)
+}
+
+// queue hands a message to the write pump. Messages sent after Disconnect
+// are refused with ErrClientClosed, a full buffer drops the message.
+func (c *ProtobufClient) queue(data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return ErrClientClosed
+	}
+
 	select {
 	case c.send <- data:
 	default:
 		log.Printf("Send buffer is full, dropping message")
 	}
 	return nil
-
 }
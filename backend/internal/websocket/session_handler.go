@@ -1,26 +1,37 @@
 package websocket
 
 import (
+	"context"
 	"errors"
 	"log"
 	"log/slog"
 	"net/http"
+	"strconv"
 
-	"github.com/google/uuid"
 	"github.com/gr4vediggr/stellarlight/internal/auth"
+	"github.com/gr4vediggr/stellarlight/internal/chat"
 	"github.com/gr4vediggr/stellarlight/internal/interfaces"
+	"github.com/gr4vediggr/stellarlight/internal/notifications"
+	"github.com/gr4vediggr/stellarlight/pkg/messages"
 	"github.com/labstack/echo/v4"
 )
 
+// inboxDeliveryLimit is the number of unread notifications sent to a player on connect
+const inboxDeliveryLimit = 50
+
 type SessionHandler struct {
 	sessionManager interfaces.SessionManagerInterface
 	authService    *auth.AuthService
+	notifications  *notifications.NotificationService
+	chat           *chat.ChatService
 }
 
-func NewSessionHandler(sessionManager interfaces.SessionManagerInterface, authService *auth.AuthService) *SessionHandler {
+func NewSessionHandler(sessionManager interfaces.SessionManagerInterface, authService *auth.AuthService, notificationService *notifications.NotificationService, chatService *chat.ChatService) *SessionHandler {
 	return &SessionHandler{
 		sessionManager: sessionManager,
 		authService:    authService,
+		notifications:  notificationService,
+		chat:           chatService,
 	}
 }
 
@@ -49,6 +60,15 @@ func (h *SessionHandler) HandleWebSocket(c echo.Context) error {
 		})
 	}
 
+	// Clients resuming a game present the last update they applied
+	var lastSequence uint64
+	if resume := c.QueryParam("lastSequence"); resume != "" {
+		lastSequence, err = strconv.ParseUint(resume, 10, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid lastSequence"})
+		}
+	}
+
 	// Get user
 	user, err := h.authService.GetUserByID(c.Request().Context(), claims.UserID)
 	if err != nil {
@@ -77,10 +97,10 @@ func (h *SessionHandler) HandleWebSocket(c echo.Context) error {
 	}
 
 	// Disconnect handler that removes client from their session
-	disconnectHandler := func(userID uuid.UUID) {
+	disconnectHandler := func(client interfaces.GameClientInterface) {
 		// Find and remove client from their session
-		if session, err := h.sessionManager.GetPlayerSession(userID); err == nil {
-			session.RemoveClient(userID)
+		if session, err := h.sessionManager.GetPlayerSession(client.GetUserID()); err == nil {
+			session.DisconnectClient(client)
 			log.Printf("Client disconnected from session: %s (session: %s)", user.Email, session.GetID().String())
 		}
 	}
@@ -90,7 +110,57 @@ func (h *SessionHandler) HandleWebSocket(c echo.Context) error {
 
 	client.Start()
 
-	gameSession.AddClient(client)
+	gameSession.AddClient(client, lastSequence)
 	log.Printf("Protobuf client connected to session: %s (session: %s)", user.Email, gameSession.GetID().String())
+
+	h.deliverInbox(c.Request().Context(), client)
+	if h.chat != nil {
+		if err := h.chat.SendHistory(c.Request().Context(), client, gameSession.GetID()); err != nil {
+			slog.Error("Failed to load chat history", slog.String("user", user.Email), slog.String("error", err.Error()))
+		}
+	}
 	return nil // Don't send JSON response after WebSocket upgrade
 }
+
+// deliverInbox sends the unread notifications a player received while offline
+func (h *SessionHandler) deliverInbox(ctx context.Context, client interfaces.GameClientInterface) {
+	if h.notifications == nil {
+		return
+	}
+
+	unread, count, err := h.notifications.Unread(ctx, client.GetUserID(), inboxDeliveryLimit)
+	if err != nil {
+		slog.Error("Failed to load notifications", slog.String("user", client.GetUserID().String()), slog.String("error", err.Error()))
+		return
+	}
+	if count == 0 {
+		return
+	}
+
+	msg := &messages.NotificationsMessage{
+		Notifications: make([]*messages.Notification, 0, len(unread)),
+		UnreadCount:   int32(count),
+	}
+	for _, n := range unread {
+		notification := &messages.Notification{
+			Id:        n.ID.String(),
+			Kind:      string(n.Kind),
+			Title:     n.Title,
+			Body:      n.Body,
+			CreatedAt: n.CreatedAt.Unix(),
+			Read:      n.Read,
+		}
+		if n.SessionID != nil {
+			notification.SessionId = n.SessionID.String()
+		}
+		msg.Notifications = append(msg.Notifications, notification)
+	}
+
+	client.SendMessage(&messages.ServerMessage{
+		Message: &messages.ServerMessage_SystemMessage{
+			SystemMessage: &messages.SystemMessage{
+				Content: &messages.SystemMessage_Notifications{Notifications: msg},
+			},
+		},
+	})
+}
@@ -32,6 +32,8 @@ type ClientCommand struct {
 	//	*ClientCommand_GameCommand
 	//	*ClientCommand_ChatCommand
 	//	*ClientCommand_PingCommand
+	//	*ClientCommand_ResyncCommand
+	//	*ClientCommand_ControlCommand
 	Command       isClientCommand_Command `protobuf_oneof:"command"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -124,6 +126,24 @@ func (x *ClientCommand) GetPingCommand() *PingCommand {
 	return nil
 }
 
+func (x *ClientCommand) GetResyncCommand() *ResyncCommand {
+	if x != nil {
+		if x, ok := x.Command.(*ClientCommand_ResyncCommand); ok {
+			return x.ResyncCommand
+		}
+	}
+	return nil
+}
+
+func (x *ClientCommand) GetControlCommand() *GameControlCommand {
+	if x != nil {
+		if x, ok := x.Command.(*ClientCommand_ControlCommand); ok {
+			return x.ControlCommand
+		}
+	}
+	return nil
+}
+
 type isClientCommand_Command interface {
 	isClientCommand_Command()
 }
@@ -147,6 +167,15 @@ type ClientCommand_PingCommand struct {
 	PingCommand *PingCommand `protobuf:"bytes,40,opt,name=ping_command,json=pingCommand,proto3,oneof"`
 }
 
+type ClientCommand_ResyncCommand struct {
+	ResyncCommand *ResyncCommand `protobuf:"bytes,50,opt,name=resync_command,json=resyncCommand,proto3,oneof"`
+}
+
+type ClientCommand_ControlCommand struct {
+	// Pause, resume and speed of a running game
+	ControlCommand *GameControlCommand `protobuf:"bytes,60,opt,name=control_command,json=controlCommand,proto3,oneof"`
+}
+
 func (*ClientCommand_LobbyCommand) isClientCommand_Command() {}
 
 func (*ClientCommand_GameCommand) isClientCommand_Command() {}
@@ -155,6 +184,10 @@ func (*ClientCommand_ChatCommand) isClientCommand_Command() {}
 
 func (*ClientCommand_PingCommand) isClientCommand_Command() {}
 
+func (*ClientCommand_ResyncCommand) isClientCommand_Command() {}
+
+func (*ClientCommand_ControlCommand) isClientCommand_Command() {}
+
 type LobbyCommand struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Action:
@@ -556,6 +589,10 @@ type GameCommand struct {
 	//	*GameCommand_MoveFleet
 	//	*GameCommand_QueueConstruction
 	//	*GameCommand_QueueFleetConstruction
+	//	*GameCommand_ReorderBuildQueue
+	//	*GameCommand_CancelBuildItem
+	//	*GameCommand_ColonizePlanet
+	//	*GameCommand_QueueResearch
 	Action        isGameCommand_Action `protobuf_oneof:"action"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -625,6 +662,42 @@ func (x *GameCommand) GetQueueFleetConstruction() *QueueFleetConstructionCommand
 	return nil
 }
 
+func (x *GameCommand) GetReorderBuildQueue() *ReorderBuildQueueCommand {
+	if x != nil {
+		if x, ok := x.Action.(*GameCommand_ReorderBuildQueue); ok {
+			return x.ReorderBuildQueue
+		}
+	}
+	return nil
+}
+
+func (x *GameCommand) GetCancelBuildItem() *CancelBuildItemCommand {
+	if x != nil {
+		if x, ok := x.Action.(*GameCommand_CancelBuildItem); ok {
+			return x.CancelBuildItem
+		}
+	}
+	return nil
+}
+
+func (x *GameCommand) GetColonizePlanet() *ColonizePlanetCommand {
+	if x != nil {
+		if x, ok := x.Action.(*GameCommand_ColonizePlanet); ok {
+			return x.ColonizePlanet
+		}
+	}
+	return nil
+}
+
+func (x *GameCommand) GetQueueResearch() *QueueResearchCommand {
+	if x != nil {
+		if x, ok := x.Action.(*GameCommand_QueueResearch); ok {
+			return x.QueueResearch
+		}
+	}
+	return nil
+}
+
 type isGameCommand_Action interface {
 	isGameCommand_Action()
 }
@@ -638,7 +711,23 @@ type GameCommand_QueueConstruction struct {
 }
 
 type GameCommand_QueueFleetConstruction struct {
-	QueueFleetConstruction *QueueFleetConstructionCommand `protobuf:"bytes,3,opt,name=queue_fleet_construction,json=queueFleetConstruction,proto3,oneof"` // Add more game commands as needed
+	QueueFleetConstruction *QueueFleetConstructionCommand `protobuf:"bytes,3,opt,name=queue_fleet_construction,json=queueFleetConstruction,proto3,oneof"`
+}
+
+type GameCommand_ReorderBuildQueue struct {
+	ReorderBuildQueue *ReorderBuildQueueCommand `protobuf:"bytes,4,opt,name=reorder_build_queue,json=reorderBuildQueue,proto3,oneof"`
+}
+
+type GameCommand_CancelBuildItem struct {
+	CancelBuildItem *CancelBuildItemCommand `protobuf:"bytes,5,opt,name=cancel_build_item,json=cancelBuildItem,proto3,oneof"`
+}
+
+type GameCommand_ColonizePlanet struct {
+	ColonizePlanet *ColonizePlanetCommand `protobuf:"bytes,6,opt,name=colonize_planet,json=colonizePlanet,proto3,oneof"`
+}
+
+type GameCommand_QueueResearch struct {
+	QueueResearch *QueueResearchCommand `protobuf:"bytes,7,opt,name=queue_research,json=queueResearch,proto3,oneof"` // Add more game commands as needed
 }
 
 func (*GameCommand_MoveFleet) isGameCommand_Action() {}
@@ -647,6 +736,14 @@ func (*GameCommand_QueueConstruction) isGameCommand_Action() {}
 
 func (*GameCommand_QueueFleetConstruction) isGameCommand_Action() {}
 
+func (*GameCommand_ReorderBuildQueue) isGameCommand_Action() {}
+
+func (*GameCommand_CancelBuildItem) isGameCommand_Action() {}
+
+func (*GameCommand_ColonizePlanet) isGameCommand_Action() {}
+
+func (*GameCommand_QueueResearch) isGameCommand_Action() {}
+
 type MoveFleetCommand struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	FleetId           uint64                 `protobuf:"varint,1,opt,name=fleetId,proto3" json:"fleetId,omitempty"`
@@ -819,32 +916,29 @@ func (x *QueueFleetConstructionCommand) GetQuantity() uint32 {
 	return 0
 }
 
-type ChatCommand struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Scope:
-	//
-	//	*ChatCommand_Global
-	//	*ChatCommand_Private
-	//	*ChatCommand_Lobby
-	Scope         isChatCommand_Scope `protobuf_oneof:"scope"`
+type ReorderBuildQueueCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        uint64                 `protobuf:"varint,1,opt,name=itemId,proto3" json:"itemId,omitempty"`
+	Position      uint32                 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	EmpireQueue   bool                   `protobuf:"varint,3,opt,name=empireQueue,proto3" json:"empireQueue,omitempty"` // Move the item in the empire-wide funding queue instead of its colony queue
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChatCommand) Reset() {
-	*x = ChatCommand{}
+func (x *ReorderBuildQueueCommand) Reset() {
+	*x = ReorderBuildQueueCommand{}
 	mi := &file_client_commands_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChatCommand) String() string {
+func (x *ReorderBuildQueueCommand) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatCommand) ProtoMessage() {}
+func (*ReorderBuildQueueCommand) ProtoMessage() {}
 
-func (x *ChatCommand) ProtoReflect() protoreflect.Message {
+func (x *ReorderBuildQueueCommand) ProtoReflect() protoreflect.Message {
 	mi := &file_client_commands_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -856,88 +950,53 @@ func (x *ChatCommand) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatCommand.ProtoReflect.Descriptor instead.
-func (*ChatCommand) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReorderBuildQueueCommand.ProtoReflect.Descriptor instead.
+func (*ReorderBuildQueueCommand) Descriptor() ([]byte, []int) {
 	return file_client_commands_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ChatCommand) GetScope() isChatCommand_Scope {
-	if x != nil {
-		return x.Scope
-	}
-	return nil
-}
-
-func (x *ChatCommand) GetGlobal() *GlobalChatCommand {
+func (x *ReorderBuildQueueCommand) GetItemId() uint64 {
 	if x != nil {
-		if x, ok := x.Scope.(*ChatCommand_Global); ok {
-			return x.Global
-		}
+		return x.ItemId
 	}
-	return nil
+	return 0
 }
 
-func (x *ChatCommand) GetPrivate() *PrivateChatCommand {
+func (x *ReorderBuildQueueCommand) GetPosition() uint32 {
 	if x != nil {
-		if x, ok := x.Scope.(*ChatCommand_Private); ok {
-			return x.Private
-		}
+		return x.Position
 	}
-	return nil
+	return 0
 }
 
-func (x *ChatCommand) GetLobby() *LobbyChatCommand {
+func (x *ReorderBuildQueueCommand) GetEmpireQueue() bool {
 	if x != nil {
-		if x, ok := x.Scope.(*ChatCommand_Lobby); ok {
-			return x.Lobby
-		}
+		return x.EmpireQueue
 	}
-	return nil
-}
-
-type isChatCommand_Scope interface {
-	isChatCommand_Scope()
-}
-
-type ChatCommand_Global struct {
-	Global *GlobalChatCommand `protobuf:"bytes,1,opt,name=global,proto3,oneof"`
-}
-
-type ChatCommand_Private struct {
-	Private *PrivateChatCommand `protobuf:"bytes,2,opt,name=private,proto3,oneof"`
-}
-
-type ChatCommand_Lobby struct {
-	Lobby *LobbyChatCommand `protobuf:"bytes,3,opt,name=lobby,proto3,oneof"`
+	return false
 }
 
-func (*ChatCommand_Global) isChatCommand_Scope() {}
-
-func (*ChatCommand_Private) isChatCommand_Scope() {}
-
-func (*ChatCommand_Lobby) isChatCommand_Scope() {}
-
-type GlobalChatCommand struct {
+type CancelBuildItemCommand struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ItemId        uint64                 `protobuf:"varint,1,opt,name=itemId,proto3" json:"itemId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GlobalChatCommand) Reset() {
-	*x = GlobalChatCommand{}
+func (x *CancelBuildItemCommand) Reset() {
+	*x = CancelBuildItemCommand{}
 	mi := &file_client_commands_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GlobalChatCommand) String() string {
+func (x *CancelBuildItemCommand) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlobalChatCommand) ProtoMessage() {}
+func (*CancelBuildItemCommand) ProtoMessage() {}
 
-func (x *GlobalChatCommand) ProtoReflect() protoreflect.Message {
+func (x *CancelBuildItemCommand) ProtoReflect() protoreflect.Message {
 	mi := &file_client_commands_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -949,40 +1008,40 @@ func (x *GlobalChatCommand) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlobalChatCommand.ProtoReflect.Descriptor instead.
-func (*GlobalChatCommand) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelBuildItemCommand.ProtoReflect.Descriptor instead.
+func (*CancelBuildItemCommand) Descriptor() ([]byte, []int) {
 	return file_client_commands_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GlobalChatCommand) GetMessage() string {
+func (x *CancelBuildItemCommand) GetItemId() uint64 {
 	if x != nil {
-		return x.Message
+		return x.ItemId
 	}
-	return ""
+	return 0
 }
 
-type PrivateChatCommand struct {
+type ColonizePlanetCommand struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RecipientId   string                 `protobuf:"bytes,1,opt,name=recipientId,proto3" json:"recipientId,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	FleetId       uint64                 `protobuf:"varint,1,opt,name=fleetId,proto3" json:"fleetId,omitempty"` // Fleet with a colony ship in the planet's system
+	PlanetId      uint64                 `protobuf:"varint,2,opt,name=planetId,proto3" json:"planetId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PrivateChatCommand) Reset() {
-	*x = PrivateChatCommand{}
+func (x *ColonizePlanetCommand) Reset() {
+	*x = ColonizePlanetCommand{}
 	mi := &file_client_commands_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PrivateChatCommand) String() string {
+func (x *ColonizePlanetCommand) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrivateChatCommand) ProtoMessage() {}
+func (*ColonizePlanetCommand) ProtoMessage() {}
 
-func (x *PrivateChatCommand) ProtoReflect() protoreflect.Message {
+func (x *ColonizePlanetCommand) ProtoReflect() protoreflect.Message {
 	mi := &file_client_commands_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -994,46 +1053,47 @@ func (x *PrivateChatCommand) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrivateChatCommand.ProtoReflect.Descriptor instead.
-func (*PrivateChatCommand) Descriptor() ([]byte, []int) {
+// Deprecated: Use ColonizePlanetCommand.ProtoReflect.Descriptor instead.
+func (*ColonizePlanetCommand) Descriptor() ([]byte, []int) {
 	return file_client_commands_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *PrivateChatCommand) GetRecipientId() string {
+func (x *ColonizePlanetCommand) GetFleetId() uint64 {
 	if x != nil {
-		return x.RecipientId
+		return x.FleetId
 	}
-	return ""
+	return 0
 }
 
-func (x *PrivateChatCommand) GetMessage() string {
+func (x *ColonizePlanetCommand) GetPlanetId() uint64 {
 	if x != nil {
-		return x.Message
+		return x.PlanetId
 	}
-	return ""
+	return 0
 }
 
-type LobbyChatCommand struct {
+type QueueResearchCommand struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Technology    string                 `protobuf:"bytes,1,opt,name=technology,proto3" json:"technology,omitempty"`
+	Remove        bool                   `protobuf:"varint,2,opt,name=remove,proto3" json:"remove,omitempty"` // Remove the technology from the research queue instead
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LobbyChatCommand) Reset() {
-	*x = LobbyChatCommand{}
+func (x *QueueResearchCommand) Reset() {
+	*x = QueueResearchCommand{}
 	mi := &file_client_commands_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LobbyChatCommand) String() string {
+func (x *QueueResearchCommand) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LobbyChatCommand) ProtoMessage() {}
+func (*QueueResearchCommand) ProtoMessage() {}
 
-func (x *LobbyChatCommand) ProtoReflect() protoreflect.Message {
+func (x *QueueResearchCommand) ProtoReflect() protoreflect.Message {
 	mi := &file_client_commands_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1045,42 +1105,55 @@ func (x *LobbyChatCommand) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LobbyChatCommand.ProtoReflect.Descriptor instead.
-func (*LobbyChatCommand) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueueResearchCommand.ProtoReflect.Descriptor instead.
+func (*QueueResearchCommand) Descriptor() ([]byte, []int) {
 	return file_client_commands_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *LobbyChatCommand) GetMessage() string {
+func (x *QueueResearchCommand) GetTechnology() string {
 	if x != nil {
-		return x.Message
+		return x.Technology
 	}
 	return ""
 }
 
-type GalaxyGenerateSettings struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	NumStars              int32                  `protobuf:"varint,1,opt,name=numStars,proto3" json:"numStars,omitempty"`
-	Shape                 string                 `protobuf:"bytes,2,opt,name=shape,proto3" json:"shape,omitempty"`
-	MaxHyperlanes         int32                  `protobuf:"varint,3,opt,name=maxHyperlanes,proto3" json:"maxHyperlanes,omitempty"`
-	HyperlaneConnectivity int32                  `protobuf:"varint,4,opt,name=hyperlaneConnectivity,proto3" json:"hyperlaneConnectivity,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *QueueResearchCommand) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
 }
 
-func (x *GalaxyGenerateSettings) Reset() {
-	*x = GalaxyGenerateSettings{}
+// Sent by the host to pause, resume or change the speed of the game right
+// away. When voting is enabled in the settings, the same commands from other
+// players count as their vote and take effect once a majority of the
+// connected players agrees.
+type GameControlCommand struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Action:
+	//
+	//	*GameControlCommand_Pause
+	//	*GameControlCommand_Resume
+	//	*GameControlCommand_SetSpeed
+	Action        isGameControlCommand_Action `protobuf_oneof:"action"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameControlCommand) Reset() {
+	*x = GameControlCommand{}
 	mi := &file_client_commands_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GalaxyGenerateSettings) String() string {
+func (x *GameControlCommand) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GalaxyGenerateSettings) ProtoMessage() {}
+func (*GameControlCommand) ProtoMessage() {}
 
-func (x *GalaxyGenerateSettings) ProtoReflect() protoreflect.Message {
+func (x *GameControlCommand) ProtoReflect() protoreflect.Message {
 	mi := &file_client_commands_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1092,59 +1165,87 @@ func (x *GalaxyGenerateSettings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GalaxyGenerateSettings.ProtoReflect.Descriptor instead.
-func (*GalaxyGenerateSettings) Descriptor() ([]byte, []int) {
+// Deprecated: Use GameControlCommand.ProtoReflect.Descriptor instead.
+func (*GameControlCommand) Descriptor() ([]byte, []int) {
 	return file_client_commands_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GalaxyGenerateSettings) GetNumStars() int32 {
+func (x *GameControlCommand) GetAction() isGameControlCommand_Action {
 	if x != nil {
-		return x.NumStars
+		return x.Action
 	}
-	return 0
+	return nil
 }
 
-func (x *GalaxyGenerateSettings) GetShape() string {
+func (x *GameControlCommand) GetPause() *PauseGameCommand {
 	if x != nil {
-		return x.Shape
+		if x, ok := x.Action.(*GameControlCommand_Pause); ok {
+			return x.Pause
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *GalaxyGenerateSettings) GetMaxHyperlanes() int32 {
+func (x *GameControlCommand) GetResume() *ResumeGameCommand {
 	if x != nil {
-		return x.MaxHyperlanes
+		if x, ok := x.Action.(*GameControlCommand_Resume); ok {
+			return x.Resume
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *GalaxyGenerateSettings) GetHyperlaneConnectivity() int32 {
+func (x *GameControlCommand) GetSetSpeed() *SetGameSpeedCommand {
 	if x != nil {
-		return x.HyperlaneConnectivity
+		if x, ok := x.Action.(*GameControlCommand_SetSpeed); ok {
+			return x.SetSpeed
+		}
 	}
-	return 0
+	return nil
 }
 
-type PingCommand struct {
+type isGameControlCommand_Action interface {
+	isGameControlCommand_Action()
+}
+
+type GameControlCommand_Pause struct {
+	Pause *PauseGameCommand `protobuf:"bytes,1,opt,name=pause,proto3,oneof"`
+}
+
+type GameControlCommand_Resume struct {
+	Resume *ResumeGameCommand `protobuf:"bytes,2,opt,name=resume,proto3,oneof"`
+}
+
+type GameControlCommand_SetSpeed struct {
+	SetSpeed *SetGameSpeedCommand `protobuf:"bytes,3,opt,name=setSpeed,proto3,oneof"`
+}
+
+func (*GameControlCommand_Pause) isGameControlCommand_Action() {}
+
+func (*GameControlCommand_Resume) isGameControlCommand_Action() {}
+
+func (*GameControlCommand_SetSpeed) isGameControlCommand_Action() {}
+
+type PauseGameCommand struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PingCommand) Reset() {
-	*x = PingCommand{}
+func (x *PauseGameCommand) Reset() {
+	*x = PauseGameCommand{}
 	mi := &file_client_commands_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PingCommand) String() string {
+func (x *PauseGameCommand) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PingCommand) ProtoMessage() {}
+func (*PauseGameCommand) ProtoMessage() {}
 
-func (x *PingCommand) ProtoReflect() protoreflect.Message {
+func (x *PauseGameCommand) ProtoReflect() protoreflect.Message {
 	mi := &file_client_commands_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1156,52 +1257,1030 @@ func (x *PingCommand) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PingCommand.ProtoReflect.Descriptor instead.
-func (*PingCommand) Descriptor() ([]byte, []int) {
+// Deprecated: Use PauseGameCommand.ProtoReflect.Descriptor instead.
+func (*PauseGameCommand) Descriptor() ([]byte, []int) {
 	return file_client_commands_proto_rawDescGZIP(), []int{17}
 }
 
-var File_client_commands_proto protoreflect.FileDescriptor
+type ResumeGameCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_client_commands_proto_rawDesc = "" +
-	"\n" +
-	"\x15client_commands.proto\x12\bmessages\"\xc7\x02\n" +
-	"\rClientCommand\x12\x1a\n" +
-	"\bplayerId\x18\x01 \x01(\tR\bplayerId\x12\x1c\n" +
-	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12=\n" +
-	"\rlobby_command\x18\n" +
-	" \x01(\v2\x16.messages.LobbyCommandH\x00R\flobbyCommand\x12:\n" +
-	"\fgame_command\x18\x14 \x01(\v2\x15.messages.GameCommandH\x00R\vgameCommand\x12:\n" +
-	"\fchat_command\x18\x1e \x01(\v2\x15.messages.ChatCommandH\x00R\vchatCommand\x12:\n" +
-	"\fping_command\x18( \x01(\v2\x15.messages.PingCommandH\x00R\vpingCommandB\t\n" +
-	"\acommand\"\x8c\x03\n" +
-	"\fLobbyCommand\x12:\n" +
-	"\tjoinLobby\x18\x01 \x01(\v2\x1a.messages.JoinLobbyCommandH\x00R\tjoinLobby\x12=\n" +
-	"\n" +
-	"leaveLobby\x18\x02 \x01(\v2\x1b.messages.LeaveLobbyCommandH\x00R\n" +
-	"leaveLobby\x127\n" +
-	"\bsetReady\x18\x03 \x01(\v2\x19.messages.SetReadyCommandH\x00R\bsetReady\x127\n" +
-	"\bsetColor\x18\x04 \x01(\v2\x19.messages.SetColorCommandH\x00R\bsetColor\x12I\n" +
-	"\x0eupdateSettings\x18\x05 \x01(\v2\x1f.messages.UpdateSettingsCommandH\x00R\x0eupdateSettings\x12:\n" +
-	"\tstartGame\x18\x06 \x01(\v2\x1a.messages.StartGameCommandH\x00R\tstartGameB\b\n" +
-	"\x06action\"2\n" +
-	"\x10JoinLobbyCommand\x12\x1e\n" +
-	"\n" +
-	"inviteCode\x18\x01 \x01(\tR\n" +
-	"inviteCode\"\x13\n" +
-	"\x11LeaveLobbyCommand\"'\n" +
-	"\x0fSetReadyCommand\x12\x14\n" +
-	"\x05ready\x18\x01 \x01(\bR\x05ready\"'\n" +
+func (x *ResumeGameCommand) Reset() {
+	*x = ResumeGameCommand{}
+	mi := &file_client_commands_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeGameCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeGameCommand) ProtoMessage() {}
+
+func (x *ResumeGameCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_client_commands_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeGameCommand.ProtoReflect.Descriptor instead.
+func (*ResumeGameCommand) Descriptor() ([]byte, []int) {
+	return file_client_commands_proto_rawDescGZIP(), []int{18}
+}
+
+type SetGameSpeedCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	YearsPerHour  float64                `protobuf:"fixed64,1,opt,name=yearsPerHour,proto3" json:"yearsPerHour,omitempty"` // In-game years per real hour
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGameSpeedCommand) Reset() {
+	*x = SetGameSpeedCommand{}
+	mi := &file_client_commands_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGameSpeedCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGameSpeedCommand) ProtoMessage() {}
+
+func (x *SetGameSpeedCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_client_commands_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGameSpeedCommand.ProtoReflect.Descriptor instead.
+func (*SetGameSpeedCommand) Descriptor() ([]byte, []int) {
+	return file_client_commands_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetGameSpeedCommand) GetYearsPerHour() float64 {
+	if x != nil {
+		return x.YearsPerHour
+	}
+	return 0
+}
+
+type ChatCommand struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Scope:
+	//
+	//	*ChatCommand_Global
+	//	*ChatCommand_Private
+	//	*ChatCommand_Lobby
+	Scope         isChatCommand_Scope `protobuf_oneof:"scope"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatCommand) Reset() {
+	*x = ChatCommand{}
+	mi := &file_client_commands_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatCommand) ProtoMessage() {}
+
+func (x *ChatCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_client_commands_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatCommand.ProtoReflect.Descriptor instead.
+func (*ChatCommand) Descriptor() ([]byte, []int) {
+	return file_client_commands_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ChatCommand) GetScope() isChatCommand_Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *ChatCommand) GetGlobal() *GlobalChatCommand {
+	if x != nil {
+		if x, ok := x.Scope.(*ChatCommand_Global); ok {
+			return x.Global
+		}
+	}
+	return nil
+}
+
+func (x *ChatCommand) GetPrivate() *PrivateChatCommand {
+	if x != nil {
+		if x, ok := x.Scope.(*ChatCommand_Private); ok {
+			return x.Private
+		}
+	}
+	return nil
+}
+
+func (x *ChatCommand) GetLobby() *LobbyChatCommand {
+	if x != nil {
+		if x, ok := x.Scope.(*ChatCommand_Lobby); ok {
+			return x.Lobby
+		}
+	}
+	return nil
+}
+
+type isChatCommand_Scope interface {
+	isChatCommand_Scope()
+}
+
+type ChatCommand_Global struct {
+	Global *GlobalChatCommand `protobuf:"bytes,1,opt,name=global,proto3,oneof"`
+}
+
+type ChatCommand_Private struct {
+	Private *PrivateChatCommand `protobuf:"bytes,2,opt,name=private,proto3,oneof"`
+}
+
+type ChatCommand_Lobby struct {
+	Lobby *LobbyChatCommand `protobuf:"bytes,3,opt,name=lobby,proto3,oneof"`
+}
+
+func (*ChatCommand_Global) isChatCommand_Scope() {}
+
+func (*ChatCommand_Private) isChatCommand_Scope() {}
+
+func (*ChatCommand_Lobby) isChatCommand_Scope() {}
+
+type GlobalChatCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GlobalChatCommand) Reset() {
+	*x = GlobalChatCommand{}
+	mi := &file_client_commands_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GlobalChatCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GlobalChatCommand) ProtoMessage() {}
+
+func (x *GlobalChatCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_client_commands_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GlobalChatCommand.ProtoReflect.Descriptor instead.
+func (*GlobalChatCommand) Descriptor() ([]byte, []int) {
+	return file_client_commands_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GlobalChatCommand) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PrivateChatCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipientId   string                 `protobuf:"bytes,1,opt,name=recipientId,proto3" json:"recipientId,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrivateChatCommand) Reset() {
+	*x = PrivateChatCommand{}
+	mi := &file_client_commands_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivateChatCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivateChatCommand) ProtoMessage() {}
+
+func (x *PrivateChatCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_client_commands_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivateChatCommand.ProtoReflect.Descriptor instead.
+func (*PrivateChatCommand) Descriptor() ([]byte, []int) {
+	return file_client_commands_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PrivateChatCommand) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *PrivateChatCommand) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type LobbyChatCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LobbyChatCommand) Reset() {
+	*x = LobbyChatCommand{}
+	mi := &file_client_commands_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LobbyChatCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyChatCommand) ProtoMessage() {}
+
+func (x *LobbyChatCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_client_commands_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyChatCommand.ProtoReflect.Descriptor instead.
+func (*LobbyChatCommand) Descriptor() ([]byte, []int) {
+	return file_client_commands_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LobbyChatCommand) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GalaxyGenerateSettings struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	NumStars              int32                  `protobuf:"varint,1,opt,name=numStars,proto3" json:"numStars,omitempty"`
+	Shape                 string                 `protobuf:"bytes,2,opt,name=shape,proto3" json:"shape,omitempty"`
+	MaxHyperlanes         int32                  `protobuf:"varint,3,opt,name=maxHyperlanes,proto3" json:"maxHyperlanes,omitempty"`
+	HyperlaneConnectivity int32                  `protobuf:"varint,4,opt,name=hyperlaneConnectivity,proto3" json:"hyperlaneConnectivity,omitempty"`
+	Seed                  int64                  `protobuf:"varint,5,opt,name=seed,proto3" json:"seed,omitempty"`                   // 0 picks a random seed when the game starts
+	YearsPerHour          float64                `protobuf:"fixed64,6,opt,name=yearsPerHour,proto3" json:"yearsPerHour,omitempty"`  // In-game years per real hour, 0 for the default
+	ControlVoting         bool                   `protobuf:"varint,7,opt,name=controlVoting,proto3" json:"controlVoting,omitempty"` // Players other than the host can pause, resume and change the speed by majority vote
+	ShapeParams           *GalaxyShapeParams     `protobuf:"bytes,8,opt,name=shapeParams,proto3" json:"shapeParams,omitempty"`      // Only the parameters of the selected shape are used
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GalaxyGenerateSettings) Reset() {
+	*x = GalaxyGenerateSettings{}
+	mi := &file_client_commands_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GalaxyGenerateSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GalaxyGenerateSettings) ProtoMessage() {}
+
+func (x *GalaxyGenerateSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_client_commands_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GalaxyGenerateSettings.ProtoReflect.Descriptor instead.
+func (*GalaxyGenerateSettings) Descriptor() ([]byte, []int) {
+	return file_client_commands_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GalaxyGenerateSettings) GetNumStars() int32 {
+	if x != nil {
+		return x.NumStars
+	}
+	return 0
+}
+
+func (x *GalaxyGenerateSettings) GetShape() string {
+	if x != nil {
+		return x.Shape
+	}
+	return ""
+}
+
+func (x *GalaxyGenerateSettings) GetMaxHyperlanes() int32 {
+	if x != nil {
+		return x.MaxHyperlanes
+	}
+	return 0
+}
+
+func (x *GalaxyGenerateSettings) GetHyperlaneConnectivity() int32 {
+	if x != nil {
+		return x.HyperlaneConnectivity
+	}
+	return 0
+}
+
+func (x *GalaxyGenerateSettings) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *GalaxyGenerateSettings) GetYearsPerHour() float64 {
+	if x != nil {
+		return x.YearsPerHour
+	}
+	return 0
+}
+
+func (x *GalaxyGenerateSettings) GetControlVoting() bool {
+	if x != nil {
+		return x.ControlVoting
+	}
+	return false
+}
+
+func (x *GalaxyGenerateSettings) GetShapeParams() *GalaxyShapeParams {
+	if x != nil {
+		return x.ShapeParams
+	}
+	return nil
+}
+
+// Tunable parameters of every galaxy shape. Parameters left unset use the
+// defaults.
+type GalaxyShapeParams struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Spiral        *SpiralShapeParams       `protobuf:"bytes,1,opt,name=spiral,proto3" json:"spiral,omitempty"`
+	Elliptical    *EllipticalShapeParams   `protobuf:"bytes,2,opt,name=elliptical,proto3" json:"elliptical,omitempty"`
+	Ring          *RingShapeParams         `protobuf:"bytes,3,opt,name=ring,proto3" json:"ring,omitempty"`
+	BarredSpiral  *BarredSpiralShapeParams `protobuf:"bytes,4,opt,name=barredSpiral,proto3" json:"barredSpiral,omitempty"`
+	Clustered     *ClusteredShapeParams    `protobuf:"bytes,5,opt,name=clustered,proto3" json:"clustered,omitempty"`
+	Irregular     *IrregularShapeParams    `protobuf:"bytes,6,opt,name=irregular,proto3" json:"irregular,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GalaxyShapeParams) Reset() {
+	*x = GalaxyShapeParams{}
+	mi := &file_client_commands_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GalaxyShapeParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GalaxyShapeParams) ProtoMessage() {}
+
+func (x *GalaxyShapeParams) ProtoReflect() protoreflect.Message {
+	mi := &file_client_commands_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GalaxyShapeParams.ProtoReflect.Descriptor instead.
+func (*GalaxyShapeParams) Descriptor() ([]byte, []int) {
+	return file_client_commands_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GalaxyShapeParams) GetSpiral() *SpiralShapeParams {
+	if x != nil {
+		return x.Spiral
+	}
+	return nil
+}
+
+func (x *GalaxyShapeParams) GetElliptical() *EllipticalShapeParams {
+	if x != nil {
+		return x.Elliptical
+	}
+	return nil
+}
+
+func (x *GalaxyShapeParams) GetRing() *RingShapeParams {
+	if x != nil {
+		return x.Ring
+	}
+	return nil
+}
+
+func (x *GalaxyShapeParams) GetBarredSpiral() *BarredSpiralShapeParams {
+	if x != nil {
+		return x.BarredSpiral
+	}
+	return nil
+}
+
+func (x *GalaxyShapeParams) GetClustered() *ClusteredShapeParams {
+	if x != nil {
+		return x.Clustered
+	}
+	return nil
+}
+
+func (x *GalaxyShapeParams) GetIrregular() *IrregularShapeParams {
+	if x != nil {
+		return x.Irregular
+	}
+	return nil
+}
+
+type SpiralShapeParams struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NumArms        *int32                 `protobuf:"varint,1,opt,name=numArms,proto3,oneof" json:"numArms,omitempty"`
+	ArmSpread      *float64               `protobuf:"fixed64,2,opt,name=armSpread,proto3,oneof" json:"armSpread,omitempty"`
+	MinRadius      *float64               `protobuf:"fixed64,3,opt,name=minRadius,proto3,oneof" json:"minRadius,omitempty"`           // Radius of the core relative to the galaxy radius
+	Twist          *float64               `protobuf:"fixed64,4,opt,name=twist,proto3,oneof" json:"twist,omitempty"`                   // Number of half turns the arms make
+	InterarmChance *float64               `protobuf:"fixed64,5,opt,name=interarmChance,proto3,oneof" json:"interarmChance,omitempty"` // Probability to place a star between the arms
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SpiralShapeParams) Reset() {
+	*x = SpiralShapeParams{}
+	mi := &file_client_commands_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpiralShapeParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpiralShapeParams) ProtoMessage() {}
+
+func (x *SpiralShapeParams) ProtoReflect() protoreflect.Message {
+	mi := &file_client_commands_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpiralShapeParams.ProtoReflect.Descriptor instead.
+func (*SpiralShapeParams) Descriptor() ([]byte, []int) {
+	return file_client_commands_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SpiralShapeParams) GetNumArms() int32 {
+	if x != nil && x.NumArms != nil {
+		return *x.NumArms
+	}
+	return 0
+}
+
+func (x *SpiralShapeParams) GetArmSpread() float64 {
+	if x != nil && x.ArmSpread != nil {
+		return *x.ArmSpread
+	}
+	return 0
+}
+
+func (x *SpiralShapeParams) GetMinRadius() float64 {
+	if x != nil && x.MinRadius != nil {
+		return *x.MinRadius
+	}
+	return 0
+}
+
+func (x *SpiralShapeParams) GetTwist() float64 {
+	if x != nil && x.Twist != nil {
+		return *x.Twist
+	}
+	return 0
+}
+
+func (x *SpiralShapeParams) GetInterarmChance() float64 {
+	if x != nil && x.InterarmChance != nil {
+		return *x.InterarmChance
+	}
+	return 0
+}
+
+type EllipticalShapeParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AxisRatio     *float64               `protobuf:"fixed64,1,opt,name=axisRatio,proto3,oneof" json:"axisRatio,omitempty"`         // Minor axis relative to the major axis
+	Concentration *float64               `protobuf:"fixed64,2,opt,name=concentration,proto3,oneof" json:"concentration,omitempty"` // 1 spreads stars evenly, higher values pack them towards the core
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EllipticalShapeParams) Reset() {
+	*x = EllipticalShapeParams{}
+	mi := &file_client_commands_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EllipticalShapeParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EllipticalShapeParams) ProtoMessage() {}
+
+func (x *EllipticalShapeParams) ProtoReflect() protoreflect.Message {
+	mi := &file_client_commands_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EllipticalShapeParams.ProtoReflect.Descriptor instead.
+func (*EllipticalShapeParams) Descriptor() ([]byte, []int) {
+	return file_client_commands_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EllipticalShapeParams) GetAxisRatio() float64 {
+	if x != nil && x.AxisRatio != nil {
+		return *x.AxisRatio
+	}
+	return 0
+}
+
+func (x *EllipticalShapeParams) GetConcentration() float64 {
+	if x != nil && x.Concentration != nil {
+		return *x.Concentration
+	}
+	return 0
+}
+
+type RingShapeParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InnerRadius   *float64               `protobuf:"fixed64,1,opt,name=innerRadius,proto3,oneof" json:"innerRadius,omitempty"` // Radius of the empty centre relative to the outer radius
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RingShapeParams) Reset() {
+	*x = RingShapeParams{}
+	mi := &file_client_commands_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RingShapeParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RingShapeParams) ProtoMessage() {}
+
+func (x *RingShapeParams) ProtoReflect() protoreflect.Message {
+	mi := &file_client_commands_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RingShapeParams.ProtoReflect.Descriptor instead.
+func (*RingShapeParams) Descriptor() ([]byte, []int) {
+	return file_client_commands_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RingShapeParams) GetInnerRadius() float64 {
+	if x != nil && x.InnerRadius != nil {
+		return *x.InnerRadius
+	}
+	return 0
+}
+
+type BarredSpiralShapeParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NumArms       *int32                 `protobuf:"varint,1,opt,name=numArms,proto3,oneof" json:"numArms,omitempty"`
+	BarLength     *float64               `protobuf:"fixed64,2,opt,name=barLength,proto3,oneof" json:"barLength,omitempty"`     // Half length of the bar relative to the galaxy radius
+	BarWidth      *float64               `protobuf:"fixed64,3,opt,name=barWidth,proto3,oneof" json:"barWidth,omitempty"`       // Half width of the bar
+	BarFraction   *float64               `protobuf:"fixed64,4,opt,name=barFraction,proto3,oneof" json:"barFraction,omitempty"` // Fraction of the stars in the bar
+	ArmSpread     *float64               `protobuf:"fixed64,5,opt,name=armSpread,proto3,oneof" json:"armSpread,omitempty"`
+	Twist         *float64               `protobuf:"fixed64,6,opt,name=twist,proto3,oneof" json:"twist,omitempty"`               // Number of half turns the arms make
+	DiskFraction  *float64               `protobuf:"fixed64,7,opt,name=diskFraction,proto3,oneof" json:"diskFraction,omitempty"` // Fraction of the stars spread over the disk between the arms
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BarredSpiralShapeParams) Reset() {
+	*x = BarredSpiralShapeParams{}
+	mi := &file_client_commands_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BarredSpiralShapeParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BarredSpiralShapeParams) ProtoMessage() {}
+
+func (x *BarredSpiralShapeParams) ProtoReflect() protoreflect.Message {
+	mi := &file_client_commands_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BarredSpiralShapeParams.ProtoReflect.Descriptor instead.
+func (*BarredSpiralShapeParams) Descriptor() ([]byte, []int) {
+	return file_client_commands_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BarredSpiralShapeParams) GetNumArms() int32 {
+	if x != nil && x.NumArms != nil {
+		return *x.NumArms
+	}
+	return 0
+}
+
+func (x *BarredSpiralShapeParams) GetBarLength() float64 {
+	if x != nil && x.BarLength != nil {
+		return *x.BarLength
+	}
+	return 0
+}
+
+func (x *BarredSpiralShapeParams) GetBarWidth() float64 {
+	if x != nil && x.BarWidth != nil {
+		return *x.BarWidth
+	}
+	return 0
+}
+
+func (x *BarredSpiralShapeParams) GetBarFraction() float64 {
+	if x != nil && x.BarFraction != nil {
+		return *x.BarFraction
+	}
+	return 0
+}
+
+func (x *BarredSpiralShapeParams) GetArmSpread() float64 {
+	if x != nil && x.ArmSpread != nil {
+		return *x.ArmSpread
+	}
+	return 0
+}
+
+func (x *BarredSpiralShapeParams) GetTwist() float64 {
+	if x != nil && x.Twist != nil {
+		return *x.Twist
+	}
+	return 0
+}
+
+func (x *BarredSpiralShapeParams) GetDiskFraction() float64 {
+	if x != nil && x.DiskFraction != nil {
+		return *x.DiskFraction
+	}
+	return 0
+}
+
+type ClusteredShapeParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NumClusters   *int32                 `protobuf:"varint,1,opt,name=numClusters,proto3,oneof" json:"numClusters,omitempty"`
+	ClusterRadius *float64               `protobuf:"fixed64,2,opt,name=clusterRadius,proto3,oneof" json:"clusterRadius,omitempty"` // Radius of a cluster relative to the galaxy radius
+	BridgeSpacing *float64               `protobuf:"fixed64,3,opt,name=bridgeSpacing,proto3,oneof" json:"bridgeSpacing,omitempty"` // Distance between bridge stars in minimum distances
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusteredShapeParams) Reset() {
+	*x = ClusteredShapeParams{}
+	mi := &file_client_commands_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusteredShapeParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusteredShapeParams) ProtoMessage() {}
+
+func (x *ClusteredShapeParams) ProtoReflect() protoreflect.Message {
+	mi := &file_client_commands_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusteredShapeParams.ProtoReflect.Descriptor instead.
+func (*ClusteredShapeParams) Descriptor() ([]byte, []int) {
+	return file_client_commands_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ClusteredShapeParams) GetNumClusters() int32 {
+	if x != nil && x.NumClusters != nil {
+		return *x.NumClusters
+	}
+	return 0
+}
+
+func (x *ClusteredShapeParams) GetClusterRadius() float64 {
+	if x != nil && x.ClusterRadius != nil {
+		return *x.ClusterRadius
+	}
+	return 0
+}
+
+func (x *ClusteredShapeParams) GetBridgeSpacing() float64 {
+	if x != nil && x.BridgeSpacing != nil {
+		return *x.BridgeSpacing
+	}
+	return 0
+}
+
+type IrregularShapeParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Irregularity  *float64               `protobuf:"fixed64,1,opt,name=irregularity,proto3,oneof" json:"irregularity,omitempty"` // 0 spreads stars evenly over a disk, 1 only places them in clumps
+	NumClumps     *int32                 `protobuf:"varint,2,opt,name=numClumps,proto3,oneof" json:"numClumps,omitempty"`
+	ClumpRadius   *float64               `protobuf:"fixed64,3,opt,name=clumpRadius,proto3,oneof" json:"clumpRadius,omitempty"` // Radius of a clump relative to the galaxy radius
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IrregularShapeParams) Reset() {
+	*x = IrregularShapeParams{}
+	mi := &file_client_commands_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IrregularShapeParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IrregularShapeParams) ProtoMessage() {}
+
+func (x *IrregularShapeParams) ProtoReflect() protoreflect.Message {
+	mi := &file_client_commands_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IrregularShapeParams.ProtoReflect.Descriptor instead.
+func (*IrregularShapeParams) Descriptor() ([]byte, []int) {
+	return file_client_commands_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *IrregularShapeParams) GetIrregularity() float64 {
+	if x != nil && x.Irregularity != nil {
+		return *x.Irregularity
+	}
+	return 0
+}
+
+func (x *IrregularShapeParams) GetNumClumps() int32 {
+	if x != nil && x.NumClumps != nil {
+		return *x.NumClumps
+	}
+	return 0
+}
+
+func (x *IrregularShapeParams) GetClumpRadius() float64 {
+	if x != nil && x.ClumpRadius != nil {
+		return *x.ClumpRadius
+	}
+	return 0
+}
+
+// Sent when the client missed a sequence number in its update stream. The
+// server replays the missed messages if it still has them, otherwise it
+// answers with a fresh snapshot. Reconnecting clients pass the same number as
+// the lastSequence query parameter of the websocket URL.
+type ResyncCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastSequence  uint64                 `protobuf:"varint,1,opt,name=lastSequence,proto3" json:"lastSequence,omitempty"` // Last sequence number the client applied
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResyncCommand) Reset() {
+	*x = ResyncCommand{}
+	mi := &file_client_commands_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResyncCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncCommand) ProtoMessage() {}
+
+func (x *ResyncCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_client_commands_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncCommand.ProtoReflect.Descriptor instead.
+func (*ResyncCommand) Descriptor() ([]byte, []int) {
+	return file_client_commands_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ResyncCommand) GetLastSequence() uint64 {
+	if x != nil {
+		return x.LastSequence
+	}
+	return 0
+}
+
+type PingCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingCommand) Reset() {
+	*x = PingCommand{}
+	mi := &file_client_commands_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingCommand) ProtoMessage() {}
+
+func (x *PingCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_client_commands_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingCommand.ProtoReflect.Descriptor instead.
+func (*PingCommand) Descriptor() ([]byte, []int) {
+	return file_client_commands_proto_rawDescGZIP(), []int{33}
+}
+
+var File_client_commands_proto protoreflect.FileDescriptor
+
+const file_client_commands_proto_rawDesc = "" +
+	"\n" +
+	"\x15client_commands.proto\x12\bmessages\"\xd2\x03\n" +
+	"\rClientCommand\x12\x1a\n" +
+	"\bplayerId\x18\x01 \x01(\tR\bplayerId\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12=\n" +
+	"\rlobby_command\x18\n" +
+	" \x01(\v2\x16.messages.LobbyCommandH\x00R\flobbyCommand\x12:\n" +
+	"\fgame_command\x18\x14 \x01(\v2\x15.messages.GameCommandH\x00R\vgameCommand\x12:\n" +
+	"\fchat_command\x18\x1e \x01(\v2\x15.messages.ChatCommandH\x00R\vchatCommand\x12:\n" +
+	"\fping_command\x18( \x01(\v2\x15.messages.PingCommandH\x00R\vpingCommand\x12@\n" +
+	"\x0eresync_command\x182 \x01(\v2\x17.messages.ResyncCommandH\x00R\rresyncCommand\x12G\n" +
+	"\x0fcontrol_command\x18< \x01(\v2\x1c.messages.GameControlCommandH\x00R\x0econtrolCommandB\t\n" +
+	"\acommand\"\x8c\x03\n" +
+	"\fLobbyCommand\x12:\n" +
+	"\tjoinLobby\x18\x01 \x01(\v2\x1a.messages.JoinLobbyCommandH\x00R\tjoinLobby\x12=\n" +
+	"\n" +
+	"leaveLobby\x18\x02 \x01(\v2\x1b.messages.LeaveLobbyCommandH\x00R\n" +
+	"leaveLobby\x127\n" +
+	"\bsetReady\x18\x03 \x01(\v2\x19.messages.SetReadyCommandH\x00R\bsetReady\x127\n" +
+	"\bsetColor\x18\x04 \x01(\v2\x19.messages.SetColorCommandH\x00R\bsetColor\x12I\n" +
+	"\x0eupdateSettings\x18\x05 \x01(\v2\x1f.messages.UpdateSettingsCommandH\x00R\x0eupdateSettings\x12:\n" +
+	"\tstartGame\x18\x06 \x01(\v2\x1a.messages.StartGameCommandH\x00R\tstartGameB\b\n" +
+	"\x06action\"2\n" +
+	"\x10JoinLobbyCommand\x12\x1e\n" +
+	"\n" +
+	"inviteCode\x18\x01 \x01(\tR\n" +
+	"inviteCode\"\x13\n" +
+	"\x11LeaveLobbyCommand\"'\n" +
+	"\x0fSetReadyCommand\x12\x14\n" +
+	"\x05ready\x18\x01 \x01(\bR\x05ready\"'\n" +
 	"\x0fSetColorCommand\x12\x14\n" +
 	"\x05color\x18\x01 \x01(\tR\x05color\"U\n" +
 	"\x15UpdateSettingsCommand\x12<\n" +
 	"\bsettings\x18\x01 \x01(\v2 .messages.GalaxyGenerateSettingsR\bsettings\"\x12\n" +
-	"\x10StartGameCommand\"\x8e\x02\n" +
+	"\x10StartGameCommand\"\xc9\x04\n" +
 	"\vGameCommand\x12;\n" +
 	"\n" +
 	"move_fleet\x18\x01 \x01(\v2\x1a.messages.MoveFleetCommandH\x00R\tmoveFleet\x12S\n" +
 	"\x12queue_construction\x18\x02 \x01(\v2\".messages.QueueConstructionCommandH\x00R\x11queueConstruction\x12c\n" +
-	"\x18queue_fleet_construction\x18\x03 \x01(\v2'.messages.QueueFleetConstructionCommandH\x00R\x16queueFleetConstructionB\b\n" +
+	"\x18queue_fleet_construction\x18\x03 \x01(\v2'.messages.QueueFleetConstructionCommandH\x00R\x16queueFleetConstruction\x12T\n" +
+	"\x13reorder_build_queue\x18\x04 \x01(\v2\".messages.ReorderBuildQueueCommandH\x00R\x11reorderBuildQueue\x12N\n" +
+	"\x11cancel_build_item\x18\x05 \x01(\v2 .messages.CancelBuildItemCommandH\x00R\x0fcancelBuildItem\x12J\n" +
+	"\x0fcolonize_planet\x18\x06 \x01(\v2\x1f.messages.ColonizePlanetCommandH\x00R\x0ecolonizePlanet\x12G\n" +
+	"\x0equeue_research\x18\a \x01(\v2\x1e.messages.QueueResearchCommandH\x00R\rqueueResearchB\b\n" +
 	"\x06action\"Z\n" +
 	"\x10MoveFleetCommand\x12\x18\n" +
 	"\afleetId\x18\x01 \x01(\x04R\afleetId\x12,\n" +
@@ -1213,7 +2292,30 @@ const file_client_commands_proto_rawDesc = "" +
 	"\x1dQueueFleetConstructionCommand\x12\x1a\n" +
 	"\bcolonyId\x18\x01 \x01(\x04R\bcolonyId\x12\x1a\n" +
 	"\bshipType\x18\x02 \x01(\tR\bshipType\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\rR\bquantity\"\xbb\x01\n" +
+	"\bquantity\x18\x03 \x01(\rR\bquantity\"p\n" +
+	"\x18ReorderBuildQueueCommand\x12\x16\n" +
+	"\x06itemId\x18\x01 \x01(\x04R\x06itemId\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\rR\bposition\x12 \n" +
+	"\vempireQueue\x18\x03 \x01(\bR\vempireQueue\"0\n" +
+	"\x16CancelBuildItemCommand\x12\x16\n" +
+	"\x06itemId\x18\x01 \x01(\x04R\x06itemId\"M\n" +
+	"\x15ColonizePlanetCommand\x12\x18\n" +
+	"\afleetId\x18\x01 \x01(\x04R\afleetId\x12\x1a\n" +
+	"\bplanetId\x18\x02 \x01(\x04R\bplanetId\"N\n" +
+	"\x14QueueResearchCommand\x12\x1e\n" +
+	"\n" +
+	"technology\x18\x01 \x01(\tR\n" +
+	"technology\x12\x16\n" +
+	"\x06remove\x18\x02 \x01(\bR\x06remove\"\xc6\x01\n" +
+	"\x12GameControlCommand\x122\n" +
+	"\x05pause\x18\x01 \x01(\v2\x1a.messages.PauseGameCommandH\x00R\x05pause\x125\n" +
+	"\x06resume\x18\x02 \x01(\v2\x1b.messages.ResumeGameCommandH\x00R\x06resume\x12;\n" +
+	"\bsetSpeed\x18\x03 \x01(\v2\x1d.messages.SetGameSpeedCommandH\x00R\bsetSpeedB\b\n" +
+	"\x06action\"\x12\n" +
+	"\x10PauseGameCommand\"\x13\n" +
+	"\x11ResumeGameCommand\"9\n" +
+	"\x13SetGameSpeedCommand\x12\"\n" +
+	"\fyearsPerHour\x18\x01 \x01(\x01R\fyearsPerHour\"\xbb\x01\n" +
 	"\vChatCommand\x125\n" +
 	"\x06global\x18\x01 \x01(\v2\x1b.messages.GlobalChatCommandH\x00R\x06global\x128\n" +
 	"\aprivate\x18\x02 \x01(\v2\x1c.messages.PrivateChatCommandH\x00R\aprivate\x122\n" +
@@ -1225,12 +2327,83 @@ const file_client_commands_proto_rawDesc = "" +
 	"\vrecipientId\x18\x01 \x01(\tR\vrecipientId\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\",\n" +
 	"\x10LobbyChatCommand\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\xa6\x01\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xc3\x02\n" +
 	"\x16GalaxyGenerateSettings\x12\x1a\n" +
 	"\bnumStars\x18\x01 \x01(\x05R\bnumStars\x12\x14\n" +
 	"\x05shape\x18\x02 \x01(\tR\x05shape\x12$\n" +
 	"\rmaxHyperlanes\x18\x03 \x01(\x05R\rmaxHyperlanes\x124\n" +
-	"\x15hyperlaneConnectivity\x18\x04 \x01(\x05R\x15hyperlaneConnectivity\"\r\n" +
+	"\x15hyperlaneConnectivity\x18\x04 \x01(\x05R\x15hyperlaneConnectivity\x12\x12\n" +
+	"\x04seed\x18\x05 \x01(\x03R\x04seed\x12\"\n" +
+	"\fyearsPerHour\x18\x06 \x01(\x01R\fyearsPerHour\x12$\n" +
+	"\rcontrolVoting\x18\a \x01(\bR\rcontrolVoting\x12=\n" +
+	"\vshapeParams\x18\b \x01(\v2\x1b.messages.GalaxyShapeParamsR\vshapeParams\"\xfb\x02\n" +
+	"\x11GalaxyShapeParams\x123\n" +
+	"\x06spiral\x18\x01 \x01(\v2\x1b.messages.SpiralShapeParamsR\x06spiral\x12?\n" +
+	"\n" +
+	"elliptical\x18\x02 \x01(\v2\x1f.messages.EllipticalShapeParamsR\n" +
+	"elliptical\x12-\n" +
+	"\x04ring\x18\x03 \x01(\v2\x19.messages.RingShapeParamsR\x04ring\x12E\n" +
+	"\fbarredSpiral\x18\x04 \x01(\v2!.messages.BarredSpiralShapeParamsR\fbarredSpiral\x12<\n" +
+	"\tclustered\x18\x05 \x01(\v2\x1e.messages.ClusteredShapeParamsR\tclustered\x12<\n" +
+	"\tirregular\x18\x06 \x01(\v2\x1e.messages.IrregularShapeParamsR\tirregular\"\x85\x02\n" +
+	"\x11SpiralShapeParams\x12\x1d\n" +
+	"\anumArms\x18\x01 \x01(\x05H\x00R\anumArms\x88\x01\x01\x12!\n" +
+	"\tarmSpread\x18\x02 \x01(\x01H\x01R\tarmSpread\x88\x01\x01\x12!\n" +
+	"\tminRadius\x18\x03 \x01(\x01H\x02R\tminRadius\x88\x01\x01\x12\x19\n" +
+	"\x05twist\x18\x04 \x01(\x01H\x03R\x05twist\x88\x01\x01\x12+\n" +
+	"\x0einterarmChance\x18\x05 \x01(\x01H\x04R\x0einterarmChance\x88\x01\x01B\n" +
+	"\n" +
+	"\b_numArmsB\f\n" +
+	"\n" +
+	"_armSpreadB\f\n" +
+	"\n" +
+	"_minRadiusB\b\n" +
+	"\x06_twistB\x11\n" +
+	"\x0f_interarmChance\"\x85\x01\n" +
+	"\x15EllipticalShapeParams\x12!\n" +
+	"\taxisRatio\x18\x01 \x01(\x01H\x00R\taxisRatio\x88\x01\x01\x12)\n" +
+	"\rconcentration\x18\x02 \x01(\x01H\x01R\rconcentration\x88\x01\x01B\f\n" +
+	"\n" +
+	"_axisRatioB\x10\n" +
+	"\x0e_concentration\"H\n" +
+	"\x0fRingShapeParams\x12%\n" +
+	"\vinnerRadius\x18\x01 \x01(\x01H\x00R\vinnerRadius\x88\x01\x01B\x0e\n" +
+	"\f_innerRadius\"\xea\x02\n" +
+	"\x17BarredSpiralShapeParams\x12\x1d\n" +
+	"\anumArms\x18\x01 \x01(\x05H\x00R\anumArms\x88\x01\x01\x12!\n" +
+	"\tbarLength\x18\x02 \x01(\x01H\x01R\tbarLength\x88\x01\x01\x12\x1f\n" +
+	"\bbarWidth\x18\x03 \x01(\x01H\x02R\bbarWidth\x88\x01\x01\x12%\n" +
+	"\vbarFraction\x18\x04 \x01(\x01H\x03R\vbarFraction\x88\x01\x01\x12!\n" +
+	"\tarmSpread\x18\x05 \x01(\x01H\x04R\tarmSpread\x88\x01\x01\x12\x19\n" +
+	"\x05twist\x18\x06 \x01(\x01H\x05R\x05twist\x88\x01\x01\x12'\n" +
+	"\fdiskFraction\x18\a \x01(\x01H\x06R\fdiskFraction\x88\x01\x01B\n" +
+	"\n" +
+	"\b_numArmsB\f\n" +
+	"\n" +
+	"_barLengthB\v\n" +
+	"\t_barWidthB\x0e\n" +
+	"\f_barFractionB\f\n" +
+	"\n" +
+	"_armSpreadB\b\n" +
+	"\x06_twistB\x0f\n" +
+	"\r_diskFraction\"\xc7\x01\n" +
+	"\x14ClusteredShapeParams\x12%\n" +
+	"\vnumClusters\x18\x01 \x01(\x05H\x00R\vnumClusters\x88\x01\x01\x12)\n" +
+	"\rclusterRadius\x18\x02 \x01(\x01H\x01R\rclusterRadius\x88\x01\x01\x12)\n" +
+	"\rbridgeSpacing\x18\x03 \x01(\x01H\x02R\rbridgeSpacing\x88\x01\x01B\x0e\n" +
+	"\f_numClustersB\x10\n" +
+	"\x0e_clusterRadiusB\x10\n" +
+	"\x0e_bridgeSpacing\"\xb8\x01\n" +
+	"\x14IrregularShapeParams\x12'\n" +
+	"\firregularity\x18\x01 \x01(\x01H\x00R\firregularity\x88\x01\x01\x12!\n" +
+	"\tnumClumps\x18\x02 \x01(\x05H\x01R\tnumClumps\x88\x01\x01\x12%\n" +
+	"\vclumpRadius\x18\x03 \x01(\x01H\x02R\vclumpRadius\x88\x01\x01B\x0f\n" +
+	"\r_irregularityB\f\n" +
+	"\n" +
+	"_numClumpsB\x0e\n" +
+	"\f_clumpRadius\"3\n" +
+	"\rResyncCommand\x12\"\n" +
+	"\flastSequence\x18\x01 \x01(\x04R\flastSequence\"\r\n" +
 	"\vPingCommandB\x0eZ\fpkg/messagesb\x06proto3"
 
 var (
@@ -1245,7 +2418,7 @@ func file_client_commands_proto_rawDescGZIP() []byte {
 	return file_client_commands_proto_rawDescData
 }
 
-var file_client_commands_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_client_commands_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_client_commands_proto_goTypes = []any{
 	(*ClientCommand)(nil),                 // 0: messages.ClientCommand
 	(*LobbyCommand)(nil),                  // 1: messages.LobbyCommand
@@ -1259,36 +2432,68 @@ var file_client_commands_proto_goTypes = []any{
 	(*MoveFleetCommand)(nil),              // 9: messages.MoveFleetCommand
 	(*QueueConstructionCommand)(nil),      // 10: messages.QueueConstructionCommand
 	(*QueueFleetConstructionCommand)(nil), // 11: messages.QueueFleetConstructionCommand
-	(*ChatCommand)(nil),                   // 12: messages.ChatCommand
-	(*GlobalChatCommand)(nil),             // 13: messages.GlobalChatCommand
-	(*PrivateChatCommand)(nil),            // 14: messages.PrivateChatCommand
-	(*LobbyChatCommand)(nil),              // 15: messages.LobbyChatCommand
-	(*GalaxyGenerateSettings)(nil),        // 16: messages.GalaxyGenerateSettings
-	(*PingCommand)(nil),                   // 17: messages.PingCommand
+	(*ReorderBuildQueueCommand)(nil),      // 12: messages.ReorderBuildQueueCommand
+	(*CancelBuildItemCommand)(nil),        // 13: messages.CancelBuildItemCommand
+	(*ColonizePlanetCommand)(nil),         // 14: messages.ColonizePlanetCommand
+	(*QueueResearchCommand)(nil),          // 15: messages.QueueResearchCommand
+	(*GameControlCommand)(nil),            // 16: messages.GameControlCommand
+	(*PauseGameCommand)(nil),              // 17: messages.PauseGameCommand
+	(*ResumeGameCommand)(nil),             // 18: messages.ResumeGameCommand
+	(*SetGameSpeedCommand)(nil),           // 19: messages.SetGameSpeedCommand
+	(*ChatCommand)(nil),                   // 20: messages.ChatCommand
+	(*GlobalChatCommand)(nil),             // 21: messages.GlobalChatCommand
+	(*PrivateChatCommand)(nil),            // 22: messages.PrivateChatCommand
+	(*LobbyChatCommand)(nil),              // 23: messages.LobbyChatCommand
+	(*GalaxyGenerateSettings)(nil),        // 24: messages.GalaxyGenerateSettings
+	(*GalaxyShapeParams)(nil),             // 25: messages.GalaxyShapeParams
+	(*SpiralShapeParams)(nil),             // 26: messages.SpiralShapeParams
+	(*EllipticalShapeParams)(nil),         // 27: messages.EllipticalShapeParams
+	(*RingShapeParams)(nil),               // 28: messages.RingShapeParams
+	(*BarredSpiralShapeParams)(nil),       // 29: messages.BarredSpiralShapeParams
+	(*ClusteredShapeParams)(nil),          // 30: messages.ClusteredShapeParams
+	(*IrregularShapeParams)(nil),          // 31: messages.IrregularShapeParams
+	(*ResyncCommand)(nil),                 // 32: messages.ResyncCommand
+	(*PingCommand)(nil),                   // 33: messages.PingCommand
 }
 var file_client_commands_proto_depIdxs = []int32{
 	1,  // 0: messages.ClientCommand.lobby_command:type_name -> messages.LobbyCommand
 	8,  // 1: messages.ClientCommand.game_command:type_name -> messages.GameCommand
-	12, // 2: messages.ClientCommand.chat_command:type_name -> messages.ChatCommand
-	17, // 3: messages.ClientCommand.ping_command:type_name -> messages.PingCommand
-	2,  // 4: messages.LobbyCommand.joinLobby:type_name -> messages.JoinLobbyCommand
-	3,  // 5: messages.LobbyCommand.leaveLobby:type_name -> messages.LeaveLobbyCommand
-	4,  // 6: messages.LobbyCommand.setReady:type_name -> messages.SetReadyCommand
-	5,  // 7: messages.LobbyCommand.setColor:type_name -> messages.SetColorCommand
-	6,  // 8: messages.LobbyCommand.updateSettings:type_name -> messages.UpdateSettingsCommand
-	7,  // 9: messages.LobbyCommand.startGame:type_name -> messages.StartGameCommand
-	16, // 10: messages.UpdateSettingsCommand.settings:type_name -> messages.GalaxyGenerateSettings
-	9,  // 11: messages.GameCommand.move_fleet:type_name -> messages.MoveFleetCommand
-	10, // 12: messages.GameCommand.queue_construction:type_name -> messages.QueueConstructionCommand
-	11, // 13: messages.GameCommand.queue_fleet_construction:type_name -> messages.QueueFleetConstructionCommand
-	13, // 14: messages.ChatCommand.global:type_name -> messages.GlobalChatCommand
-	14, // 15: messages.ChatCommand.private:type_name -> messages.PrivateChatCommand
-	15, // 16: messages.ChatCommand.lobby:type_name -> messages.LobbyChatCommand
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	20, // 2: messages.ClientCommand.chat_command:type_name -> messages.ChatCommand
+	33, // 3: messages.ClientCommand.ping_command:type_name -> messages.PingCommand
+	32, // 4: messages.ClientCommand.resync_command:type_name -> messages.ResyncCommand
+	16, // 5: messages.ClientCommand.control_command:type_name -> messages.GameControlCommand
+	2,  // 6: messages.LobbyCommand.joinLobby:type_name -> messages.JoinLobbyCommand
+	3,  // 7: messages.LobbyCommand.leaveLobby:type_name -> messages.LeaveLobbyCommand
+	4,  // 8: messages.LobbyCommand.setReady:type_name -> messages.SetReadyCommand
+	5,  // 9: messages.LobbyCommand.setColor:type_name -> messages.SetColorCommand
+	6,  // 10: messages.LobbyCommand.updateSettings:type_name -> messages.UpdateSettingsCommand
+	7,  // 11: messages.LobbyCommand.startGame:type_name -> messages.StartGameCommand
+	24, // 12: messages.UpdateSettingsCommand.settings:type_name -> messages.GalaxyGenerateSettings
+	9,  // 13: messages.GameCommand.move_fleet:type_name -> messages.MoveFleetCommand
+	10, // 14: messages.GameCommand.queue_construction:type_name -> messages.QueueConstructionCommand
+	11, // 15: messages.GameCommand.queue_fleet_construction:type_name -> messages.QueueFleetConstructionCommand
+	12, // 16: messages.GameCommand.reorder_build_queue:type_name -> messages.ReorderBuildQueueCommand
+	13, // 17: messages.GameCommand.cancel_build_item:type_name -> messages.CancelBuildItemCommand
+	14, // 18: messages.GameCommand.colonize_planet:type_name -> messages.ColonizePlanetCommand
+	15, // 19: messages.GameCommand.queue_research:type_name -> messages.QueueResearchCommand
+	17, // 20: messages.GameControlCommand.pause:type_name -> messages.PauseGameCommand
+	18, // 21: messages.GameControlCommand.resume:type_name -> messages.ResumeGameCommand
+	19, // 22: messages.GameControlCommand.setSpeed:type_name -> messages.SetGameSpeedCommand
+	21, // 23: messages.ChatCommand.global:type_name -> messages.GlobalChatCommand
+	22, // 24: messages.ChatCommand.private:type_name -> messages.PrivateChatCommand
+	23, // 25: messages.ChatCommand.lobby:type_name -> messages.LobbyChatCommand
+	25, // 26: messages.GalaxyGenerateSettings.shapeParams:type_name -> messages.GalaxyShapeParams
+	26, // 27: messages.GalaxyShapeParams.spiral:type_name -> messages.SpiralShapeParams
+	27, // 28: messages.GalaxyShapeParams.elliptical:type_name -> messages.EllipticalShapeParams
+	28, // 29: messages.GalaxyShapeParams.ring:type_name -> messages.RingShapeParams
+	29, // 30: messages.GalaxyShapeParams.barredSpiral:type_name -> messages.BarredSpiralShapeParams
+	30, // 31: messages.GalaxyShapeParams.clustered:type_name -> messages.ClusteredShapeParams
+	31, // 32: messages.GalaxyShapeParams.irregular:type_name -> messages.IrregularShapeParams
+	33, // [33:33] is the sub-list for method output_type
+	33, // [33:33] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_client_commands_proto_init() }
@@ -1301,6 +2506,8 @@ func file_client_commands_proto_init() {
 		(*ClientCommand_GameCommand)(nil),
 		(*ClientCommand_ChatCommand)(nil),
 		(*ClientCommand_PingCommand)(nil),
+		(*ClientCommand_ResyncCommand)(nil),
+		(*ClientCommand_ControlCommand)(nil),
 	}
 	file_client_commands_proto_msgTypes[1].OneofWrappers = []any{
 		(*LobbyCommand_JoinLobby)(nil),
@@ -1314,19 +2521,34 @@ func file_client_commands_proto_init() {
 		(*GameCommand_MoveFleet)(nil),
 		(*GameCommand_QueueConstruction)(nil),
 		(*GameCommand_QueueFleetConstruction)(nil),
+		(*GameCommand_ReorderBuildQueue)(nil),
+		(*GameCommand_CancelBuildItem)(nil),
+		(*GameCommand_ColonizePlanet)(nil),
+		(*GameCommand_QueueResearch)(nil),
+	}
+	file_client_commands_proto_msgTypes[16].OneofWrappers = []any{
+		(*GameControlCommand_Pause)(nil),
+		(*GameControlCommand_Resume)(nil),
+		(*GameControlCommand_SetSpeed)(nil),
 	}
-	file_client_commands_proto_msgTypes[12].OneofWrappers = []any{
+	file_client_commands_proto_msgTypes[20].OneofWrappers = []any{
 		(*ChatCommand_Global)(nil),
 		(*ChatCommand_Private)(nil),
 		(*ChatCommand_Lobby)(nil),
 	}
+	file_client_commands_proto_msgTypes[26].OneofWrappers = []any{}
+	file_client_commands_proto_msgTypes[27].OneofWrappers = []any{}
+	file_client_commands_proto_msgTypes[28].OneofWrappers = []any{}
+	file_client_commands_proto_msgTypes[29].OneofWrappers = []any{}
+	file_client_commands_proto_msgTypes[30].OneofWrappers = []any{}
+	file_client_commands_proto_msgTypes[31].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_client_commands_proto_rawDesc), len(file_client_commands_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -27,6 +27,7 @@ const (
 	LobbyStateMessage_WAITING  LobbyStateMessage_LobbyStatus = 0
 	LobbyStateMessage_STARTING LobbyStateMessage_LobbyStatus = 1
 	LobbyStateMessage_IN_GAME  LobbyStateMessage_LobbyStatus = 2
+	LobbyStateMessage_ENDED    LobbyStateMessage_LobbyStatus = 3
 )
 
 // Enum value maps for LobbyStateMessage_LobbyStatus.
@@ -35,11 +36,13 @@ var (
 		0: "WAITING",
 		1: "STARTING",
 		2: "IN_GAME",
+		3: "ENDED",
 	}
 	LobbyStateMessage_LobbyStatus_value = map[string]int32{
 		"WAITING":  0,
 		"STARTING": 1,
 		"IN_GAME":  2,
+		"ENDED":    3,
 	}
 )
 
@@ -873,6 +876,9 @@ type GameMessage struct {
 	//	*GameMessage_GameState
 	//	*GameMessage_GameEvent
 	//	*GameMessage_TurnUpdate
+	//	*GameMessage_Snapshot
+	//	*GameMessage_Delta
+	//	*GameMessage_ControlVote
 	Content       isGameMessage_Content `protobuf_oneof:"content"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -942,6 +948,33 @@ func (x *GameMessage) GetTurnUpdate() *TurnUpdateMessage {
 	return nil
 }
 
+func (x *GameMessage) GetSnapshot() *GameSnapshotMessage {
+	if x != nil {
+		if x, ok := x.Content.(*GameMessage_Snapshot); ok {
+			return x.Snapshot
+		}
+	}
+	return nil
+}
+
+func (x *GameMessage) GetDelta() *GameDeltaMessage {
+	if x != nil {
+		if x, ok := x.Content.(*GameMessage_Delta); ok {
+			return x.Delta
+		}
+	}
+	return nil
+}
+
+func (x *GameMessage) GetControlVote() *ControlVoteMessage {
+	if x != nil {
+		if x, ok := x.Content.(*GameMessage_ControlVote); ok {
+			return x.ControlVote
+		}
+	}
+	return nil
+}
+
 type isGameMessage_Content interface {
 	isGameMessage_Content()
 }
@@ -958,12 +991,30 @@ type GameMessage_TurnUpdate struct {
 	TurnUpdate *TurnUpdateMessage `protobuf:"bytes,3,opt,name=turn_update,json=turnUpdate,proto3,oneof"`
 }
 
+type GameMessage_Snapshot struct {
+	Snapshot *GameSnapshotMessage `protobuf:"bytes,4,opt,name=snapshot,proto3,oneof"`
+}
+
+type GameMessage_Delta struct {
+	Delta *GameDeltaMessage `protobuf:"bytes,5,opt,name=delta,proto3,oneof"`
+}
+
+type GameMessage_ControlVote struct {
+	ControlVote *ControlVoteMessage `protobuf:"bytes,6,opt,name=control_vote,json=controlVote,proto3,oneof"`
+}
+
 func (*GameMessage_GameState) isGameMessage_Content() {}
 
 func (*GameMessage_GameEvent) isGameMessage_Content() {}
 
 func (*GameMessage_TurnUpdate) isGameMessage_Content() {}
 
+func (*GameMessage_Snapshot) isGameMessage_Content() {}
+
+func (*GameMessage_Delta) isGameMessage_Content() {}
+
+func (*GameMessage_ControlVote) isGameMessage_Content() {}
+
 type GameStateMessage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Full or partial game state
@@ -981,14 +1032,1164 @@ func (x *GameStateMessage) Reset() {
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameStateMessage) String() string {
+func (x *GameStateMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameStateMessage) ProtoMessage() {}
+
+func (x *GameStateMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameStateMessage.ProtoReflect.Descriptor instead.
+func (*GameStateMessage) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GameStateMessage) GetStateData() string {
+	if x != nil {
+		return x.StateData
+	}
+	return ""
+}
+
+func (x *GameStateMessage) GetTurnNumber() int64 {
+	if x != nil {
+		return x.TurnNumber
+	}
+	return 0
+}
+
+func (x *GameStateMessage) GetGameTime() int64 {
+	if x != nil {
+		return x.GameTime
+	}
+	return 0
+}
+
+type GameEventMessage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EventType       string                 `protobuf:"bytes,1,opt,name=eventType,proto3" json:"eventType,omitempty"` // "FLEET_ARRIVED", "BATTLE_OCCURRED", etc.
+	EventData       string                 `protobuf:"bytes,2,opt,name=eventData,proto3" json:"eventData,omitempty"` // JSON event data
+	AffectedPlayers []string               `protobuf:"bytes,3,rep,name=affectedPlayers,proto3" json:"affectedPlayers,omitempty"`
+	Sequence        uint64                 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"` // Position in the player's update stream
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GameEventMessage) Reset() {
+	*x = GameEventMessage{}
+	mi := &file_server_messages_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameEventMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameEventMessage) ProtoMessage() {}
+
+func (x *GameEventMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameEventMessage.ProtoReflect.Descriptor instead.
+func (*GameEventMessage) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GameEventMessage) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *GameEventMessage) GetEventData() string {
+	if x != nil {
+		return x.EventData
+	}
+	return ""
+}
+
+func (x *GameEventMessage) GetAffectedPlayers() []string {
+	if x != nil {
+		return x.AffectedPlayers
+	}
+	return nil
+}
+
+func (x *GameEventMessage) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+// Everything a player can see, sent when they connect or ask for a resync.
+// Deltas with a sequence number up to and including `sequence` are already
+// applied; the next delta the client expects is sequence + 1.
+type GameSnapshotMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sequence      uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Tick          int64                  `protobuf:"varint,2,opt,name=tick,proto3" json:"tick,omitempty"`
+	GameTime      int64                  `protobuf:"varint,3,opt,name=gameTime,proto3" json:"gameTime,omitempty"`
+	Empire        *EmpireSnapshot        `protobuf:"bytes,4,opt,name=empire,proto3" json:"empire,omitempty"`
+	Systems       []*SystemSnapshot      `protobuf:"bytes,5,rep,name=systems,proto3" json:"systems,omitempty"` // Every system, contents only for systems seen
+	Fleets        []*FleetSnapshot       `protobuf:"bytes,6,rep,name=fleets,proto3" json:"fleets,omitempty"`   // Own fleets and fleets in or last seen in known systems
+	Date          *GameDate              `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"`
+	YearsPerHour  float64                `protobuf:"fixed64,8,opt,name=yearsPerHour,proto3" json:"yearsPerHour,omitempty"` // In-game years per real hour
+	IsPaused      bool                   `protobuf:"varint,9,opt,name=isPaused,proto3" json:"isPaused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameSnapshotMessage) Reset() {
+	*x = GameSnapshotMessage{}
+	mi := &file_server_messages_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameSnapshotMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSnapshotMessage) ProtoMessage() {}
+
+func (x *GameSnapshotMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSnapshotMessage.ProtoReflect.Descriptor instead.
+func (*GameSnapshotMessage) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GameSnapshotMessage) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *GameSnapshotMessage) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *GameSnapshotMessage) GetGameTime() int64 {
+	if x != nil {
+		return x.GameTime
+	}
+	return 0
+}
+
+func (x *GameSnapshotMessage) GetEmpire() *EmpireSnapshot {
+	if x != nil {
+		return x.Empire
+	}
+	return nil
+}
+
+func (x *GameSnapshotMessage) GetSystems() []*SystemSnapshot {
+	if x != nil {
+		return x.Systems
+	}
+	return nil
+}
+
+func (x *GameSnapshotMessage) GetFleets() []*FleetSnapshot {
+	if x != nil {
+		return x.Fleets
+	}
+	return nil
+}
+
+func (x *GameSnapshotMessage) GetDate() *GameDate {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *GameSnapshotMessage) GetYearsPerHour() float64 {
+	if x != nil {
+		return x.YearsPerHour
+	}
+	return 0
+}
+
+func (x *GameSnapshotMessage) GetIsPaused() bool {
+	if x != nil {
+		return x.IsPaused
+	}
+	return false
+}
+
+type EmpireSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmpireId      string                 `protobuf:"bytes,1,opt,name=empireId,proto3" json:"empireId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	HomeSystemId  uint64                 `protobuf:"varint,4,opt,name=homeSystemId,proto3" json:"homeSystemId,omitempty"`
+	Resources     *ResourceAmounts       `protobuf:"bytes,5,opt,name=resources,proto3" json:"resources,omitempty"`
+	Technologies  []string               `protobuf:"bytes,6,rep,name=technologies,proto3" json:"technologies,omitempty"` // Researched technologies
+	ResearchQueue []string               `protobuf:"bytes,7,rep,name=researchQueue,proto3" json:"researchQueue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmpireSnapshot) Reset() {
+	*x = EmpireSnapshot{}
+	mi := &file_server_messages_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmpireSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmpireSnapshot) ProtoMessage() {}
+
+func (x *EmpireSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmpireSnapshot.ProtoReflect.Descriptor instead.
+func (*EmpireSnapshot) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EmpireSnapshot) GetEmpireId() string {
+	if x != nil {
+		return x.EmpireId
+	}
+	return ""
+}
+
+func (x *EmpireSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EmpireSnapshot) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *EmpireSnapshot) GetHomeSystemId() uint64 {
+	if x != nil {
+		return x.HomeSystemId
+	}
+	return 0
+}
+
+func (x *EmpireSnapshot) GetResources() *ResourceAmounts {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *EmpireSnapshot) GetTechnologies() []string {
+	if x != nil {
+		return x.Technologies
+	}
+	return nil
+}
+
+func (x *EmpireSnapshot) GetResearchQueue() []string {
+	if x != nil {
+		return x.ResearchQueue
+	}
+	return nil
+}
+
+type ResourceAmounts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credits       int64                  `protobuf:"varint,1,opt,name=credits,proto3" json:"credits,omitempty"`
+	Minerals      int64                  `protobuf:"varint,2,opt,name=minerals,proto3" json:"minerals,omitempty"`
+	Energy        int64                  `protobuf:"varint,3,opt,name=energy,proto3" json:"energy,omitempty"`
+	Research      int64                  `protobuf:"varint,4,opt,name=research,proto3" json:"research,omitempty"`
+	Population    int64                  `protobuf:"varint,5,opt,name=population,proto3" json:"population,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceAmounts) Reset() {
+	*x = ResourceAmounts{}
+	mi := &file_server_messages_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceAmounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceAmounts) ProtoMessage() {}
+
+func (x *ResourceAmounts) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceAmounts.ProtoReflect.Descriptor instead.
+func (*ResourceAmounts) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ResourceAmounts) GetCredits() int64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *ResourceAmounts) GetMinerals() int64 {
+	if x != nil {
+		return x.Minerals
+	}
+	return 0
+}
+
+func (x *ResourceAmounts) GetEnergy() int64 {
+	if x != nil {
+		return x.Energy
+	}
+	return 0
+}
+
+func (x *ResourceAmounts) GetResearch() int64 {
+	if x != nil {
+		return x.Research
+	}
+	return 0
+}
+
+func (x *ResourceAmounts) GetPopulation() int64 {
+	if x != nil {
+		return x.Population
+	}
+	return 0
+}
+
+type SystemSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StarType      string                 `protobuf:"bytes,3,opt,name=starType,proto3" json:"starType,omitempty"`
+	X             float64                `protobuf:"fixed64,4,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,5,opt,name=y,proto3" json:"y,omitempty"`
+	Hyperlanes    []uint64               `protobuf:"varint,6,rep,packed,name=hyperlanes,proto3" json:"hyperlanes,omitempty"`
+	Planets       []*PlanetSnapshot      `protobuf:"bytes,7,rep,name=planets,proto3" json:"planets,omitempty"`
+	OwnerEmpireId string                 `protobuf:"bytes,8,opt,name=ownerEmpireId,proto3" json:"ownerEmpireId,omitempty"` // Empty if unowned or never seen
+	Visible       bool                   `protobuf:"varint,9,opt,name=visible,proto3" json:"visible,omitempty"`            // In vision right now
+	LastSeen      int64                  `protobuf:"varint,10,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`         // Unix seconds, 0 if never seen
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemSnapshot) Reset() {
+	*x = SystemSnapshot{}
+	mi := &file_server_messages_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemSnapshot) ProtoMessage() {}
+
+func (x *SystemSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemSnapshot.ProtoReflect.Descriptor instead.
+func (*SystemSnapshot) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SystemSnapshot) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SystemSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemSnapshot) GetStarType() string {
+	if x != nil {
+		return x.StarType
+	}
+	return ""
+}
+
+func (x *SystemSnapshot) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *SystemSnapshot) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *SystemSnapshot) GetHyperlanes() []uint64 {
+	if x != nil {
+		return x.Hyperlanes
+	}
+	return nil
+}
+
+func (x *SystemSnapshot) GetPlanets() []*PlanetSnapshot {
+	if x != nil {
+		return x.Planets
+	}
+	return nil
+}
+
+func (x *SystemSnapshot) GetOwnerEmpireId() string {
+	if x != nil {
+		return x.OwnerEmpireId
+	}
+	return ""
+}
+
+func (x *SystemSnapshot) GetVisible() bool {
+	if x != nil {
+		return x.Visible
+	}
+	return false
+}
+
+func (x *SystemSnapshot) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+type PlanetSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Population    int64                  `protobuf:"varint,5,opt,name=population,proto3" json:"population,omitempty"`
+	ColonyId      uint64                 `protobuf:"varint,6,opt,name=colonyId,proto3" json:"colonyId,omitempty"` // Only set for the player's own colonies
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanetSnapshot) Reset() {
+	*x = PlanetSnapshot{}
+	mi := &file_server_messages_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanetSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanetSnapshot) ProtoMessage() {}
+
+func (x *PlanetSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanetSnapshot.ProtoReflect.Descriptor instead.
+func (*PlanetSnapshot) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PlanetSnapshot) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlanetSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlanetSnapshot) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PlanetSnapshot) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PlanetSnapshot) GetPopulation() int64 {
+	if x != nil {
+		return x.Population
+	}
+	return 0
+}
+
+func (x *PlanetSnapshot) GetColonyId() uint64 {
+	if x != nil {
+		return x.ColonyId
+	}
+	return 0
+}
+
+type FleetSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerEmpireId string                 `protobuf:"bytes,2,opt,name=ownerEmpireId,proto3" json:"ownerEmpireId,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Ships         map[string]int32       `protobuf:"bytes,4,rep,name=ships,proto3" json:"ships,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	LocationId    uint64                 `protobuf:"varint,5,opt,name=locationId,proto3" json:"locationId,omitempty"`
+	DestinationId uint64                 `protobuf:"varint,6,opt,name=destinationId,proto3" json:"destinationId,omitempty"` // 0 if not travelling
+	ArrivalTime   float64                `protobuf:"fixed64,7,opt,name=arrivalTime,proto3" json:"arrivalTime,omitempty"`    // In-game day, see GameDate.elapsedDays
+	LastKnown     bool                   `protobuf:"varint,8,opt,name=lastKnown,proto3" json:"lastKnown,omitempty"`         // Taken from intel on a system out of sight
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FleetSnapshot) Reset() {
+	*x = FleetSnapshot{}
+	mi := &file_server_messages_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FleetSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetSnapshot) ProtoMessage() {}
+
+func (x *FleetSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetSnapshot.ProtoReflect.Descriptor instead.
+func (*FleetSnapshot) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FleetSnapshot) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FleetSnapshot) GetOwnerEmpireId() string {
+	if x != nil {
+		return x.OwnerEmpireId
+	}
+	return ""
+}
+
+func (x *FleetSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FleetSnapshot) GetShips() map[string]int32 {
+	if x != nil {
+		return x.Ships
+	}
+	return nil
+}
+
+func (x *FleetSnapshot) GetLocationId() uint64 {
+	if x != nil {
+		return x.LocationId
+	}
+	return 0
+}
+
+func (x *FleetSnapshot) GetDestinationId() uint64 {
+	if x != nil {
+		return x.DestinationId
+	}
+	return 0
+}
+
+func (x *FleetSnapshot) GetArrivalTime() float64 {
+	if x != nil {
+		return x.ArrivalTime
+	}
+	return 0
+}
+
+func (x *FleetSnapshot) GetLastKnown() bool {
+	if x != nil {
+		return x.LastKnown
+	}
+	return false
+}
+
+// A single change to the state sent in the last snapshot
+type GameDeltaMessage struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Tick     int64                  `protobuf:"varint,2,opt,name=tick,proto3" json:"tick,omitempty"`
+	// Types that are valid to be assigned to Delta:
+	//
+	//	*GameDeltaMessage_FleetMoved
+	//	*GameDeltaMessage_FleetArrived
+	//	*GameDeltaMessage_SystemOwnerChanged
+	//	*GameDeltaMessage_ResourcesChanged
+	//	*GameDeltaMessage_VisionChanged
+	Delta         isGameDeltaMessage_Delta `protobuf_oneof:"delta"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameDeltaMessage) Reset() {
+	*x = GameDeltaMessage{}
+	mi := &file_server_messages_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameDeltaMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameDeltaMessage) ProtoMessage() {}
+
+func (x *GameDeltaMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameDeltaMessage.ProtoReflect.Descriptor instead.
+func (*GameDeltaMessage) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GameDeltaMessage) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *GameDeltaMessage) GetTick() int64 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+func (x *GameDeltaMessage) GetDelta() isGameDeltaMessage_Delta {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+func (x *GameDeltaMessage) GetFleetMoved() *FleetMovedDelta {
+	if x != nil {
+		if x, ok := x.Delta.(*GameDeltaMessage_FleetMoved); ok {
+			return x.FleetMoved
+		}
+	}
+	return nil
+}
+
+func (x *GameDeltaMessage) GetFleetArrived() *FleetArrivedDelta {
+	if x != nil {
+		if x, ok := x.Delta.(*GameDeltaMessage_FleetArrived); ok {
+			return x.FleetArrived
+		}
+	}
+	return nil
+}
+
+func (x *GameDeltaMessage) GetSystemOwnerChanged() *SystemOwnerChangedDelta {
+	if x != nil {
+		if x, ok := x.Delta.(*GameDeltaMessage_SystemOwnerChanged); ok {
+			return x.SystemOwnerChanged
+		}
+	}
+	return nil
+}
+
+func (x *GameDeltaMessage) GetResourcesChanged() *ResourcesChangedDelta {
+	if x != nil {
+		if x, ok := x.Delta.(*GameDeltaMessage_ResourcesChanged); ok {
+			return x.ResourcesChanged
+		}
+	}
+	return nil
+}
+
+func (x *GameDeltaMessage) GetVisionChanged() *VisionChangedDelta {
+	if x != nil {
+		if x, ok := x.Delta.(*GameDeltaMessage_VisionChanged); ok {
+			return x.VisionChanged
+		}
+	}
+	return nil
+}
+
+type isGameDeltaMessage_Delta interface {
+	isGameDeltaMessage_Delta()
+}
+
+type GameDeltaMessage_FleetMoved struct {
+	FleetMoved *FleetMovedDelta `protobuf:"bytes,10,opt,name=fleet_moved,json=fleetMoved,proto3,oneof"`
+}
+
+type GameDeltaMessage_FleetArrived struct {
+	FleetArrived *FleetArrivedDelta `protobuf:"bytes,11,opt,name=fleet_arrived,json=fleetArrived,proto3,oneof"`
+}
+
+type GameDeltaMessage_SystemOwnerChanged struct {
+	SystemOwnerChanged *SystemOwnerChangedDelta `protobuf:"bytes,12,opt,name=system_owner_changed,json=systemOwnerChanged,proto3,oneof"`
+}
+
+type GameDeltaMessage_ResourcesChanged struct {
+	ResourcesChanged *ResourcesChangedDelta `protobuf:"bytes,13,opt,name=resources_changed,json=resourcesChanged,proto3,oneof"`
+}
+
+type GameDeltaMessage_VisionChanged struct {
+	VisionChanged *VisionChangedDelta `protobuf:"bytes,14,opt,name=vision_changed,json=visionChanged,proto3,oneof"`
+}
+
+func (*GameDeltaMessage_FleetMoved) isGameDeltaMessage_Delta() {}
+
+func (*GameDeltaMessage_FleetArrived) isGameDeltaMessage_Delta() {}
+
+func (*GameDeltaMessage_SystemOwnerChanged) isGameDeltaMessage_Delta() {}
+
+func (*GameDeltaMessage_ResourcesChanged) isGameDeltaMessage_Delta() {}
+
+func (*GameDeltaMessage_VisionChanged) isGameDeltaMessage_Delta() {}
+
+type FleetMovedDelta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fleet         *FleetSnapshot         `protobuf:"bytes,1,opt,name=fleet,proto3" json:"fleet,omitempty"`
+	FromSystemId  uint64                 `protobuf:"varint,2,opt,name=fromSystemId,proto3" json:"fromSystemId,omitempty"`
+	ToSystemId    uint64                 `protobuf:"varint,3,opt,name=toSystemId,proto3" json:"toSystemId,omitempty"`
+	ArrivalTime   float64                `protobuf:"fixed64,4,opt,name=arrivalTime,proto3" json:"arrivalTime,omitempty"` // In-game day
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FleetMovedDelta) Reset() {
+	*x = FleetMovedDelta{}
+	mi := &file_server_messages_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FleetMovedDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetMovedDelta) ProtoMessage() {}
+
+func (x *FleetMovedDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetMovedDelta.ProtoReflect.Descriptor instead.
+func (*FleetMovedDelta) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FleetMovedDelta) GetFleet() *FleetSnapshot {
+	if x != nil {
+		return x.Fleet
+	}
+	return nil
+}
+
+func (x *FleetMovedDelta) GetFromSystemId() uint64 {
+	if x != nil {
+		return x.FromSystemId
+	}
+	return 0
+}
+
+func (x *FleetMovedDelta) GetToSystemId() uint64 {
+	if x != nil {
+		return x.ToSystemId
+	}
+	return 0
+}
+
+func (x *FleetMovedDelta) GetArrivalTime() float64 {
+	if x != nil {
+		return x.ArrivalTime
+	}
+	return 0
+}
+
+type FleetArrivedDelta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fleet         *FleetSnapshot         `protobuf:"bytes,1,opt,name=fleet,proto3" json:"fleet,omitempty"`
+	SystemId      uint64                 `protobuf:"varint,2,opt,name=systemId,proto3" json:"systemId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FleetArrivedDelta) Reset() {
+	*x = FleetArrivedDelta{}
+	mi := &file_server_messages_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FleetArrivedDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetArrivedDelta) ProtoMessage() {}
+
+func (x *FleetArrivedDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetArrivedDelta.ProtoReflect.Descriptor instead.
+func (*FleetArrivedDelta) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FleetArrivedDelta) GetFleet() *FleetSnapshot {
+	if x != nil {
+		return x.Fleet
+	}
+	return nil
+}
+
+func (x *FleetArrivedDelta) GetSystemId() uint64 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+type SystemOwnerChangedDelta struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	SystemId              uint64                 `protobuf:"varint,1,opt,name=systemId,proto3" json:"systemId,omitempty"`
+	PreviousOwnerEmpireId string                 `protobuf:"bytes,2,opt,name=previousOwnerEmpireId,proto3" json:"previousOwnerEmpireId,omitempty"`
+	OwnerEmpireId         string                 `protobuf:"bytes,3,opt,name=ownerEmpireId,proto3" json:"ownerEmpireId,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SystemOwnerChangedDelta) Reset() {
+	*x = SystemOwnerChangedDelta{}
+	mi := &file_server_messages_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemOwnerChangedDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemOwnerChangedDelta) ProtoMessage() {}
+
+func (x *SystemOwnerChangedDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemOwnerChangedDelta.ProtoReflect.Descriptor instead.
+func (*SystemOwnerChangedDelta) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SystemOwnerChangedDelta) GetSystemId() uint64 {
+	if x != nil {
+		return x.SystemId
+	}
+	return 0
+}
+
+func (x *SystemOwnerChangedDelta) GetPreviousOwnerEmpireId() string {
+	if x != nil {
+		return x.PreviousOwnerEmpireId
+	}
+	return ""
+}
+
+func (x *SystemOwnerChangedDelta) GetOwnerEmpireId() string {
+	if x != nil {
+		return x.OwnerEmpireId
+	}
+	return ""
+}
+
+type ResourcesChangedDelta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resources     *ResourceAmounts       `protobuf:"bytes,1,opt,name=resources,proto3" json:"resources,omitempty"`
+	Income        *ResourceAmounts       `protobuf:"bytes,2,opt,name=income,proto3" json:"income,omitempty"` // Net income of the last economy update
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourcesChangedDelta) Reset() {
+	*x = ResourcesChangedDelta{}
+	mi := &file_server_messages_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourcesChangedDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourcesChangedDelta) ProtoMessage() {}
+
+func (x *ResourcesChangedDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourcesChangedDelta.ProtoReflect.Descriptor instead.
+func (*ResourcesChangedDelta) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ResourcesChangedDelta) GetResources() *ResourceAmounts {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ResourcesChangedDelta) GetIncome() *ResourceAmounts {
+	if x != nil {
+		return x.Income
+	}
+	return nil
+}
+
+type VisionChangedDelta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revealed      []*SystemSnapshot      `protobuf:"bytes,1,rep,name=revealed,proto3" json:"revealed,omitempty"`
+	Fleets        []*FleetSnapshot       `protobuf:"bytes,2,rep,name=fleets,proto3" json:"fleets,omitempty"`         // Fleets in the revealed systems
+	Hidden        []uint64               `protobuf:"varint,3,rep,packed,name=hidden,proto3" json:"hidden,omitempty"` // Systems that went out of sight
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VisionChangedDelta) Reset() {
+	*x = VisionChangedDelta{}
+	mi := &file_server_messages_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisionChangedDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisionChangedDelta) ProtoMessage() {}
+
+func (x *VisionChangedDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisionChangedDelta.ProtoReflect.Descriptor instead.
+func (*VisionChangedDelta) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *VisionChangedDelta) GetRevealed() []*SystemSnapshot {
+	if x != nil {
+		return x.Revealed
+	}
+	return nil
+}
+
+func (x *VisionChangedDelta) GetFleets() []*FleetSnapshot {
+	if x != nil {
+		return x.Fleets
+	}
+	return nil
+}
+
+func (x *VisionChangedDelta) GetHidden() []uint64 {
+	if x != nil {
+		return x.Hidden
+	}
+	return nil
+}
+
+// Sent to every player when a new in-game day starts
+type TurnUpdateMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TurnNumber    int64                  `protobuf:"varint,1,opt,name=turnNumber,proto3" json:"turnNumber,omitempty"`
+	TurnDeadline  int64                  `protobuf:"varint,2,opt,name=turnDeadline,proto3" json:"turnDeadline,omitempty"`
+	IsPaused      bool                   `protobuf:"varint,3,opt,name=isPaused,proto3" json:"isPaused,omitempty"`
+	Date          *GameDate              `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	YearsPerHour  float64                `protobuf:"fixed64,5,opt,name=yearsPerHour,proto3" json:"yearsPerHour,omitempty"` // In-game years per real hour
+	PauseReason   string                 `protobuf:"bytes,6,opt,name=pauseReason,proto3" json:"pauseReason,omitempty"`     // "host", "vote" or "maintenance" while paused
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TurnUpdateMessage) Reset() {
+	*x = TurnUpdateMessage{}
+	mi := &file_server_messages_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TurnUpdateMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameStateMessage) ProtoMessage() {}
+func (*TurnUpdateMessage) ProtoMessage() {}
 
-func (x *GameStateMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_server_messages_proto_msgTypes[11]
+func (x *TurnUpdateMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -999,56 +2200,82 @@ func (x *GameStateMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameStateMessage.ProtoReflect.Descriptor instead.
-func (*GameStateMessage) Descriptor() ([]byte, []int) {
-	return file_server_messages_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use TurnUpdateMessage.ProtoReflect.Descriptor instead.
+func (*TurnUpdateMessage) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GameStateMessage) GetStateData() string {
+func (x *TurnUpdateMessage) GetTurnNumber() int64 {
 	if x != nil {
-		return x.StateData
+		return x.TurnNumber
 	}
-	return ""
+	return 0
 }
 
-func (x *GameStateMessage) GetTurnNumber() int64 {
+func (x *TurnUpdateMessage) GetTurnDeadline() int64 {
 	if x != nil {
-		return x.TurnNumber
+		return x.TurnDeadline
 	}
 	return 0
 }
 
-func (x *GameStateMessage) GetGameTime() int64 {
+func (x *TurnUpdateMessage) GetIsPaused() bool {
 	if x != nil {
-		return x.GameTime
+		return x.IsPaused
+	}
+	return false
+}
+
+func (x *TurnUpdateMessage) GetDate() *GameDate {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *TurnUpdateMessage) GetYearsPerHour() float64 {
+	if x != nil {
+		return x.YearsPerHour
 	}
 	return 0
 }
 
-type GameEventMessage struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	EventType       string                 `protobuf:"bytes,1,opt,name=eventType,proto3" json:"eventType,omitempty"` // "FLEET_ARRIVED", "BATTLE_OCCURRED", etc.
-	EventData       string                 `protobuf:"bytes,2,opt,name=eventData,proto3" json:"eventData,omitempty"` // JSON event data
-	AffectedPlayers []string               `protobuf:"bytes,3,rep,name=affectedPlayers,proto3" json:"affectedPlayers,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *TurnUpdateMessage) GetPauseReason() string {
+	if x != nil {
+		return x.PauseReason
+	}
+	return ""
 }
 
-func (x *GameEventMessage) Reset() {
-	*x = GameEventMessage{}
-	mi := &file_server_messages_proto_msgTypes[12]
+// Progress of a vote to pause, resume or change the speed of the game. Sent
+// to every player when someone votes; the vote passes once votes reaches
+// required.
+type ControlVoteMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`               // "pause", "resume" or "speed"
+	YearsPerHour  float64                `protobuf:"fixed64,2,opt,name=yearsPerHour,proto3" json:"yearsPerHour,omitempty"` // Proposed speed of a "speed" vote
+	Votes         int32                  `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`
+	Required      int32                  `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,5,opt,name=playerId,proto3" json:"playerId,omitempty"` // Player who just voted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlVoteMessage) Reset() {
+	*x = ControlVoteMessage{}
+	mi := &file_server_messages_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameEventMessage) String() string {
+func (x *ControlVoteMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameEventMessage) ProtoMessage() {}
+func (*ControlVoteMessage) ProtoMessage() {}
 
-func (x *GameEventMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_server_messages_proto_msgTypes[12]
+func (x *ControlVoteMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1059,56 +2286,72 @@ func (x *GameEventMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameEventMessage.ProtoReflect.Descriptor instead.
-func (*GameEventMessage) Descriptor() ([]byte, []int) {
-	return file_server_messages_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ControlVoteMessage.ProtoReflect.Descriptor instead.
+func (*ControlVoteMessage) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GameEventMessage) GetEventType() string {
+func (x *ControlVoteMessage) GetAction() string {
 	if x != nil {
-		return x.EventType
+		return x.Action
 	}
 	return ""
 }
 
-func (x *GameEventMessage) GetEventData() string {
+func (x *ControlVoteMessage) GetYearsPerHour() float64 {
 	if x != nil {
-		return x.EventData
+		return x.YearsPerHour
 	}
-	return ""
+	return 0
 }
 
-func (x *GameEventMessage) GetAffectedPlayers() []string {
+func (x *ControlVoteMessage) GetVotes() int32 {
 	if x != nil {
-		return x.AffectedPlayers
+		return x.Votes
 	}
-	return nil
+	return 0
 }
 
-type TurnUpdateMessage struct {
+func (x *ControlVoteMessage) GetRequired() int32 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+func (x *ControlVoteMessage) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+// A date on the in-game calendar of 12 months of 30 days
+type GameDate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TurnNumber    int64                  `protobuf:"varint,1,opt,name=turnNumber,proto3" json:"turnNumber,omitempty"`
-	TurnDeadline  int64                  `protobuf:"varint,2,opt,name=turnDeadline,proto3" json:"turnDeadline,omitempty"`
-	IsPaused      bool                   `protobuf:"varint,3,opt,name=isPaused,proto3" json:"isPaused,omitempty"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Day           int32                  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	ElapsedDays   float64                `protobuf:"fixed64,4,opt,name=elapsedDays,proto3" json:"elapsedDays,omitempty"` // In-game days since the start of the game
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TurnUpdateMessage) Reset() {
-	*x = TurnUpdateMessage{}
-	mi := &file_server_messages_proto_msgTypes[13]
+func (x *GameDate) Reset() {
+	*x = GameDate{}
+	mi := &file_server_messages_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TurnUpdateMessage) String() string {
+func (x *GameDate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TurnUpdateMessage) ProtoMessage() {}
+func (*GameDate) ProtoMessage() {}
 
-func (x *TurnUpdateMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_server_messages_proto_msgTypes[13]
+func (x *GameDate) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1119,30 +2362,37 @@ func (x *TurnUpdateMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TurnUpdateMessage.ProtoReflect.Descriptor instead.
-func (*TurnUpdateMessage) Descriptor() ([]byte, []int) {
-	return file_server_messages_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GameDate.ProtoReflect.Descriptor instead.
+func (*GameDate) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *TurnUpdateMessage) GetTurnNumber() int64 {
+func (x *GameDate) GetYear() int32 {
 	if x != nil {
-		return x.TurnNumber
+		return x.Year
 	}
 	return 0
 }
 
-func (x *TurnUpdateMessage) GetTurnDeadline() int64 {
+func (x *GameDate) GetMonth() int32 {
 	if x != nil {
-		return x.TurnDeadline
+		return x.Month
 	}
 	return 0
 }
 
-func (x *TurnUpdateMessage) GetIsPaused() bool {
+func (x *GameDate) GetDay() int32 {
 	if x != nil {
-		return x.IsPaused
+		return x.Day
 	}
-	return false
+	return 0
+}
+
+func (x *GameDate) GetElapsedDays() float64 {
+	if x != nil {
+		return x.ElapsedDays
+	}
+	return 0
 }
 
 type ChatMessage struct {
@@ -1163,7 +2413,7 @@ type ChatMessage struct {
 
 func (x *ChatMessage) Reset() {
 	*x = ChatMessage{}
-	mi := &file_server_messages_proto_msgTypes[14]
+	mi := &file_server_messages_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1175,7 +2425,7 @@ func (x *ChatMessage) String() string {
 func (*ChatMessage) ProtoMessage() {}
 
 func (x *ChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_server_messages_proto_msgTypes[14]
+	mi := &file_server_messages_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1188,7 +2438,7 @@ func (x *ChatMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
 func (*ChatMessage) Descriptor() ([]byte, []int) {
-	return file_server_messages_proto_rawDescGZIP(), []int{14}
+	return file_server_messages_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ChatMessage) GetSenderId() string {
@@ -1292,7 +2542,7 @@ type GlobalChatMessage struct {
 
 func (x *GlobalChatMessage) Reset() {
 	*x = GlobalChatMessage{}
-	mi := &file_server_messages_proto_msgTypes[15]
+	mi := &file_server_messages_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1304,7 +2554,7 @@ func (x *GlobalChatMessage) String() string {
 func (*GlobalChatMessage) ProtoMessage() {}
 
 func (x *GlobalChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_server_messages_proto_msgTypes[15]
+	mi := &file_server_messages_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1317,7 +2567,7 @@ func (x *GlobalChatMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GlobalChatMessage.ProtoReflect.Descriptor instead.
 func (*GlobalChatMessage) Descriptor() ([]byte, []int) {
-	return file_server_messages_proto_rawDescGZIP(), []int{15}
+	return file_server_messages_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GlobalChatMessage) GetMessage() string {
@@ -1337,7 +2587,7 @@ type PrivateChatMessage struct {
 
 func (x *PrivateChatMessage) Reset() {
 	*x = PrivateChatMessage{}
-	mi := &file_server_messages_proto_msgTypes[16]
+	mi := &file_server_messages_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1349,7 +2599,7 @@ func (x *PrivateChatMessage) String() string {
 func (*PrivateChatMessage) ProtoMessage() {}
 
 func (x *PrivateChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_server_messages_proto_msgTypes[16]
+	mi := &file_server_messages_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1362,7 +2612,7 @@ func (x *PrivateChatMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrivateChatMessage.ProtoReflect.Descriptor instead.
 func (*PrivateChatMessage) Descriptor() ([]byte, []int) {
-	return file_server_messages_proto_rawDescGZIP(), []int{16}
+	return file_server_messages_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *PrivateChatMessage) GetRecipientId() string {
@@ -1388,7 +2638,7 @@ type LobbyChatMessage struct {
 
 func (x *LobbyChatMessage) Reset() {
 	*x = LobbyChatMessage{}
-	mi := &file_server_messages_proto_msgTypes[17]
+	mi := &file_server_messages_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1400,7 +2650,7 @@ func (x *LobbyChatMessage) String() string {
 func (*LobbyChatMessage) ProtoMessage() {}
 
 func (x *LobbyChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_server_messages_proto_msgTypes[17]
+	mi := &file_server_messages_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1413,7 +2663,7 @@ func (x *LobbyChatMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LobbyChatMessage.ProtoReflect.Descriptor instead.
 func (*LobbyChatMessage) Descriptor() ([]byte, []int) {
-	return file_server_messages_proto_rawDescGZIP(), []int{17}
+	return file_server_messages_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *LobbyChatMessage) GetMessage() string {
@@ -1433,7 +2683,7 @@ type SystemChatMessage struct {
 
 func (x *SystemChatMessage) Reset() {
 	*x = SystemChatMessage{}
-	mi := &file_server_messages_proto_msgTypes[18]
+	mi := &file_server_messages_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1445,7 +2695,7 @@ func (x *SystemChatMessage) String() string {
 func (*SystemChatMessage) ProtoMessage() {}
 
 func (x *SystemChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_server_messages_proto_msgTypes[18]
+	mi := &file_server_messages_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1458,7 +2708,7 @@ func (x *SystemChatMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemChatMessage.ProtoReflect.Descriptor instead.
 func (*SystemChatMessage) Descriptor() ([]byte, []int) {
-	return file_server_messages_proto_rawDescGZIP(), []int{18}
+	return file_server_messages_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SystemChatMessage) GetMessage() string {
@@ -1482,6 +2732,7 @@ type SystemMessage struct {
 	//	*SystemMessage_Connection
 	//	*SystemMessage_Auth
 	//	*SystemMessage_ServerStatus
+	//	*SystemMessage_Notifications
 	Content       isSystemMessage_Content `protobuf_oneof:"content"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1489,7 +2740,7 @@ type SystemMessage struct {
 
 func (x *SystemMessage) Reset() {
 	*x = SystemMessage{}
-	mi := &file_server_messages_proto_msgTypes[19]
+	mi := &file_server_messages_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1501,7 +2752,7 @@ func (x *SystemMessage) String() string {
 func (*SystemMessage) ProtoMessage() {}
 
 func (x *SystemMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_server_messages_proto_msgTypes[19]
+	mi := &file_server_messages_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,7 +2765,7 @@ func (x *SystemMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemMessage.ProtoReflect.Descriptor instead.
 func (*SystemMessage) Descriptor() ([]byte, []int) {
-	return file_server_messages_proto_rawDescGZIP(), []int{19}
+	return file_server_messages_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *SystemMessage) GetContent() isSystemMessage_Content {
@@ -1551,6 +2802,15 @@ func (x *SystemMessage) GetServerStatus() *ServerStatusMessage {
 	return nil
 }
 
+func (x *SystemMessage) GetNotifications() *NotificationsMessage {
+	if x != nil {
+		if x, ok := x.Content.(*SystemMessage_Notifications); ok {
+			return x.Notifications
+		}
+	}
+	return nil
+}
+
 type isSystemMessage_Content interface {
 	isSystemMessage_Content()
 }
@@ -1567,12 +2827,18 @@ type SystemMessage_ServerStatus struct {
 	ServerStatus *ServerStatusMessage `protobuf:"bytes,3,opt,name=server_status,json=serverStatus,proto3,oneof"`
 }
 
+type SystemMessage_Notifications struct {
+	Notifications *NotificationsMessage `protobuf:"bytes,4,opt,name=notifications,proto3,oneof"`
+}
+
 func (*SystemMessage_Connection) isSystemMessage_Content() {}
 
 func (*SystemMessage_Auth) isSystemMessage_Content() {}
 
 func (*SystemMessage_ServerStatus) isSystemMessage_Content() {}
 
+func (*SystemMessage_Notifications) isSystemMessage_Content() {}
+
 type ConnectionMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "CONNECTED", "RECONNECTING", "DISCONNECTED"
@@ -1583,7 +2849,7 @@ type ConnectionMessage struct {
 
 func (x *ConnectionMessage) Reset() {
 	*x = ConnectionMessage{}
-	mi := &file_server_messages_proto_msgTypes[20]
+	mi := &file_server_messages_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1595,7 +2861,7 @@ func (x *ConnectionMessage) String() string {
 func (*ConnectionMessage) ProtoMessage() {}
 
 func (x *ConnectionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_server_messages_proto_msgTypes[20]
+	mi := &file_server_messages_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,7 +2874,7 @@ func (x *ConnectionMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectionMessage.ProtoReflect.Descriptor instead.
 func (*ConnectionMessage) Descriptor() ([]byte, []int) {
-	return file_server_messages_proto_rawDescGZIP(), []int{20}
+	return file_server_messages_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ConnectionMessage) GetStatus() string {
@@ -1635,7 +2901,7 @@ type AuthMessage struct {
 
 func (x *AuthMessage) Reset() {
 	*x = AuthMessage{}
-	mi := &file_server_messages_proto_msgTypes[21]
+	mi := &file_server_messages_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1647,7 +2913,7 @@ func (x *AuthMessage) String() string {
 func (*AuthMessage) ProtoMessage() {}
 
 func (x *AuthMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_server_messages_proto_msgTypes[21]
+	mi := &file_server_messages_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1660,7 +2926,7 @@ func (x *AuthMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthMessage.ProtoReflect.Descriptor instead.
 func (*AuthMessage) Descriptor() ([]byte, []int) {
-	return file_server_messages_proto_rawDescGZIP(), []int{21}
+	return file_server_messages_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *AuthMessage) GetStatus() string {
@@ -1688,7 +2954,7 @@ type ServerStatusMessage struct {
 
 func (x *ServerStatusMessage) Reset() {
 	*x = ServerStatusMessage{}
-	mi := &file_server_messages_proto_msgTypes[22]
+	mi := &file_server_messages_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1700,7 +2966,7 @@ func (x *ServerStatusMessage) String() string {
 func (*ServerStatusMessage) ProtoMessage() {}
 
 func (x *ServerStatusMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_server_messages_proto_msgTypes[22]
+	mi := &file_server_messages_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1713,7 +2979,7 @@ func (x *ServerStatusMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerStatusMessage.ProtoReflect.Descriptor instead.
 func (*ServerStatusMessage) Descriptor() ([]byte, []int) {
-	return file_server_messages_proto_rawDescGZIP(), []int{22}
+	return file_server_messages_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ServerStatusMessage) GetIsMaintenance() bool {
@@ -1737,6 +3003,151 @@ func (x *ServerStatusMessage) GetPlayerCount() int32 {
 	return 0
 }
 
+// Unread inbox entries - sent when a player connects
+type NotificationsMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	UnreadCount   int32                  `protobuf:"varint,2,opt,name=unreadCount,proto3" json:"unreadCount,omitempty"` // May exceed the number of notifications sent
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationsMessage) Reset() {
+	*x = NotificationsMessage{}
+	mi := &file_server_messages_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationsMessage) ProtoMessage() {}
+
+func (x *NotificationsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationsMessage.ProtoReflect.Descriptor instead.
+func (*NotificationsMessage) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *NotificationsMessage) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *NotificationsMessage) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // "battle", "build_complete", "system_lost", "private_chat"
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	SessionId     string                 `protobuf:"bytes,5,opt,name=sessionId,proto3" json:"sessionId,omitempty"`  // Empty for notifications outside of a game
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // Unix seconds
+	Read          bool                   `protobuf:"varint,7,opt,name=read,proto3" json:"read,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_server_messages_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_server_messages_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_server_messages_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Notification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Notification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Notification) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *Notification) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
 type ErrorMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ErrorCode     string                 `protobuf:"bytes,1,opt,name=errorCode,proto3" json:"errorCode,omitempty"` // "LOBBY_FULL", "INVALID_COMMAND", etc.
@@ -1749,7 +3160,7 @@ type ErrorMessage struct {
 
 func (x *ErrorMessage) Reset() {
 	*x = ErrorMessage{}
-	mi := &file_server_messages_proto_msgTypes[23]
+	mi := &file_server_messages_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1761,7 +3172,7 @@ func (x *ErrorMessage) String() string {
 func (*ErrorMessage) ProtoMessage() {}
 
 func (x *ErrorMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_server_messages_proto_msgTypes[23]
+	mi := &file_server_messages_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1774,7 +3185,7 @@ func (x *ErrorMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrorMessage.ProtoReflect.Descriptor instead.
 func (*ErrorMessage) Descriptor() ([]byte, []int) {
-	return file_server_messages_proto_rawDescGZIP(), []int{23}
+	return file_server_messages_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ErrorMessage) GetErrorCode() string {
@@ -1830,7 +3241,7 @@ const file_server_messages_proto_rawDesc = "" +
 	"\x10settings_updated\x18\x05 \x01(\v2%.messages.LobbySettingsUpdatedMessageH\x00R\x0fsettingsUpdated\x12D\n" +
 	"\rgame_starting\x18\x06 \x01(\v2\x1d.messages.GameStartingMessageH\x00R\fgameStarting\x12A\n" +
 	"\fgame_loading\x18\a \x01(\v2\x1c.messages.GameLoadingMessageH\x00R\vgameLoadingB\t\n" +
-	"\acontent\"\xdc\x02\n" +
+	"\acontent\"\xe7\x02\n" +
 	"\x11LobbyStateMessage\x12\x1c\n" +
 	"\tsessionId\x18\x01 \x01(\tR\tsessionId\x12\x1e\n" +
 	"\n" +
@@ -1839,11 +3250,12 @@ const file_server_messages_proto_rawDesc = "" +
 	"\fhostPlayerId\x18\x03 \x01(\tR\fhostPlayerId\x12?\n" +
 	"\x06status\x18\x04 \x01(\x0e2'.messages.LobbyStateMessage.LobbyStatusR\x06status\x12/\n" +
 	"\aplayers\x18\x05 \x03(\v2\x15.messages.LobbyPlayerR\aplayers\x12<\n" +
-	"\bsettings\x18\x06 \x01(\v2 .messages.GalaxyGenerateSettingsR\bsettings\"5\n" +
+	"\bsettings\x18\x06 \x01(\v2 .messages.GalaxyGenerateSettingsR\bsettings\"@\n" +
 	"\vLobbyStatus\x12\v\n" +
 	"\aWAITING\x10\x00\x12\f\n" +
 	"\bSTARTING\x10\x01\x12\v\n" +
-	"\aIN_GAME\x10\x02\"\xd1\x01\n" +
+	"\aIN_GAME\x10\x02\x12\t\n" +
+	"\x05ENDED\x10\x03\"\xd1\x01\n" +
 	"\vLobbyPlayer\x12\x1a\n" +
 	"\bplayerId\x18\x01 \x01(\tR\bplayerId\x12 \n" +
 	"\vdisplayName\x18\x02 \x01(\tR\vdisplayName\x12\x14\n" +
@@ -1870,31 +3282,145 @@ const file_server_messages_proto_rawDesc = "" +
 	"\n" +
 	"statusText\x18\x02 \x01(\tR\n" +
 	"statusText\x12\x14\n" +
-	"\x05phase\x18\x03 \x01(\tR\x05phase\"\xd2\x01\n" +
+	"\x05phase\x18\x03 \x01(\tR\x05phase\"\x86\x03\n" +
 	"\vGameMessage\x12;\n" +
 	"\n" +
 	"game_state\x18\x01 \x01(\v2\x1a.messages.GameStateMessageH\x00R\tgameState\x12;\n" +
 	"\n" +
 	"game_event\x18\x02 \x01(\v2\x1a.messages.GameEventMessageH\x00R\tgameEvent\x12>\n" +
 	"\vturn_update\x18\x03 \x01(\v2\x1b.messages.TurnUpdateMessageH\x00R\n" +
-	"turnUpdateB\t\n" +
+	"turnUpdate\x12;\n" +
+	"\bsnapshot\x18\x04 \x01(\v2\x1d.messages.GameSnapshotMessageH\x00R\bsnapshot\x122\n" +
+	"\x05delta\x18\x05 \x01(\v2\x1a.messages.GameDeltaMessageH\x00R\x05delta\x12A\n" +
+	"\fcontrol_vote\x18\x06 \x01(\v2\x1c.messages.ControlVoteMessageH\x00R\vcontrolVoteB\t\n" +
 	"\acontent\"l\n" +
 	"\x10GameStateMessage\x12\x1c\n" +
 	"\tstateData\x18\x01 \x01(\tR\tstateData\x12\x1e\n" +
 	"\n" +
 	"turnNumber\x18\x02 \x01(\x03R\n" +
 	"turnNumber\x12\x1a\n" +
-	"\bgameTime\x18\x03 \x01(\x03R\bgameTime\"x\n" +
+	"\bgameTime\x18\x03 \x01(\x03R\bgameTime\"\x94\x01\n" +
 	"\x10GameEventMessage\x12\x1c\n" +
 	"\teventType\x18\x01 \x01(\tR\teventType\x12\x1c\n" +
 	"\teventData\x18\x02 \x01(\tR\teventData\x12(\n" +
-	"\x0faffectedPlayers\x18\x03 \x03(\tR\x0faffectedPlayers\"s\n" +
+	"\x0faffectedPlayers\x18\x03 \x03(\tR\x0faffectedPlayers\x12\x1a\n" +
+	"\bsequence\x18\x04 \x01(\x04R\bsequence\"\xe0\x02\n" +
+	"\x13GameSnapshotMessage\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12\x12\n" +
+	"\x04tick\x18\x02 \x01(\x03R\x04tick\x12\x1a\n" +
+	"\bgameTime\x18\x03 \x01(\x03R\bgameTime\x120\n" +
+	"\x06empire\x18\x04 \x01(\v2\x18.messages.EmpireSnapshotR\x06empire\x122\n" +
+	"\asystems\x18\x05 \x03(\v2\x18.messages.SystemSnapshotR\asystems\x12/\n" +
+	"\x06fleets\x18\x06 \x03(\v2\x17.messages.FleetSnapshotR\x06fleets\x12&\n" +
+	"\x04date\x18\a \x01(\v2\x12.messages.GameDateR\x04date\x12\"\n" +
+	"\fyearsPerHour\x18\b \x01(\x01R\fyearsPerHour\x12\x1a\n" +
+	"\bisPaused\x18\t \x01(\bR\bisPaused\"\xfd\x01\n" +
+	"\x0eEmpireSnapshot\x12\x1a\n" +
+	"\bempireId\x18\x01 \x01(\tR\bempireId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12\"\n" +
+	"\fhomeSystemId\x18\x04 \x01(\x04R\fhomeSystemId\x127\n" +
+	"\tresources\x18\x05 \x01(\v2\x19.messages.ResourceAmountsR\tresources\x12\"\n" +
+	"\ftechnologies\x18\x06 \x03(\tR\ftechnologies\x12$\n" +
+	"\rresearchQueue\x18\a \x03(\tR\rresearchQueue\"\x9b\x01\n" +
+	"\x0fResourceAmounts\x12\x18\n" +
+	"\acredits\x18\x01 \x01(\x03R\acredits\x12\x1a\n" +
+	"\bminerals\x18\x02 \x01(\x03R\bminerals\x12\x16\n" +
+	"\x06energy\x18\x03 \x01(\x03R\x06energy\x12\x1a\n" +
+	"\bresearch\x18\x04 \x01(\x03R\bresearch\x12\x1e\n" +
+	"\n" +
+	"population\x18\x05 \x01(\x03R\n" +
+	"population\"\x9c\x02\n" +
+	"\x0eSystemSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bstarType\x18\x03 \x01(\tR\bstarType\x12\f\n" +
+	"\x01x\x18\x04 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x05 \x01(\x01R\x01y\x12\x1e\n" +
+	"\n" +
+	"hyperlanes\x18\x06 \x03(\x04R\n" +
+	"hyperlanes\x122\n" +
+	"\aplanets\x18\a \x03(\v2\x18.messages.PlanetSnapshotR\aplanets\x12$\n" +
+	"\rownerEmpireId\x18\b \x01(\tR\rownerEmpireId\x12\x18\n" +
+	"\avisible\x18\t \x01(\bR\avisible\x12\x1a\n" +
+	"\blastSeen\x18\n" +
+	" \x01(\x03R\blastSeen\"\x98\x01\n" +
+	"\x0ePlanetSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\x12\x1e\n" +
+	"\n" +
+	"population\x18\x05 \x01(\x03R\n" +
+	"population\x12\x1a\n" +
+	"\bcolonyId\x18\x06 \x01(\x04R\bcolonyId\"\xd3\x02\n" +
+	"\rFleetSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12$\n" +
+	"\rownerEmpireId\x18\x02 \x01(\tR\rownerEmpireId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x128\n" +
+	"\x05ships\x18\x04 \x03(\v2\".messages.FleetSnapshot.ShipsEntryR\x05ships\x12\x1e\n" +
+	"\n" +
+	"locationId\x18\x05 \x01(\x04R\n" +
+	"locationId\x12$\n" +
+	"\rdestinationId\x18\x06 \x01(\x04R\rdestinationId\x12 \n" +
+	"\varrivalTime\x18\a \x01(\x01R\varrivalTime\x12\x1c\n" +
+	"\tlastKnown\x18\b \x01(\bR\tlastKnown\x1a8\n" +
+	"\n" +
+	"ShipsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xbb\x03\n" +
+	"\x10GameDeltaMessage\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12\x12\n" +
+	"\x04tick\x18\x02 \x01(\x03R\x04tick\x12<\n" +
+	"\vfleet_moved\x18\n" +
+	" \x01(\v2\x19.messages.FleetMovedDeltaH\x00R\n" +
+	"fleetMoved\x12B\n" +
+	"\rfleet_arrived\x18\v \x01(\v2\x1b.messages.FleetArrivedDeltaH\x00R\ffleetArrived\x12U\n" +
+	"\x14system_owner_changed\x18\f \x01(\v2!.messages.SystemOwnerChangedDeltaH\x00R\x12systemOwnerChanged\x12N\n" +
+	"\x11resources_changed\x18\r \x01(\v2\x1f.messages.ResourcesChangedDeltaH\x00R\x10resourcesChanged\x12E\n" +
+	"\x0evision_changed\x18\x0e \x01(\v2\x1c.messages.VisionChangedDeltaH\x00R\rvisionChangedB\a\n" +
+	"\x05delta\"\xa6\x01\n" +
+	"\x0fFleetMovedDelta\x12-\n" +
+	"\x05fleet\x18\x01 \x01(\v2\x17.messages.FleetSnapshotR\x05fleet\x12\"\n" +
+	"\ffromSystemId\x18\x02 \x01(\x04R\ffromSystemId\x12\x1e\n" +
+	"\n" +
+	"toSystemId\x18\x03 \x01(\x04R\n" +
+	"toSystemId\x12 \n" +
+	"\varrivalTime\x18\x04 \x01(\x01R\varrivalTime\"^\n" +
+	"\x11FleetArrivedDelta\x12-\n" +
+	"\x05fleet\x18\x01 \x01(\v2\x17.messages.FleetSnapshotR\x05fleet\x12\x1a\n" +
+	"\bsystemId\x18\x02 \x01(\x04R\bsystemId\"\x91\x01\n" +
+	"\x17SystemOwnerChangedDelta\x12\x1a\n" +
+	"\bsystemId\x18\x01 \x01(\x04R\bsystemId\x124\n" +
+	"\x15previousOwnerEmpireId\x18\x02 \x01(\tR\x15previousOwnerEmpireId\x12$\n" +
+	"\rownerEmpireId\x18\x03 \x01(\tR\rownerEmpireId\"\x83\x01\n" +
+	"\x15ResourcesChangedDelta\x127\n" +
+	"\tresources\x18\x01 \x01(\v2\x19.messages.ResourceAmountsR\tresources\x121\n" +
+	"\x06income\x18\x02 \x01(\v2\x19.messages.ResourceAmountsR\x06income\"\x93\x01\n" +
+	"\x12VisionChangedDelta\x124\n" +
+	"\brevealed\x18\x01 \x03(\v2\x18.messages.SystemSnapshotR\brevealed\x12/\n" +
+	"\x06fleets\x18\x02 \x03(\v2\x17.messages.FleetSnapshotR\x06fleets\x12\x16\n" +
+	"\x06hidden\x18\x03 \x03(\x04R\x06hidden\"\xe1\x01\n" +
 	"\x11TurnUpdateMessage\x12\x1e\n" +
 	"\n" +
 	"turnNumber\x18\x01 \x01(\x03R\n" +
 	"turnNumber\x12\"\n" +
 	"\fturnDeadline\x18\x02 \x01(\x03R\fturnDeadline\x12\x1a\n" +
-	"\bisPaused\x18\x03 \x01(\bR\bisPaused\"\xda\x02\n" +
+	"\bisPaused\x18\x03 \x01(\bR\bisPaused\x12&\n" +
+	"\x04date\x18\x04 \x01(\v2\x12.messages.GameDateR\x04date\x12\"\n" +
+	"\fyearsPerHour\x18\x05 \x01(\x01R\fyearsPerHour\x12 \n" +
+	"\vpauseReason\x18\x06 \x01(\tR\vpauseReason\"\x9e\x01\n" +
+	"\x12ControlVoteMessage\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\"\n" +
+	"\fyearsPerHour\x18\x02 \x01(\x01R\fyearsPerHour\x12\x14\n" +
+	"\x05votes\x18\x03 \x01(\x05R\x05votes\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\x05R\brequired\x12\x1a\n" +
+	"\bplayerId\x18\x05 \x01(\tR\bplayerId\"h\n" +
+	"\bGameDate\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x10\n" +
+	"\x03day\x18\x03 \x01(\x05R\x03day\x12 \n" +
+	"\velapsedDays\x18\x04 \x01(\x01R\velapsedDays\"\xda\x02\n" +
 	"\vChatMessage\x12\x1a\n" +
 	"\bsenderId\x18\x01 \x01(\tR\bsenderId\x12,\n" +
 	"\x11senderDisplayName\x18\x02 \x01(\tR\x11senderDisplayName\x12\x1c\n" +
@@ -1914,13 +3440,14 @@ const file_server_messages_proto_rawDesc = "" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"O\n" +
 	"\x11SystemChatMessage\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12 \n" +
-	"\vmessageType\x18\x02 \x01(\tR\vmessageType\"\xcc\x01\n" +
+	"\vmessageType\x18\x02 \x01(\tR\vmessageType\"\x94\x02\n" +
 	"\rSystemMessage\x12=\n" +
 	"\n" +
 	"connection\x18\x01 \x01(\v2\x1b.messages.ConnectionMessageH\x00R\n" +
 	"connection\x12+\n" +
 	"\x04auth\x18\x02 \x01(\v2\x15.messages.AuthMessageH\x00R\x04auth\x12D\n" +
-	"\rserver_status\x18\x03 \x01(\v2\x1d.messages.ServerStatusMessageH\x00R\fserverStatusB\t\n" +
+	"\rserver_status\x18\x03 \x01(\v2\x1d.messages.ServerStatusMessageH\x00R\fserverStatus\x12F\n" +
+	"\rnotifications\x18\x04 \x01(\v2\x1e.messages.NotificationsMessageH\x00R\rnotificationsB\t\n" +
 	"\acontent\"C\n" +
 	"\x11ConnectionMessage\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x16\n" +
@@ -1931,7 +3458,18 @@ const file_server_messages_proto_rawDesc = "" +
 	"\x13ServerStatusMessage\x12$\n" +
 	"\risMaintenance\x18\x01 \x01(\bR\risMaintenance\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12 \n" +
-	"\vplayerCount\x18\x03 \x01(\x05R\vplayerCount\"\x84\x01\n" +
+	"\vplayerCount\x18\x03 \x01(\x05R\vplayerCount\"v\n" +
+	"\x14NotificationsMessage\x12<\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x16.messages.NotificationR\rnotifications\x12 \n" +
+	"\vunreadCount\x18\x02 \x01(\x05R\vunreadCount\"\xac\x01\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x1c\n" +
+	"\tsessionId\x18\x05 \x01(\tR\tsessionId\x12\x1c\n" +
+	"\tcreatedAt\x18\x06 \x01(\x03R\tcreatedAt\x12\x12\n" +
+	"\x04read\x18\a \x01(\bR\x04read\"\x84\x01\n" +
 	"\fErrorMessage\x12\x1c\n" +
 	"\terrorCode\x18\x01 \x01(\tR\terrorCode\x12\"\n" +
 	"\ferrorMessage\x18\x02 \x01(\tR\ferrorMessage\x12\x18\n" +
@@ -1951,7 +3489,7 @@ func file_server_messages_proto_rawDescGZIP() []byte {
 }
 
 var file_server_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_server_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_server_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_server_messages_proto_goTypes = []any{
 	(LobbyStateMessage_LobbyStatus)(0),  // 0: messages.LobbyStateMessage.LobbyStatus
 	(*ServerMessage)(nil),               // 1: messages.ServerMessage
@@ -1967,25 +3505,42 @@ var file_server_messages_proto_goTypes = []any{
 	(*GameMessage)(nil),                 // 11: messages.GameMessage
 	(*GameStateMessage)(nil),            // 12: messages.GameStateMessage
 	(*GameEventMessage)(nil),            // 13: messages.GameEventMessage
-	(*TurnUpdateMessage)(nil),           // 14: messages.TurnUpdateMessage
-	(*ChatMessage)(nil),                 // 15: messages.ChatMessage
-	(*GlobalChatMessage)(nil),           // 16: messages.GlobalChatMessage
-	(*PrivateChatMessage)(nil),          // 17: messages.PrivateChatMessage
-	(*LobbyChatMessage)(nil),            // 18: messages.LobbyChatMessage
-	(*SystemChatMessage)(nil),           // 19: messages.SystemChatMessage
-	(*SystemMessage)(nil),               // 20: messages.SystemMessage
-	(*ConnectionMessage)(nil),           // 21: messages.ConnectionMessage
-	(*AuthMessage)(nil),                 // 22: messages.AuthMessage
-	(*ServerStatusMessage)(nil),         // 23: messages.ServerStatusMessage
-	(*ErrorMessage)(nil),                // 24: messages.ErrorMessage
-	(*GalaxyGenerateSettings)(nil),      // 25: messages.GalaxyGenerateSettings
+	(*GameSnapshotMessage)(nil),         // 14: messages.GameSnapshotMessage
+	(*EmpireSnapshot)(nil),              // 15: messages.EmpireSnapshot
+	(*ResourceAmounts)(nil),             // 16: messages.ResourceAmounts
+	(*SystemSnapshot)(nil),              // 17: messages.SystemSnapshot
+	(*PlanetSnapshot)(nil),              // 18: messages.PlanetSnapshot
+	(*FleetSnapshot)(nil),               // 19: messages.FleetSnapshot
+	(*GameDeltaMessage)(nil),            // 20: messages.GameDeltaMessage
+	(*FleetMovedDelta)(nil),             // 21: messages.FleetMovedDelta
+	(*FleetArrivedDelta)(nil),           // 22: messages.FleetArrivedDelta
+	(*SystemOwnerChangedDelta)(nil),     // 23: messages.SystemOwnerChangedDelta
+	(*ResourcesChangedDelta)(nil),       // 24: messages.ResourcesChangedDelta
+	(*VisionChangedDelta)(nil),          // 25: messages.VisionChangedDelta
+	(*TurnUpdateMessage)(nil),           // 26: messages.TurnUpdateMessage
+	(*ControlVoteMessage)(nil),          // 27: messages.ControlVoteMessage
+	(*GameDate)(nil),                    // 28: messages.GameDate
+	(*ChatMessage)(nil),                 // 29: messages.ChatMessage
+	(*GlobalChatMessage)(nil),           // 30: messages.GlobalChatMessage
+	(*PrivateChatMessage)(nil),          // 31: messages.PrivateChatMessage
+	(*LobbyChatMessage)(nil),            // 32: messages.LobbyChatMessage
+	(*SystemChatMessage)(nil),           // 33: messages.SystemChatMessage
+	(*SystemMessage)(nil),               // 34: messages.SystemMessage
+	(*ConnectionMessage)(nil),           // 35: messages.ConnectionMessage
+	(*AuthMessage)(nil),                 // 36: messages.AuthMessage
+	(*ServerStatusMessage)(nil),         // 37: messages.ServerStatusMessage
+	(*NotificationsMessage)(nil),        // 38: messages.NotificationsMessage
+	(*Notification)(nil),                // 39: messages.Notification
+	(*ErrorMessage)(nil),                // 40: messages.ErrorMessage
+	nil,                                 // 41: messages.FleetSnapshot.ShipsEntry
+	(*GalaxyGenerateSettings)(nil),      // 42: messages.GalaxyGenerateSettings
 }
 var file_server_messages_proto_depIdxs = []int32{
 	2,  // 0: messages.ServerMessage.lobbyMessage:type_name -> messages.LobbyMessage
 	11, // 1: messages.ServerMessage.gameMessage:type_name -> messages.GameMessage
-	15, // 2: messages.ServerMessage.chatMessage:type_name -> messages.ChatMessage
-	20, // 3: messages.ServerMessage.systemMessage:type_name -> messages.SystemMessage
-	24, // 4: messages.ServerMessage.errorMessage:type_name -> messages.ErrorMessage
+	29, // 2: messages.ServerMessage.chatMessage:type_name -> messages.ChatMessage
+	34, // 3: messages.ServerMessage.systemMessage:type_name -> messages.SystemMessage
+	40, // 4: messages.ServerMessage.errorMessage:type_name -> messages.ErrorMessage
 	3,  // 5: messages.LobbyMessage.lobby_state:type_name -> messages.LobbyStateMessage
 	5,  // 6: messages.LobbyMessage.player_joined:type_name -> messages.PlayerJoinedMessage
 	6,  // 7: messages.LobbyMessage.player_left:type_name -> messages.PlayerLeftMessage
@@ -1995,26 +3550,50 @@ var file_server_messages_proto_depIdxs = []int32{
 	10, // 11: messages.LobbyMessage.game_loading:type_name -> messages.GameLoadingMessage
 	0,  // 12: messages.LobbyStateMessage.status:type_name -> messages.LobbyStateMessage.LobbyStatus
 	4,  // 13: messages.LobbyStateMessage.players:type_name -> messages.LobbyPlayer
-	25, // 14: messages.LobbyStateMessage.settings:type_name -> messages.GalaxyGenerateSettings
+	42, // 14: messages.LobbyStateMessage.settings:type_name -> messages.GalaxyGenerateSettings
 	4,  // 15: messages.PlayerJoinedMessage.player:type_name -> messages.LobbyPlayer
 	4,  // 16: messages.PlayerUpdatedMessage.player:type_name -> messages.LobbyPlayer
-	25, // 17: messages.LobbySettingsUpdatedMessage.settings:type_name -> messages.GalaxyGenerateSettings
-	25, // 18: messages.GameStartingMessage.finalSettings:type_name -> messages.GalaxyGenerateSettings
+	42, // 17: messages.LobbySettingsUpdatedMessage.settings:type_name -> messages.GalaxyGenerateSettings
+	42, // 18: messages.GameStartingMessage.finalSettings:type_name -> messages.GalaxyGenerateSettings
 	12, // 19: messages.GameMessage.game_state:type_name -> messages.GameStateMessage
 	13, // 20: messages.GameMessage.game_event:type_name -> messages.GameEventMessage
-	14, // 21: messages.GameMessage.turn_update:type_name -> messages.TurnUpdateMessage
-	16, // 22: messages.ChatMessage.global:type_name -> messages.GlobalChatMessage
-	17, // 23: messages.ChatMessage.private:type_name -> messages.PrivateChatMessage
-	18, // 24: messages.ChatMessage.lobby:type_name -> messages.LobbyChatMessage
-	19, // 25: messages.ChatMessage.system:type_name -> messages.SystemChatMessage
-	21, // 26: messages.SystemMessage.connection:type_name -> messages.ConnectionMessage
-	22, // 27: messages.SystemMessage.auth:type_name -> messages.AuthMessage
-	23, // 28: messages.SystemMessage.server_status:type_name -> messages.ServerStatusMessage
-	29, // [29:29] is the sub-list for method output_type
-	29, // [29:29] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	26, // 21: messages.GameMessage.turn_update:type_name -> messages.TurnUpdateMessage
+	14, // 22: messages.GameMessage.snapshot:type_name -> messages.GameSnapshotMessage
+	20, // 23: messages.GameMessage.delta:type_name -> messages.GameDeltaMessage
+	27, // 24: messages.GameMessage.control_vote:type_name -> messages.ControlVoteMessage
+	15, // 25: messages.GameSnapshotMessage.empire:type_name -> messages.EmpireSnapshot
+	17, // 26: messages.GameSnapshotMessage.systems:type_name -> messages.SystemSnapshot
+	19, // 27: messages.GameSnapshotMessage.fleets:type_name -> messages.FleetSnapshot
+	28, // 28: messages.GameSnapshotMessage.date:type_name -> messages.GameDate
+	16, // 29: messages.EmpireSnapshot.resources:type_name -> messages.ResourceAmounts
+	18, // 30: messages.SystemSnapshot.planets:type_name -> messages.PlanetSnapshot
+	41, // 31: messages.FleetSnapshot.ships:type_name -> messages.FleetSnapshot.ShipsEntry
+	21, // 32: messages.GameDeltaMessage.fleet_moved:type_name -> messages.FleetMovedDelta
+	22, // 33: messages.GameDeltaMessage.fleet_arrived:type_name -> messages.FleetArrivedDelta
+	23, // 34: messages.GameDeltaMessage.system_owner_changed:type_name -> messages.SystemOwnerChangedDelta
+	24, // 35: messages.GameDeltaMessage.resources_changed:type_name -> messages.ResourcesChangedDelta
+	25, // 36: messages.GameDeltaMessage.vision_changed:type_name -> messages.VisionChangedDelta
+	19, // 37: messages.FleetMovedDelta.fleet:type_name -> messages.FleetSnapshot
+	19, // 38: messages.FleetArrivedDelta.fleet:type_name -> messages.FleetSnapshot
+	16, // 39: messages.ResourcesChangedDelta.resources:type_name -> messages.ResourceAmounts
+	16, // 40: messages.ResourcesChangedDelta.income:type_name -> messages.ResourceAmounts
+	17, // 41: messages.VisionChangedDelta.revealed:type_name -> messages.SystemSnapshot
+	19, // 42: messages.VisionChangedDelta.fleets:type_name -> messages.FleetSnapshot
+	28, // 43: messages.TurnUpdateMessage.date:type_name -> messages.GameDate
+	30, // 44: messages.ChatMessage.global:type_name -> messages.GlobalChatMessage
+	31, // 45: messages.ChatMessage.private:type_name -> messages.PrivateChatMessage
+	32, // 46: messages.ChatMessage.lobby:type_name -> messages.LobbyChatMessage
+	33, // 47: messages.ChatMessage.system:type_name -> messages.SystemChatMessage
+	35, // 48: messages.SystemMessage.connection:type_name -> messages.ConnectionMessage
+	36, // 49: messages.SystemMessage.auth:type_name -> messages.AuthMessage
+	37, // 50: messages.SystemMessage.server_status:type_name -> messages.ServerStatusMessage
+	38, // 51: messages.SystemMessage.notifications:type_name -> messages.NotificationsMessage
+	39, // 52: messages.NotificationsMessage.notifications:type_name -> messages.Notification
+	53, // [53:53] is the sub-list for method output_type
+	53, // [53:53] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_server_messages_proto_init() }
@@ -2043,17 +3622,28 @@ func file_server_messages_proto_init() {
 		(*GameMessage_GameState)(nil),
 		(*GameMessage_GameEvent)(nil),
 		(*GameMessage_TurnUpdate)(nil),
+		(*GameMessage_Snapshot)(nil),
+		(*GameMessage_Delta)(nil),
+		(*GameMessage_ControlVote)(nil),
+	}
+	file_server_messages_proto_msgTypes[19].OneofWrappers = []any{
+		(*GameDeltaMessage_FleetMoved)(nil),
+		(*GameDeltaMessage_FleetArrived)(nil),
+		(*GameDeltaMessage_SystemOwnerChanged)(nil),
+		(*GameDeltaMessage_ResourcesChanged)(nil),
+		(*GameDeltaMessage_VisionChanged)(nil),
 	}
-	file_server_messages_proto_msgTypes[14].OneofWrappers = []any{
+	file_server_messages_proto_msgTypes[28].OneofWrappers = []any{
 		(*ChatMessage_Global)(nil),
 		(*ChatMessage_Private)(nil),
 		(*ChatMessage_Lobby)(nil),
 		(*ChatMessage_System)(nil),
 	}
-	file_server_messages_proto_msgTypes[19].OneofWrappers = []any{
+	file_server_messages_proto_msgTypes[33].OneofWrappers = []any{
 		(*SystemMessage_Connection)(nil),
 		(*SystemMessage_Auth)(nil),
 		(*SystemMessage_ServerStatus)(nil),
+		(*SystemMessage_Notifications)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2061,7 +3651,7 @@ func file_server_messages_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_server_messages_proto_rawDesc), len(file_server_messages_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   24,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,17 @@
+-- chat_messages.sql
+
+CREATE TABLE chat_messages (
+    id UUID PRIMARY KEY,
+    scope TEXT NOT NULL,
+    session_id UUID,
+    sender_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    sender_name TEXT NOT NULL,
+    recipient_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    message TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_chat_messages_session_id_created_at ON chat_messages(session_id, created_at DESC) WHERE scope = 'lobby';
+CREATE INDEX idx_chat_messages_sender_id_created_at ON chat_messages(sender_id, created_at DESC) WHERE scope = 'private';
+CREATE INDEX idx_chat_messages_recipient_id_created_at ON chat_messages(recipient_id, created_at DESC) WHERE scope = 'private';
+CREATE INDEX idx_chat_messages_global_created_at ON chat_messages(created_at DESC) WHERE scope = 'global';
@@ -0,0 +1,7 @@
+DROP TABLE build_items;
+DROP TABLE fleets;
+DROP TABLE colonies;
+DROP TABLE empires;
+DROP TABLE galaxies;
+DROP TABLE session_players;
+DROP TABLE game_sessions;
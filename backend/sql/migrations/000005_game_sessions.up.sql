@@ -0,0 +1,100 @@
+-- game_sessions.sql
+
+CREATE TABLE game_sessions (
+    id UUID PRIMARY KEY,
+    invite_code TEXT NOT NULL UNIQUE,
+    state TEXT NOT NULL,
+    host_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    settings JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    checkpointed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE session_players (
+    session_id UUID NOT NULL REFERENCES game_sessions(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    empire_id UUID NOT NULL,
+    color TEXT NOT NULL DEFAULT '',
+    ready BOOLEAN NOT NULL DEFAULT FALSE,
+    joined_at TIMESTAMPTZ NOT NULL,
+    last_seen TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (session_id, user_id)
+);
+
+-- The generated galaxy and the world state that is not kept per empire
+CREATE TABLE galaxies (
+    session_id UUID PRIMARY KEY REFERENCES game_sessions(id) ON DELETE CASCADE,
+    map JSONB NOT NULL,
+    systems JSONB NOT NULL,
+    battles JSONB NOT NULL,
+    refs JSONB NOT NULL,
+    turn INTEGER NOT NULL DEFAULT 0,
+    game_time TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE empires (
+    id UUID PRIMARY KEY,
+    session_id UUID NOT NULL REFERENCES game_sessions(id) ON DELETE CASCADE,
+    player_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    color TEXT NOT NULL DEFAULT '',
+    home_system UUID NOT NULL,
+    systems JSONB NOT NULL,
+    resources JSONB NOT NULL,
+    income JSONB NOT NULL,
+    technologies JSONB NOT NULL,
+    research JSONB NOT NULL,
+    intel JSONB NOT NULL
+);
+
+CREATE TABLE colonies (
+    id UUID PRIMARY KEY,
+    ref BIGINT NOT NULL,
+    session_id UUID NOT NULL REFERENCES game_sessions(id) ON DELETE CASCADE,
+    owner UUID NOT NULL,
+    name TEXT NOT NULL,
+    system_id UUID NOT NULL,
+    planet_id UUID NOT NULL,
+    population BIGINT NOT NULL,
+    max_population BIGINT NOT NULL,
+    industry_slots INTEGER NOT NULL,
+    growth DOUBLE PRECISION NOT NULL
+);
+
+CREATE TABLE fleets (
+    id UUID PRIMARY KEY,
+    ref BIGINT NOT NULL,
+    session_id UUID NOT NULL REFERENCES game_sessions(id) ON DELETE CASCADE,
+    owner UUID NOT NULL,
+    name TEXT NOT NULL,
+    ships JSONB NOT NULL,
+    location UUID NOT NULL,
+    destination UUID,
+    arrival_time BIGINT,
+    path JSONB NOT NULL
+);
+
+-- Items are kept in the funding order of the empire queue and the build order
+-- of the colony queue
+CREATE TABLE build_items (
+    id UUID PRIMARY KEY,
+    ref BIGINT NOT NULL,
+    session_id UUID NOT NULL REFERENCES game_sessions(id) ON DELETE CASCADE,
+    empire_id UUID NOT NULL,
+    colony_id UUID NOT NULL,
+    empire_position INTEGER NOT NULL,
+    colony_position INTEGER NOT NULL,
+    kind TEXT NOT NULL,
+    type TEXT NOT NULL,
+    quantity INTEGER NOT NULL,
+    cost JSONB NOT NULL,
+    build_time DOUBLE PRECISION NOT NULL,
+    progress DOUBLE PRECISION NOT NULL,
+    funded BOOLEAN NOT NULL
+);
+
+CREATE INDEX idx_session_players_user_id ON session_players(user_id);
+CREATE INDEX idx_empires_session_id ON empires(session_id);
+CREATE INDEX idx_colonies_session_id ON colonies(session_id);
+CREATE INDEX idx_fleets_session_id ON fleets(session_id);
+CREATE INDEX idx_build_items_session_id ON build_items(session_id);
@@ -0,0 +1,8 @@
+UPDATE build_items SET build_time = build_time * 10, progress = progress * 10;
+
+ALTER TABLE fleets ALTER COLUMN arrival_time TYPE BIGINT
+    USING CASE WHEN arrival_time IS NULL THEN NULL ELSE EXTRACT(EPOCH FROM NOW())::BIGINT END;
+
+ALTER TABLE galaxies DROP COLUMN years_per_hour;
+ALTER TABLE galaxies DROP COLUMN game_days;
+ALTER TABLE galaxies ADD COLUMN game_time TIMESTAMPTZ NOT NULL DEFAULT NOW();
@@ -0,0 +1,12 @@
+-- The game clock runs in in-game days at a rate set per session
+ALTER TABLE galaxies DROP COLUMN game_time;
+ALTER TABLE galaxies ADD COLUMN game_days DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE galaxies ADD COLUMN years_per_hour DOUBLE PRECISION NOT NULL DEFAULT 1;
+
+-- Fleets in transit arrive right away, their unix arrival times have no
+-- in-game equivalent
+ALTER TABLE fleets ALTER COLUMN arrival_time TYPE DOUBLE PRECISION
+    USING CASE WHEN arrival_time IS NULL THEN NULL ELSE 0 END;
+
+-- Build times were stored in seconds at ten seconds per in-game day
+UPDATE build_items SET build_time = build_time / 10, progress = progress / 10;
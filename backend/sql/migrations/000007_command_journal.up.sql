@@ -0,0 +1,14 @@
+-- command_journal.sql
+
+-- Append-only journal of the commands of every game, for replays. There is no
+-- foreign key, the journal of a game is kept after its session is deleted.
+CREATE TABLE command_journal (
+    seq BIGSERIAL PRIMARY KEY,
+    session_id UUID NOT NULL,
+    tick INTEGER NOT NULL,
+    kind TEXT NOT NULL,
+    entry JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_command_journal_session_id_seq ON command_journal(session_id, seq);
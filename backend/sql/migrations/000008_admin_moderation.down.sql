@@ -0,0 +1,2 @@
+ALTER TABLE game_sessions DROP COLUMN banned;
+ALTER TABLE users DROP COLUMN role;
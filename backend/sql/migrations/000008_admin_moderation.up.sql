@@ -0,0 +1,6 @@
+-- Admins can inspect and moderate every session. Roles are granted in the
+-- database, e.g. UPDATE users SET role = 'admin' WHERE email = '...'
+ALTER TABLE users ADD COLUMN role TEXT NOT NULL DEFAULT 'player';
+
+-- Players banned from a session cannot join it again
+ALTER TABLE game_sessions ADD COLUMN banned JSONB NOT NULL DEFAULT '[]';
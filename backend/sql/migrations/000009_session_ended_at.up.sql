@@ -0,0 +1,3 @@
+-- Ended sessions are cleaned up some time after they ended, not after they
+-- were created
+ALTER TABLE game_sessions ADD COLUMN ended_at TIMESTAMPTZ;
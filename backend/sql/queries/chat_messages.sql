@@ -0,0 +1,22 @@
+-- name: CreateChatMessage :one
+INSERT INTO chat_messages (id, scope, session_id, sender_id, sender_name, recipient_id, message)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: ListLobbyChatMessages :many
+SELECT * FROM chat_messages
+WHERE scope = 'lobby' AND session_id = $1
+ORDER BY created_at DESC
+LIMIT $2;
+
+-- name: ListPrivateChatMessages :many
+SELECT * FROM chat_messages
+WHERE scope = 'private' AND (sender_id = sqlc.arg(user_id) OR recipient_id = sqlc.arg(user_id))
+ORDER BY created_at DESC
+LIMIT sqlc.arg('limit');
+
+-- name: ListGlobalChatMessages :many
+SELECT * FROM chat_messages
+WHERE scope = 'global'
+ORDER BY created_at DESC
+LIMIT $1;
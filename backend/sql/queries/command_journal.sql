@@ -0,0 +1,8 @@
+-- name: CreateJournalEntry :exec
+INSERT INTO command_journal (session_id, tick, kind, entry)
+VALUES ($1, $2, $3, $4);
+
+-- name: ListJournalEntries :many
+SELECT entry FROM command_journal
+WHERE session_id = $1
+ORDER BY seq;
@@ -0,0 +1,95 @@
+-- name: UpsertGameSession :exec
+INSERT INTO game_sessions (id, invite_code, state, host_id, settings, created_at, banned, ended_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (id) DO UPDATE
+SET state = EXCLUDED.state,
+    host_id = EXCLUDED.host_id,
+    settings = EXCLUDED.settings,
+    banned = EXCLUDED.banned,
+    ended_at = EXCLUDED.ended_at,
+    checkpointed_at = now();
+
+-- name: ListGameSessions :many
+SELECT * FROM game_sessions
+ORDER BY created_at;
+
+-- name: DeleteGameSession :exec
+DELETE FROM game_sessions WHERE id = $1;
+
+-- name: CreateSessionPlayer :exec
+INSERT INTO session_players (session_id, user_id, empire_id, color, ready, joined_at, last_seen)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: ListSessionPlayers :many
+SELECT sp.*, u.email, u.display_name
+FROM session_players sp
+JOIN users u ON u.id = sp.user_id
+WHERE sp.session_id = $1
+ORDER BY sp.joined_at;
+
+-- name: DeleteSessionPlayers :exec
+DELETE FROM session_players WHERE session_id = $1;
+
+-- name: UpsertGalaxy :exec
+INSERT INTO galaxies (session_id, map, systems, battles, refs, turn, game_days, years_per_hour)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (session_id) DO UPDATE
+SET map = EXCLUDED.map,
+    systems = EXCLUDED.systems,
+    battles = EXCLUDED.battles,
+    refs = EXCLUDED.refs,
+    turn = EXCLUDED.turn,
+    game_days = EXCLUDED.game_days,
+    years_per_hour = EXCLUDED.years_per_hour;
+
+-- name: GetGalaxy :one
+SELECT * FROM galaxies WHERE session_id = $1;
+
+-- name: DeleteGalaxy :exec
+DELETE FROM galaxies WHERE session_id = $1;
+
+-- name: CreateEmpire :exec
+INSERT INTO empires (id, session_id, player_id, name, color, home_system, systems, resources, income, technologies, research, intel)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+
+-- name: ListEmpires :many
+SELECT * FROM empires WHERE session_id = $1;
+
+-- name: DeleteEmpires :exec
+DELETE FROM empires WHERE session_id = $1;
+
+-- name: CreateColony :exec
+INSERT INTO colonies (id, ref, session_id, owner, name, system_id, planet_id, population, max_population, industry_slots, growth)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+
+-- name: ListColonies :many
+SELECT * FROM colonies
+WHERE session_id = $1
+ORDER BY ref;
+
+-- name: DeleteColonies :exec
+DELETE FROM colonies WHERE session_id = $1;
+
+-- name: CreateFleet :exec
+INSERT INTO fleets (id, ref, session_id, owner, name, ships, location, destination, arrival_time, path)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: ListFleets :many
+SELECT * FROM fleets
+WHERE session_id = $1
+ORDER BY ref;
+
+-- name: DeleteFleets :exec
+DELETE FROM fleets WHERE session_id = $1;
+
+-- name: CreateBuildItem :exec
+INSERT INTO build_items (id, ref, session_id, empire_id, colony_id, empire_position, colony_position, kind, type, quantity, cost, build_time, progress, funded)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14);
+
+-- name: ListBuildItems :many
+SELECT * FROM build_items
+WHERE session_id = $1
+ORDER BY empire_position;
+
+-- name: DeleteBuildItems :exec
+DELETE FROM build_items WHERE session_id = $1;
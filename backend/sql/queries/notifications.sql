@@ -0,0 +1,34 @@
+-- name: CreateNotification :one
+INSERT INTO notifications (id, user_id, session_id, kind, title, body)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: ListNotifications :many
+SELECT * FROM notifications
+WHERE user_id = $1
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3;
+
+-- name: ListUnreadNotifications :many
+SELECT * FROM notifications
+WHERE user_id = $1 AND NOT read
+ORDER BY created_at DESC
+LIMIT $2;
+
+-- name: CountUnreadNotifications :one
+SELECT count(*) FROM notifications
+WHERE user_id = $1 AND NOT read;
+
+-- name: MarkNotificationRead :execrows
+UPDATE notifications
+SET read = TRUE
+WHERE id = $1 AND user_id = $2;
+
+-- name: MarkAllNotificationsRead :exec
+UPDATE notifications
+SET read = TRUE
+WHERE user_id = $1 AND NOT read;
+
+-- name: DeleteReadNotificationsBefore :exec
+DELETE FROM notifications
+WHERE read AND created_at < $1;
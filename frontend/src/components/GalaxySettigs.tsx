@@ -37,7 +37,10 @@ export class GalaxySettings extends React.Component<GalaxySettingsProps> {
               className="p-2 rounded bg-[#222] text-white border border-[#333] focus:outline-none focus:ring-2 focus:ring-blue-500"
             >
               <option value="spiral">Spiral</option>
+              <option value="barred_spiral">Barred Spiral</option>
               <option value="elliptical">Elliptical</option>
+              <option value="ring">Ring</option>
+              <option value="clustered">Clustered</option>
               <option value="irregular">Irregular</option>
             </select>
           </label>
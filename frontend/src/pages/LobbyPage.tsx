@@ -334,7 +334,10 @@ export const LobbyPage = () => {
                     className="w-full px-3 py-2 bg-white/10 border border-white/20 rounded text-white"
                   >
                     <option value="spiral">Spiral</option>
+                    <option value="barred_spiral">Barred Spiral</option>
                     <option value="elliptical">Elliptical</option>
+                    <option value="ring">Ring</option>
+                    <option value="clustered">Clustered</option>
                     <option value="irregular">Irregular</option>
                   </select>
                 </div>
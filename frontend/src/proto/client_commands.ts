@@ -24,6 +24,11 @@ export interface ClientCommand {
   /** Chat Commands */
   chatCommand?: ChatCommand | undefined;
   pingCommand?: PingCommand | undefined;
+  resyncCommand?:
+    | ResyncCommand
+    | undefined;
+  /** Pause, resume and speed of a running game */
+  controlCommand?: GameControlCommand | undefined;
 }
 
 export interface LobbyCommand {
@@ -61,11 +66,15 @@ export interface StartGameCommand {
 
 export interface GameCommand {
   moveFleet?: MoveFleetCommand | undefined;
-  queueConstruction?:
-    | QueueConstructionCommand
+  queueConstruction?: QueueConstructionCommand | undefined;
+  queueFleetConstruction?: QueueFleetConstructionCommand | undefined;
+  reorderBuildQueue?: ReorderBuildQueueCommand | undefined;
+  cancelBuildItem?: CancelBuildItemCommand | undefined;
+  colonizePlanet?:
+    | ColonizePlanetCommand
     | undefined;
   /** Add more game commands as needed */
-  queueFleetConstruction?: QueueFleetConstructionCommand | undefined;
+  queueResearch?: QueueResearchCommand | undefined;
 }
 
 export interface MoveFleetCommand {
@@ -85,6 +94,52 @@ export interface QueueFleetConstructionCommand {
   quantity: number;
 }
 
+export interface ReorderBuildQueueCommand {
+  itemId: number;
+  position: number;
+  /** Move the item in the empire-wide funding queue instead of its colony queue */
+  empireQueue: boolean;
+}
+
+export interface CancelBuildItemCommand {
+  itemId: number;
+}
+
+export interface ColonizePlanetCommand {
+  /** Fleet with a colony ship in the planet's system */
+  fleetId: number;
+  planetId: number;
+}
+
+export interface QueueResearchCommand {
+  technology: string;
+  /** Remove the technology from the research queue instead */
+  remove: boolean;
+}
+
+/**
+ * Sent by the host to pause, resume or change the speed of the game right
+ * away. When voting is enabled in the settings, the same commands from other
+ * players count as their vote and take effect once a majority of the
+ * connected players agrees.
+ */
+export interface GameControlCommand {
+  pause?: PauseGameCommand | undefined;
+  resume?: ResumeGameCommand | undefined;
+  setSpeed?: SetGameSpeedCommand | undefined;
+}
+
+export interface PauseGameCommand {
+}
+
+export interface ResumeGameCommand {
+}
+
+export interface SetGameSpeedCommand {
+  /** In-game years per real hour */
+  yearsPerHour: number;
+}
+
 export interface ChatCommand {
   global?: GlobalChatCommand | undefined;
   private?: PrivateChatCommand | undefined;
@@ -109,6 +164,116 @@ export interface GalaxyGenerateSettings {
   shape: string;
   maxHyperlanes: number;
   hyperlaneConnectivity: number;
+  /** 0 picks a random seed when the game starts */
+  seed: number;
+  /** In-game years per real hour, 0 for the default */
+  yearsPerHour: number;
+  /** Players other than the host can pause, resume and change the speed by majority vote */
+  controlVoting: boolean;
+  /** Only the parameters of the selected shape are used */
+  shapeParams: GalaxyShapeParams | undefined;
+}
+
+/**
+ * Tunable parameters of every galaxy shape. Parameters left unset use the
+ * defaults.
+ */
+export interface GalaxyShapeParams {
+  spiral: SpiralShapeParams | undefined;
+  elliptical: EllipticalShapeParams | undefined;
+  ring: RingShapeParams | undefined;
+  barredSpiral: BarredSpiralShapeParams | undefined;
+  clustered: ClusteredShapeParams | undefined;
+  irregular: IrregularShapeParams | undefined;
+}
+
+export interface SpiralShapeParams {
+  numArms?: number | undefined;
+  armSpread?:
+    | number
+    | undefined;
+  /** Radius of the core relative to the galaxy radius */
+  minRadius?:
+    | number
+    | undefined;
+  /** Number of half turns the arms make */
+  twist?:
+    | number
+    | undefined;
+  /** Probability to place a star between the arms */
+  interarmChance?: number | undefined;
+}
+
+export interface EllipticalShapeParams {
+  /** Minor axis relative to the major axis */
+  axisRatio?:
+    | number
+    | undefined;
+  /** 1 spreads stars evenly, higher values pack them towards the core */
+  concentration?: number | undefined;
+}
+
+export interface RingShapeParams {
+  /** Radius of the empty centre relative to the outer radius */
+  innerRadius?: number | undefined;
+}
+
+export interface BarredSpiralShapeParams {
+  numArms?:
+    | number
+    | undefined;
+  /** Half length of the bar relative to the galaxy radius */
+  barLength?:
+    | number
+    | undefined;
+  /** Half width of the bar */
+  barWidth?:
+    | number
+    | undefined;
+  /** Fraction of the stars in the bar */
+  barFraction?: number | undefined;
+  armSpread?:
+    | number
+    | undefined;
+  /** Number of half turns the arms make */
+  twist?:
+    | number
+    | undefined;
+  /** Fraction of the stars spread over the disk between the arms */
+  diskFraction?: number | undefined;
+}
+
+export interface ClusteredShapeParams {
+  numClusters?:
+    | number
+    | undefined;
+  /** Radius of a cluster relative to the galaxy radius */
+  clusterRadius?:
+    | number
+    | undefined;
+  /** Distance between bridge stars in minimum distances */
+  bridgeSpacing?: number | undefined;
+}
+
+export interface IrregularShapeParams {
+  /** 0 spreads stars evenly over a disk, 1 only places them in clumps */
+  irregularity?: number | undefined;
+  numClumps?:
+    | number
+    | undefined;
+  /** Radius of a clump relative to the galaxy radius */
+  clumpRadius?: number | undefined;
+}
+
+/**
+ * Sent when the client missed a sequence number in its update stream. The
+ * server replays the missed messages if it still has them, otherwise it
+ * answers with a fresh snapshot. Reconnecting clients pass the same number as
+ * the lastSequence query parameter of the websocket URL.
+ */
+export interface ResyncCommand {
+  /** Last sequence number the client applied */
+  lastSequence: number;
 }
 
 export interface PingCommand {
@@ -122,6 +287,8 @@ function createBaseClientCommand(): ClientCommand {
     gameCommand: undefined,
     chatCommand: undefined,
     pingCommand: undefined,
+    resyncCommand: undefined,
+    controlCommand: undefined,
   };
 }
 
@@ -145,6 +312,12 @@ export const ClientCommand: MessageFns<ClientCommand> = {
     if (message.pingCommand !== undefined) {
       PingCommand.encode(message.pingCommand, writer.uint32(322).fork()).join();
     }
+    if (message.resyncCommand !== undefined) {
+      ResyncCommand.encode(message.resyncCommand, writer.uint32(402).fork()).join();
+    }
+    if (message.controlCommand !== undefined) {
+      GameControlCommand.encode(message.controlCommand, writer.uint32(482).fork()).join();
+    }
     return writer;
   },
 
@@ -203,6 +376,22 @@ export const ClientCommand: MessageFns<ClientCommand> = {
           message.pingCommand = PingCommand.decode(reader, reader.uint32());
           continue;
         }
+        case 50: {
+          if (tag !== 402) {
+            break;
+          }
+
+          message.resyncCommand = ResyncCommand.decode(reader, reader.uint32());
+          continue;
+        }
+        case 60: {
+          if (tag !== 482) {
+            break;
+          }
+
+          message.controlCommand = GameControlCommand.decode(reader, reader.uint32());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -220,6 +409,8 @@ export const ClientCommand: MessageFns<ClientCommand> = {
       gameCommand: isSet(object.gameCommand) ? GameCommand.fromJSON(object.gameCommand) : undefined,
       chatCommand: isSet(object.chatCommand) ? ChatCommand.fromJSON(object.chatCommand) : undefined,
       pingCommand: isSet(object.pingCommand) ? PingCommand.fromJSON(object.pingCommand) : undefined,
+      resyncCommand: isSet(object.resyncCommand) ? ResyncCommand.fromJSON(object.resyncCommand) : undefined,
+      controlCommand: isSet(object.controlCommand) ? GameControlCommand.fromJSON(object.controlCommand) : undefined,
     };
   },
 
@@ -243,6 +434,12 @@ export const ClientCommand: MessageFns<ClientCommand> = {
     if (message.pingCommand !== undefined) {
       obj.pingCommand = PingCommand.toJSON(message.pingCommand);
     }
+    if (message.resyncCommand !== undefined) {
+      obj.resyncCommand = ResyncCommand.toJSON(message.resyncCommand);
+    }
+    if (message.controlCommand !== undefined) {
+      obj.controlCommand = GameControlCommand.toJSON(message.controlCommand);
+    }
     return obj;
   },
 
@@ -265,6 +462,12 @@ export const ClientCommand: MessageFns<ClientCommand> = {
     message.pingCommand = (object.pingCommand !== undefined && object.pingCommand !== null)
       ? PingCommand.fromPartial(object.pingCommand)
       : undefined;
+    message.resyncCommand = (object.resyncCommand !== undefined && object.resyncCommand !== null)
+      ? ResyncCommand.fromPartial(object.resyncCommand)
+      : undefined;
+    message.controlCommand = (object.controlCommand !== undefined && object.controlCommand !== null)
+      ? GameControlCommand.fromPartial(object.controlCommand)
+      : undefined;
     return message;
   },
 };
@@ -749,7 +952,15 @@ export const StartGameCommand: MessageFns<StartGameCommand> = {
 };
 
 function createBaseGameCommand(): GameCommand {
-  return { moveFleet: undefined, queueConstruction: undefined, queueFleetConstruction: undefined };
+  return {
+    moveFleet: undefined,
+    queueConstruction: undefined,
+    queueFleetConstruction: undefined,
+    reorderBuildQueue: undefined,
+    cancelBuildItem: undefined,
+    colonizePlanet: undefined,
+    queueResearch: undefined,
+  };
 }
 
 export const GameCommand: MessageFns<GameCommand> = {
@@ -763,6 +974,18 @@ export const GameCommand: MessageFns<GameCommand> = {
     if (message.queueFleetConstruction !== undefined) {
       QueueFleetConstructionCommand.encode(message.queueFleetConstruction, writer.uint32(26).fork()).join();
     }
+    if (message.reorderBuildQueue !== undefined) {
+      ReorderBuildQueueCommand.encode(message.reorderBuildQueue, writer.uint32(34).fork()).join();
+    }
+    if (message.cancelBuildItem !== undefined) {
+      CancelBuildItemCommand.encode(message.cancelBuildItem, writer.uint32(42).fork()).join();
+    }
+    if (message.colonizePlanet !== undefined) {
+      ColonizePlanetCommand.encode(message.colonizePlanet, writer.uint32(50).fork()).join();
+    }
+    if (message.queueResearch !== undefined) {
+      QueueResearchCommand.encode(message.queueResearch, writer.uint32(58).fork()).join();
+    }
     return writer;
   },
 
@@ -797,6 +1020,38 @@ export const GameCommand: MessageFns<GameCommand> = {
           message.queueFleetConstruction = QueueFleetConstructionCommand.decode(reader, reader.uint32());
           continue;
         }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.reorderBuildQueue = ReorderBuildQueueCommand.decode(reader, reader.uint32());
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.cancelBuildItem = CancelBuildItemCommand.decode(reader, reader.uint32());
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.colonizePlanet = ColonizePlanetCommand.decode(reader, reader.uint32());
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.queueResearch = QueueResearchCommand.decode(reader, reader.uint32());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -815,6 +1070,14 @@ export const GameCommand: MessageFns<GameCommand> = {
       queueFleetConstruction: isSet(object.queueFleetConstruction)
         ? QueueFleetConstructionCommand.fromJSON(object.queueFleetConstruction)
         : undefined,
+      reorderBuildQueue: isSet(object.reorderBuildQueue)
+        ? ReorderBuildQueueCommand.fromJSON(object.reorderBuildQueue)
+        : undefined,
+      cancelBuildItem: isSet(object.cancelBuildItem)
+        ? CancelBuildItemCommand.fromJSON(object.cancelBuildItem)
+        : undefined,
+      colonizePlanet: isSet(object.colonizePlanet) ? ColonizePlanetCommand.fromJSON(object.colonizePlanet) : undefined,
+      queueResearch: isSet(object.queueResearch) ? QueueResearchCommand.fromJSON(object.queueResearch) : undefined,
     };
   },
 
@@ -829,6 +1092,18 @@ export const GameCommand: MessageFns<GameCommand> = {
     if (message.queueFleetConstruction !== undefined) {
       obj.queueFleetConstruction = QueueFleetConstructionCommand.toJSON(message.queueFleetConstruction);
     }
+    if (message.reorderBuildQueue !== undefined) {
+      obj.reorderBuildQueue = ReorderBuildQueueCommand.toJSON(message.reorderBuildQueue);
+    }
+    if (message.cancelBuildItem !== undefined) {
+      obj.cancelBuildItem = CancelBuildItemCommand.toJSON(message.cancelBuildItem);
+    }
+    if (message.colonizePlanet !== undefined) {
+      obj.colonizePlanet = ColonizePlanetCommand.toJSON(message.colonizePlanet);
+    }
+    if (message.queueResearch !== undefined) {
+      obj.queueResearch = QueueResearchCommand.toJSON(message.queueResearch);
+    }
     return obj;
   },
 
@@ -847,6 +1122,18 @@ export const GameCommand: MessageFns<GameCommand> = {
       (object.queueFleetConstruction !== undefined && object.queueFleetConstruction !== null)
         ? QueueFleetConstructionCommand.fromPartial(object.queueFleetConstruction)
         : undefined;
+    message.reorderBuildQueue = (object.reorderBuildQueue !== undefined && object.reorderBuildQueue !== null)
+      ? ReorderBuildQueueCommand.fromPartial(object.reorderBuildQueue)
+      : undefined;
+    message.cancelBuildItem = (object.cancelBuildItem !== undefined && object.cancelBuildItem !== null)
+      ? CancelBuildItemCommand.fromPartial(object.cancelBuildItem)
+      : undefined;
+    message.colonizePlanet = (object.colonizePlanet !== undefined && object.colonizePlanet !== null)
+      ? ColonizePlanetCommand.fromPartial(object.colonizePlanet)
+      : undefined;
+    message.queueResearch = (object.queueResearch !== undefined && object.queueResearch !== null)
+      ? QueueResearchCommand.fromPartial(object.queueResearch)
+      : undefined;
     return message;
   },
 };
@@ -1113,53 +1400,53 @@ export const QueueFleetConstructionCommand: MessageFns<QueueFleetConstructionCom
   },
 };
 
-function createBaseChatCommand(): ChatCommand {
-  return { global: undefined, private: undefined, lobby: undefined };
+function createBaseReorderBuildQueueCommand(): ReorderBuildQueueCommand {
+  return { itemId: 0, position: 0, empireQueue: false };
 }
 
-export const ChatCommand: MessageFns<ChatCommand> = {
-  encode(message: ChatCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.global !== undefined) {
-      GlobalChatCommand.encode(message.global, writer.uint32(10).fork()).join();
+export const ReorderBuildQueueCommand: MessageFns<ReorderBuildQueueCommand> = {
+  encode(message: ReorderBuildQueueCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.itemId !== 0) {
+      writer.uint32(8).uint64(message.itemId);
     }
-    if (message.private !== undefined) {
-      PrivateChatCommand.encode(message.private, writer.uint32(18).fork()).join();
+    if (message.position !== 0) {
+      writer.uint32(16).uint32(message.position);
     }
-    if (message.lobby !== undefined) {
-      LobbyChatCommand.encode(message.lobby, writer.uint32(26).fork()).join();
+    if (message.empireQueue !== false) {
+      writer.uint32(24).bool(message.empireQueue);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ChatCommand {
+  decode(input: BinaryReader | Uint8Array, length?: number): ReorderBuildQueueCommand {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseChatCommand();
+    const message = createBaseReorderBuildQueueCommand();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.global = GlobalChatCommand.decode(reader, reader.uint32());
+          message.itemId = longToNumber(reader.uint64());
           continue;
         }
         case 2: {
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.private = PrivateChatCommand.decode(reader, reader.uint32());
+          message.position = reader.uint32();
           continue;
         }
         case 3: {
-          if (tag !== 26) {
+          if (tag !== 24) {
             break;
           }
 
-          message.lobby = LobbyChatCommand.decode(reader, reader.uint32());
+          message.empireQueue = reader.bool();
           continue;
         }
       }
@@ -1171,71 +1458,65 @@ export const ChatCommand: MessageFns<ChatCommand> = {
     return message;
   },
 
-  fromJSON(object: any): ChatCommand {
+  fromJSON(object: any): ReorderBuildQueueCommand {
     return {
-      global: isSet(object.global) ? GlobalChatCommand.fromJSON(object.global) : undefined,
-      private: isSet(object.private) ? PrivateChatCommand.fromJSON(object.private) : undefined,
-      lobby: isSet(object.lobby) ? LobbyChatCommand.fromJSON(object.lobby) : undefined,
+      itemId: isSet(object.itemId) ? globalThis.Number(object.itemId) : 0,
+      position: isSet(object.position) ? globalThis.Number(object.position) : 0,
+      empireQueue: isSet(object.empireQueue) ? globalThis.Boolean(object.empireQueue) : false,
     };
   },
 
-  toJSON(message: ChatCommand): unknown {
+  toJSON(message: ReorderBuildQueueCommand): unknown {
     const obj: any = {};
-    if (message.global !== undefined) {
-      obj.global = GlobalChatCommand.toJSON(message.global);
+    if (message.itemId !== 0) {
+      obj.itemId = Math.round(message.itemId);
     }
-    if (message.private !== undefined) {
-      obj.private = PrivateChatCommand.toJSON(message.private);
+    if (message.position !== 0) {
+      obj.position = Math.round(message.position);
     }
-    if (message.lobby !== undefined) {
-      obj.lobby = LobbyChatCommand.toJSON(message.lobby);
+    if (message.empireQueue !== false) {
+      obj.empireQueue = message.empireQueue;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ChatCommand>, I>>(base?: I): ChatCommand {
-    return ChatCommand.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ReorderBuildQueueCommand>, I>>(base?: I): ReorderBuildQueueCommand {
+    return ReorderBuildQueueCommand.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ChatCommand>, I>>(object: I): ChatCommand {
-    const message = createBaseChatCommand();
-    message.global = (object.global !== undefined && object.global !== null)
-      ? GlobalChatCommand.fromPartial(object.global)
-      : undefined;
-    message.private = (object.private !== undefined && object.private !== null)
-      ? PrivateChatCommand.fromPartial(object.private)
-      : undefined;
-    message.lobby = (object.lobby !== undefined && object.lobby !== null)
-      ? LobbyChatCommand.fromPartial(object.lobby)
-      : undefined;
+  fromPartial<I extends Exact<DeepPartial<ReorderBuildQueueCommand>, I>>(object: I): ReorderBuildQueueCommand {
+    const message = createBaseReorderBuildQueueCommand();
+    message.itemId = object.itemId ?? 0;
+    message.position = object.position ?? 0;
+    message.empireQueue = object.empireQueue ?? false;
     return message;
   },
 };
 
-function createBaseGlobalChatCommand(): GlobalChatCommand {
-  return { message: "" };
+function createBaseCancelBuildItemCommand(): CancelBuildItemCommand {
+  return { itemId: 0 };
 }
 
-export const GlobalChatCommand: MessageFns<GlobalChatCommand> = {
-  encode(message: GlobalChatCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.message !== "") {
-      writer.uint32(10).string(message.message);
+export const CancelBuildItemCommand: MessageFns<CancelBuildItemCommand> = {
+  encode(message: CancelBuildItemCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.itemId !== 0) {
+      writer.uint32(8).uint64(message.itemId);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): GlobalChatCommand {
+  decode(input: BinaryReader | Uint8Array, length?: number): CancelBuildItemCommand {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGlobalChatCommand();
+    const message = createBaseCancelBuildItemCommand();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.message = reader.string();
+          message.itemId = longToNumber(reader.uint64());
           continue;
         }
       }
@@ -1247,64 +1528,64 @@ export const GlobalChatCommand: MessageFns<GlobalChatCommand> = {
     return message;
   },
 
-  fromJSON(object: any): GlobalChatCommand {
-    return { message: isSet(object.message) ? globalThis.String(object.message) : "" };
+  fromJSON(object: any): CancelBuildItemCommand {
+    return { itemId: isSet(object.itemId) ? globalThis.Number(object.itemId) : 0 };
   },
 
-  toJSON(message: GlobalChatCommand): unknown {
+  toJSON(message: CancelBuildItemCommand): unknown {
     const obj: any = {};
-    if (message.message !== "") {
-      obj.message = message.message;
+    if (message.itemId !== 0) {
+      obj.itemId = Math.round(message.itemId);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<GlobalChatCommand>, I>>(base?: I): GlobalChatCommand {
-    return GlobalChatCommand.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<CancelBuildItemCommand>, I>>(base?: I): CancelBuildItemCommand {
+    return CancelBuildItemCommand.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<GlobalChatCommand>, I>>(object: I): GlobalChatCommand {
-    const message = createBaseGlobalChatCommand();
-    message.message = object.message ?? "";
+  fromPartial<I extends Exact<DeepPartial<CancelBuildItemCommand>, I>>(object: I): CancelBuildItemCommand {
+    const message = createBaseCancelBuildItemCommand();
+    message.itemId = object.itemId ?? 0;
     return message;
   },
 };
 
-function createBasePrivateChatCommand(): PrivateChatCommand {
-  return { recipientId: "", message: "" };
+function createBaseColonizePlanetCommand(): ColonizePlanetCommand {
+  return { fleetId: 0, planetId: 0 };
 }
 
-export const PrivateChatCommand: MessageFns<PrivateChatCommand> = {
-  encode(message: PrivateChatCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.recipientId !== "") {
-      writer.uint32(10).string(message.recipientId);
+export const ColonizePlanetCommand: MessageFns<ColonizePlanetCommand> = {
+  encode(message: ColonizePlanetCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.fleetId !== 0) {
+      writer.uint32(8).uint64(message.fleetId);
     }
-    if (message.message !== "") {
-      writer.uint32(18).string(message.message);
+    if (message.planetId !== 0) {
+      writer.uint32(16).uint64(message.planetId);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): PrivateChatCommand {
+  decode(input: BinaryReader | Uint8Array, length?: number): ColonizePlanetCommand {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBasePrivateChatCommand();
+    const message = createBaseColonizePlanetCommand();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.recipientId = reader.string();
+          message.fleetId = longToNumber(reader.uint64());
           continue;
         }
         case 2: {
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.message = reader.string();
+          message.planetId = longToNumber(reader.uint64());
           continue;
         }
       }
@@ -1316,51 +1597,54 @@ export const PrivateChatCommand: MessageFns<PrivateChatCommand> = {
     return message;
   },
 
-  fromJSON(object: any): PrivateChatCommand {
+  fromJSON(object: any): ColonizePlanetCommand {
     return {
-      recipientId: isSet(object.recipientId) ? globalThis.String(object.recipientId) : "",
-      message: isSet(object.message) ? globalThis.String(object.message) : "",
+      fleetId: isSet(object.fleetId) ? globalThis.Number(object.fleetId) : 0,
+      planetId: isSet(object.planetId) ? globalThis.Number(object.planetId) : 0,
     };
   },
 
-  toJSON(message: PrivateChatCommand): unknown {
+  toJSON(message: ColonizePlanetCommand): unknown {
     const obj: any = {};
-    if (message.recipientId !== "") {
-      obj.recipientId = message.recipientId;
+    if (message.fleetId !== 0) {
+      obj.fleetId = Math.round(message.fleetId);
     }
-    if (message.message !== "") {
-      obj.message = message.message;
+    if (message.planetId !== 0) {
+      obj.planetId = Math.round(message.planetId);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<PrivateChatCommand>, I>>(base?: I): PrivateChatCommand {
-    return PrivateChatCommand.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ColonizePlanetCommand>, I>>(base?: I): ColonizePlanetCommand {
+    return ColonizePlanetCommand.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<PrivateChatCommand>, I>>(object: I): PrivateChatCommand {
-    const message = createBasePrivateChatCommand();
-    message.recipientId = object.recipientId ?? "";
-    message.message = object.message ?? "";
+  fromPartial<I extends Exact<DeepPartial<ColonizePlanetCommand>, I>>(object: I): ColonizePlanetCommand {
+    const message = createBaseColonizePlanetCommand();
+    message.fleetId = object.fleetId ?? 0;
+    message.planetId = object.planetId ?? 0;
     return message;
   },
 };
 
-function createBaseLobbyChatCommand(): LobbyChatCommand {
-  return { message: "" };
+function createBaseQueueResearchCommand(): QueueResearchCommand {
+  return { technology: "", remove: false };
 }
 
-export const LobbyChatCommand: MessageFns<LobbyChatCommand> = {
-  encode(message: LobbyChatCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.message !== "") {
-      writer.uint32(10).string(message.message);
+export const QueueResearchCommand: MessageFns<QueueResearchCommand> = {
+  encode(message: QueueResearchCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.technology !== "") {
+      writer.uint32(10).string(message.technology);
+    }
+    if (message.remove !== false) {
+      writer.uint32(16).bool(message.remove);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): LobbyChatCommand {
+  decode(input: BinaryReader | Uint8Array, length?: number): QueueResearchCommand {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseLobbyChatCommand();
+    const message = createBaseQueueResearchCommand();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1369,7 +1653,15 @@ export const LobbyChatCommand: MessageFns<LobbyChatCommand> = {
             break;
           }
 
-          message.message = reader.string();
+          message.technology = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.remove = reader.bool();
           continue;
         }
       }
@@ -1381,62 +1673,622 @@ export const LobbyChatCommand: MessageFns<LobbyChatCommand> = {
     return message;
   },
 
-  fromJSON(object: any): LobbyChatCommand {
-    return { message: isSet(object.message) ? globalThis.String(object.message) : "" };
+  fromJSON(object: any): QueueResearchCommand {
+    return {
+      technology: isSet(object.technology) ? globalThis.String(object.technology) : "",
+      remove: isSet(object.remove) ? globalThis.Boolean(object.remove) : false,
+    };
   },
 
-  toJSON(message: LobbyChatCommand): unknown {
+  toJSON(message: QueueResearchCommand): unknown {
     const obj: any = {};
-    if (message.message !== "") {
-      obj.message = message.message;
+    if (message.technology !== "") {
+      obj.technology = message.technology;
+    }
+    if (message.remove !== false) {
+      obj.remove = message.remove;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<LobbyChatCommand>, I>>(base?: I): LobbyChatCommand {
-    return LobbyChatCommand.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<QueueResearchCommand>, I>>(base?: I): QueueResearchCommand {
+    return QueueResearchCommand.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<LobbyChatCommand>, I>>(object: I): LobbyChatCommand {
-    const message = createBaseLobbyChatCommand();
-    message.message = object.message ?? "";
+  fromPartial<I extends Exact<DeepPartial<QueueResearchCommand>, I>>(object: I): QueueResearchCommand {
+    const message = createBaseQueueResearchCommand();
+    message.technology = object.technology ?? "";
+    message.remove = object.remove ?? false;
     return message;
   },
 };
 
-function createBaseGalaxyGenerateSettings(): GalaxyGenerateSettings {
-  return { numStars: 0, shape: "", maxHyperlanes: 0, hyperlaneConnectivity: 0 };
+function createBaseGameControlCommand(): GameControlCommand {
+  return { pause: undefined, resume: undefined, setSpeed: undefined };
 }
 
-export const GalaxyGenerateSettings: MessageFns<GalaxyGenerateSettings> = {
-  encode(message: GalaxyGenerateSettings, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.numStars !== 0) {
-      writer.uint32(8).int32(message.numStars);
+export const GameControlCommand: MessageFns<GameControlCommand> = {
+  encode(message: GameControlCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.pause !== undefined) {
+      PauseGameCommand.encode(message.pause, writer.uint32(10).fork()).join();
     }
-    if (message.shape !== "") {
-      writer.uint32(18).string(message.shape);
-    }
-    if (message.maxHyperlanes !== 0) {
-      writer.uint32(24).int32(message.maxHyperlanes);
+    if (message.resume !== undefined) {
+      ResumeGameCommand.encode(message.resume, writer.uint32(18).fork()).join();
     }
-    if (message.hyperlaneConnectivity !== 0) {
-      writer.uint32(32).int32(message.hyperlaneConnectivity);
+    if (message.setSpeed !== undefined) {
+      SetGameSpeedCommand.encode(message.setSpeed, writer.uint32(26).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): GalaxyGenerateSettings {
+  decode(input: BinaryReader | Uint8Array, length?: number): GameControlCommand {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGalaxyGenerateSettings();
+    const message = createBaseGameControlCommand();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.numStars = reader.int32();
+          message.pause = PauseGameCommand.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.resume = ResumeGameCommand.decode(reader, reader.uint32());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.setSpeed = SetGameSpeedCommand.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GameControlCommand {
+    return {
+      pause: isSet(object.pause) ? PauseGameCommand.fromJSON(object.pause) : undefined,
+      resume: isSet(object.resume) ? ResumeGameCommand.fromJSON(object.resume) : undefined,
+      setSpeed: isSet(object.setSpeed) ? SetGameSpeedCommand.fromJSON(object.setSpeed) : undefined,
+    };
+  },
+
+  toJSON(message: GameControlCommand): unknown {
+    const obj: any = {};
+    if (message.pause !== undefined) {
+      obj.pause = PauseGameCommand.toJSON(message.pause);
+    }
+    if (message.resume !== undefined) {
+      obj.resume = ResumeGameCommand.toJSON(message.resume);
+    }
+    if (message.setSpeed !== undefined) {
+      obj.setSpeed = SetGameSpeedCommand.toJSON(message.setSpeed);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<GameControlCommand>, I>>(base?: I): GameControlCommand {
+    return GameControlCommand.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<GameControlCommand>, I>>(object: I): GameControlCommand {
+    const message = createBaseGameControlCommand();
+    message.pause = (object.pause !== undefined && object.pause !== null)
+      ? PauseGameCommand.fromPartial(object.pause)
+      : undefined;
+    message.resume = (object.resume !== undefined && object.resume !== null)
+      ? ResumeGameCommand.fromPartial(object.resume)
+      : undefined;
+    message.setSpeed = (object.setSpeed !== undefined && object.setSpeed !== null)
+      ? SetGameSpeedCommand.fromPartial(object.setSpeed)
+      : undefined;
+    return message;
+  },
+};
+
+function createBasePauseGameCommand(): PauseGameCommand {
+  return {};
+}
+
+export const PauseGameCommand: MessageFns<PauseGameCommand> = {
+  encode(_: PauseGameCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): PauseGameCommand {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePauseGameCommand();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): PauseGameCommand {
+    return {};
+  },
+
+  toJSON(_: PauseGameCommand): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PauseGameCommand>, I>>(base?: I): PauseGameCommand {
+    return PauseGameCommand.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<PauseGameCommand>, I>>(_: I): PauseGameCommand {
+    const message = createBasePauseGameCommand();
+    return message;
+  },
+};
+
+function createBaseResumeGameCommand(): ResumeGameCommand {
+  return {};
+}
+
+export const ResumeGameCommand: MessageFns<ResumeGameCommand> = {
+  encode(_: ResumeGameCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ResumeGameCommand {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResumeGameCommand();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): ResumeGameCommand {
+    return {};
+  },
+
+  toJSON(_: ResumeGameCommand): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ResumeGameCommand>, I>>(base?: I): ResumeGameCommand {
+    return ResumeGameCommand.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ResumeGameCommand>, I>>(_: I): ResumeGameCommand {
+    const message = createBaseResumeGameCommand();
+    return message;
+  },
+};
+
+function createBaseSetGameSpeedCommand(): SetGameSpeedCommand {
+  return { yearsPerHour: 0 };
+}
+
+export const SetGameSpeedCommand: MessageFns<SetGameSpeedCommand> = {
+  encode(message: SetGameSpeedCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.yearsPerHour !== 0) {
+      writer.uint32(9).double(message.yearsPerHour);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SetGameSpeedCommand {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSetGameSpeedCommand();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 9) {
+            break;
+          }
+
+          message.yearsPerHour = reader.double();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SetGameSpeedCommand {
+    return { yearsPerHour: isSet(object.yearsPerHour) ? globalThis.Number(object.yearsPerHour) : 0 };
+  },
+
+  toJSON(message: SetGameSpeedCommand): unknown {
+    const obj: any = {};
+    if (message.yearsPerHour !== 0) {
+      obj.yearsPerHour = message.yearsPerHour;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<SetGameSpeedCommand>, I>>(base?: I): SetGameSpeedCommand {
+    return SetGameSpeedCommand.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<SetGameSpeedCommand>, I>>(object: I): SetGameSpeedCommand {
+    const message = createBaseSetGameSpeedCommand();
+    message.yearsPerHour = object.yearsPerHour ?? 0;
+    return message;
+  },
+};
+
+function createBaseChatCommand(): ChatCommand {
+  return { global: undefined, private: undefined, lobby: undefined };
+}
+
+export const ChatCommand: MessageFns<ChatCommand> = {
+  encode(message: ChatCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.global !== undefined) {
+      GlobalChatCommand.encode(message.global, writer.uint32(10).fork()).join();
+    }
+    if (message.private !== undefined) {
+      PrivateChatCommand.encode(message.private, writer.uint32(18).fork()).join();
+    }
+    if (message.lobby !== undefined) {
+      LobbyChatCommand.encode(message.lobby, writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ChatCommand {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseChatCommand();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.global = GlobalChatCommand.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.private = PrivateChatCommand.decode(reader, reader.uint32());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.lobby = LobbyChatCommand.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ChatCommand {
+    return {
+      global: isSet(object.global) ? GlobalChatCommand.fromJSON(object.global) : undefined,
+      private: isSet(object.private) ? PrivateChatCommand.fromJSON(object.private) : undefined,
+      lobby: isSet(object.lobby) ? LobbyChatCommand.fromJSON(object.lobby) : undefined,
+    };
+  },
+
+  toJSON(message: ChatCommand): unknown {
+    const obj: any = {};
+    if (message.global !== undefined) {
+      obj.global = GlobalChatCommand.toJSON(message.global);
+    }
+    if (message.private !== undefined) {
+      obj.private = PrivateChatCommand.toJSON(message.private);
+    }
+    if (message.lobby !== undefined) {
+      obj.lobby = LobbyChatCommand.toJSON(message.lobby);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ChatCommand>, I>>(base?: I): ChatCommand {
+    return ChatCommand.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ChatCommand>, I>>(object: I): ChatCommand {
+    const message = createBaseChatCommand();
+    message.global = (object.global !== undefined && object.global !== null)
+      ? GlobalChatCommand.fromPartial(object.global)
+      : undefined;
+    message.private = (object.private !== undefined && object.private !== null)
+      ? PrivateChatCommand.fromPartial(object.private)
+      : undefined;
+    message.lobby = (object.lobby !== undefined && object.lobby !== null)
+      ? LobbyChatCommand.fromPartial(object.lobby)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseGlobalChatCommand(): GlobalChatCommand {
+  return { message: "" };
+}
+
+export const GlobalChatCommand: MessageFns<GlobalChatCommand> = {
+  encode(message: GlobalChatCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.message !== "") {
+      writer.uint32(10).string(message.message);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GlobalChatCommand {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGlobalChatCommand();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.message = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GlobalChatCommand {
+    return { message: isSet(object.message) ? globalThis.String(object.message) : "" };
+  },
+
+  toJSON(message: GlobalChatCommand): unknown {
+    const obj: any = {};
+    if (message.message !== "") {
+      obj.message = message.message;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<GlobalChatCommand>, I>>(base?: I): GlobalChatCommand {
+    return GlobalChatCommand.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<GlobalChatCommand>, I>>(object: I): GlobalChatCommand {
+    const message = createBaseGlobalChatCommand();
+    message.message = object.message ?? "";
+    return message;
+  },
+};
+
+function createBasePrivateChatCommand(): PrivateChatCommand {
+  return { recipientId: "", message: "" };
+}
+
+export const PrivateChatCommand: MessageFns<PrivateChatCommand> = {
+  encode(message: PrivateChatCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.recipientId !== "") {
+      writer.uint32(10).string(message.recipientId);
+    }
+    if (message.message !== "") {
+      writer.uint32(18).string(message.message);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): PrivateChatCommand {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePrivateChatCommand();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.recipientId = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.message = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PrivateChatCommand {
+    return {
+      recipientId: isSet(object.recipientId) ? globalThis.String(object.recipientId) : "",
+      message: isSet(object.message) ? globalThis.String(object.message) : "",
+    };
+  },
+
+  toJSON(message: PrivateChatCommand): unknown {
+    const obj: any = {};
+    if (message.recipientId !== "") {
+      obj.recipientId = message.recipientId;
+    }
+    if (message.message !== "") {
+      obj.message = message.message;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PrivateChatCommand>, I>>(base?: I): PrivateChatCommand {
+    return PrivateChatCommand.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<PrivateChatCommand>, I>>(object: I): PrivateChatCommand {
+    const message = createBasePrivateChatCommand();
+    message.recipientId = object.recipientId ?? "";
+    message.message = object.message ?? "";
+    return message;
+  },
+};
+
+function createBaseLobbyChatCommand(): LobbyChatCommand {
+  return { message: "" };
+}
+
+export const LobbyChatCommand: MessageFns<LobbyChatCommand> = {
+  encode(message: LobbyChatCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.message !== "") {
+      writer.uint32(10).string(message.message);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): LobbyChatCommand {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLobbyChatCommand();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.message = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): LobbyChatCommand {
+    return { message: isSet(object.message) ? globalThis.String(object.message) : "" };
+  },
+
+  toJSON(message: LobbyChatCommand): unknown {
+    const obj: any = {};
+    if (message.message !== "") {
+      obj.message = message.message;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<LobbyChatCommand>, I>>(base?: I): LobbyChatCommand {
+    return LobbyChatCommand.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<LobbyChatCommand>, I>>(object: I): LobbyChatCommand {
+    const message = createBaseLobbyChatCommand();
+    message.message = object.message ?? "";
+    return message;
+  },
+};
+
+function createBaseGalaxyGenerateSettings(): GalaxyGenerateSettings {
+  return {
+    numStars: 0,
+    shape: "",
+    maxHyperlanes: 0,
+    hyperlaneConnectivity: 0,
+    seed: 0,
+    yearsPerHour: 0,
+    controlVoting: false,
+    shapeParams: undefined,
+  };
+}
+
+export const GalaxyGenerateSettings: MessageFns<GalaxyGenerateSettings> = {
+  encode(message: GalaxyGenerateSettings, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.numStars !== 0) {
+      writer.uint32(8).int32(message.numStars);
+    }
+    if (message.shape !== "") {
+      writer.uint32(18).string(message.shape);
+    }
+    if (message.maxHyperlanes !== 0) {
+      writer.uint32(24).int32(message.maxHyperlanes);
+    }
+    if (message.hyperlaneConnectivity !== 0) {
+      writer.uint32(32).int32(message.hyperlaneConnectivity);
+    }
+    if (message.seed !== 0) {
+      writer.uint32(40).int64(message.seed);
+    }
+    if (message.yearsPerHour !== 0) {
+      writer.uint32(49).double(message.yearsPerHour);
+    }
+    if (message.controlVoting !== false) {
+      writer.uint32(56).bool(message.controlVoting);
+    }
+    if (message.shapeParams !== undefined) {
+      GalaxyShapeParams.encode(message.shapeParams, writer.uint32(66).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GalaxyGenerateSettings {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGalaxyGenerateSettings();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.numStars = reader.int32();
           continue;
         }
         case 2: {
@@ -1448,19 +2300,645 @@ export const GalaxyGenerateSettings: MessageFns<GalaxyGenerateSettings> = {
           continue;
         }
         case 3: {
-          if (tag !== 24) {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.maxHyperlanes = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.hyperlaneConnectivity = reader.int32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.seed = longToNumber(reader.int64());
+          continue;
+        }
+        case 6: {
+          if (tag !== 49) {
+            break;
+          }
+
+          message.yearsPerHour = reader.double();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.controlVoting = reader.bool();
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.shapeParams = GalaxyShapeParams.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GalaxyGenerateSettings {
+    return {
+      numStars: isSet(object.numStars) ? globalThis.Number(object.numStars) : 0,
+      shape: isSet(object.shape) ? globalThis.String(object.shape) : "",
+      maxHyperlanes: isSet(object.maxHyperlanes) ? globalThis.Number(object.maxHyperlanes) : 0,
+      hyperlaneConnectivity: isSet(object.hyperlaneConnectivity) ? globalThis.Number(object.hyperlaneConnectivity) : 0,
+      seed: isSet(object.seed) ? globalThis.Number(object.seed) : 0,
+      yearsPerHour: isSet(object.yearsPerHour) ? globalThis.Number(object.yearsPerHour) : 0,
+      controlVoting: isSet(object.controlVoting) ? globalThis.Boolean(object.controlVoting) : false,
+      shapeParams: isSet(object.shapeParams) ? GalaxyShapeParams.fromJSON(object.shapeParams) : undefined,
+    };
+  },
+
+  toJSON(message: GalaxyGenerateSettings): unknown {
+    const obj: any = {};
+    if (message.numStars !== 0) {
+      obj.numStars = Math.round(message.numStars);
+    }
+    if (message.shape !== "") {
+      obj.shape = message.shape;
+    }
+    if (message.maxHyperlanes !== 0) {
+      obj.maxHyperlanes = Math.round(message.maxHyperlanes);
+    }
+    if (message.hyperlaneConnectivity !== 0) {
+      obj.hyperlaneConnectivity = Math.round(message.hyperlaneConnectivity);
+    }
+    if (message.seed !== 0) {
+      obj.seed = Math.round(message.seed);
+    }
+    if (message.yearsPerHour !== 0) {
+      obj.yearsPerHour = message.yearsPerHour;
+    }
+    if (message.controlVoting !== false) {
+      obj.controlVoting = message.controlVoting;
+    }
+    if (message.shapeParams !== undefined) {
+      obj.shapeParams = GalaxyShapeParams.toJSON(message.shapeParams);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<GalaxyGenerateSettings>, I>>(base?: I): GalaxyGenerateSettings {
+    return GalaxyGenerateSettings.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<GalaxyGenerateSettings>, I>>(object: I): GalaxyGenerateSettings {
+    const message = createBaseGalaxyGenerateSettings();
+    message.numStars = object.numStars ?? 0;
+    message.shape = object.shape ?? "";
+    message.maxHyperlanes = object.maxHyperlanes ?? 0;
+    message.hyperlaneConnectivity = object.hyperlaneConnectivity ?? 0;
+    message.seed = object.seed ?? 0;
+    message.yearsPerHour = object.yearsPerHour ?? 0;
+    message.controlVoting = object.controlVoting ?? false;
+    message.shapeParams = (object.shapeParams !== undefined && object.shapeParams !== null)
+      ? GalaxyShapeParams.fromPartial(object.shapeParams)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseGalaxyShapeParams(): GalaxyShapeParams {
+  return {
+    spiral: undefined,
+    elliptical: undefined,
+    ring: undefined,
+    barredSpiral: undefined,
+    clustered: undefined,
+    irregular: undefined,
+  };
+}
+
+export const GalaxyShapeParams: MessageFns<GalaxyShapeParams> = {
+  encode(message: GalaxyShapeParams, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.spiral !== undefined) {
+      SpiralShapeParams.encode(message.spiral, writer.uint32(10).fork()).join();
+    }
+    if (message.elliptical !== undefined) {
+      EllipticalShapeParams.encode(message.elliptical, writer.uint32(18).fork()).join();
+    }
+    if (message.ring !== undefined) {
+      RingShapeParams.encode(message.ring, writer.uint32(26).fork()).join();
+    }
+    if (message.barredSpiral !== undefined) {
+      BarredSpiralShapeParams.encode(message.barredSpiral, writer.uint32(34).fork()).join();
+    }
+    if (message.clustered !== undefined) {
+      ClusteredShapeParams.encode(message.clustered, writer.uint32(42).fork()).join();
+    }
+    if (message.irregular !== undefined) {
+      IrregularShapeParams.encode(message.irregular, writer.uint32(50).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GalaxyShapeParams {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGalaxyShapeParams();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.spiral = SpiralShapeParams.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.elliptical = EllipticalShapeParams.decode(reader, reader.uint32());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.ring = RingShapeParams.decode(reader, reader.uint32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.barredSpiral = BarredSpiralShapeParams.decode(reader, reader.uint32());
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.clustered = ClusteredShapeParams.decode(reader, reader.uint32());
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.irregular = IrregularShapeParams.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GalaxyShapeParams {
+    return {
+      spiral: isSet(object.spiral) ? SpiralShapeParams.fromJSON(object.spiral) : undefined,
+      elliptical: isSet(object.elliptical) ? EllipticalShapeParams.fromJSON(object.elliptical) : undefined,
+      ring: isSet(object.ring) ? RingShapeParams.fromJSON(object.ring) : undefined,
+      barredSpiral: isSet(object.barredSpiral) ? BarredSpiralShapeParams.fromJSON(object.barredSpiral) : undefined,
+      clustered: isSet(object.clustered) ? ClusteredShapeParams.fromJSON(object.clustered) : undefined,
+      irregular: isSet(object.irregular) ? IrregularShapeParams.fromJSON(object.irregular) : undefined,
+    };
+  },
+
+  toJSON(message: GalaxyShapeParams): unknown {
+    const obj: any = {};
+    if (message.spiral !== undefined) {
+      obj.spiral = SpiralShapeParams.toJSON(message.spiral);
+    }
+    if (message.elliptical !== undefined) {
+      obj.elliptical = EllipticalShapeParams.toJSON(message.elliptical);
+    }
+    if (message.ring !== undefined) {
+      obj.ring = RingShapeParams.toJSON(message.ring);
+    }
+    if (message.barredSpiral !== undefined) {
+      obj.barredSpiral = BarredSpiralShapeParams.toJSON(message.barredSpiral);
+    }
+    if (message.clustered !== undefined) {
+      obj.clustered = ClusteredShapeParams.toJSON(message.clustered);
+    }
+    if (message.irregular !== undefined) {
+      obj.irregular = IrregularShapeParams.toJSON(message.irregular);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<GalaxyShapeParams>, I>>(base?: I): GalaxyShapeParams {
+    return GalaxyShapeParams.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<GalaxyShapeParams>, I>>(object: I): GalaxyShapeParams {
+    const message = createBaseGalaxyShapeParams();
+    message.spiral = (object.spiral !== undefined && object.spiral !== null)
+      ? SpiralShapeParams.fromPartial(object.spiral)
+      : undefined;
+    message.elliptical = (object.elliptical !== undefined && object.elliptical !== null)
+      ? EllipticalShapeParams.fromPartial(object.elliptical)
+      : undefined;
+    message.ring = (object.ring !== undefined && object.ring !== null)
+      ? RingShapeParams.fromPartial(object.ring)
+      : undefined;
+    message.barredSpiral = (object.barredSpiral !== undefined && object.barredSpiral !== null)
+      ? BarredSpiralShapeParams.fromPartial(object.barredSpiral)
+      : undefined;
+    message.clustered = (object.clustered !== undefined && object.clustered !== null)
+      ? ClusteredShapeParams.fromPartial(object.clustered)
+      : undefined;
+    message.irregular = (object.irregular !== undefined && object.irregular !== null)
+      ? IrregularShapeParams.fromPartial(object.irregular)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseSpiralShapeParams(): SpiralShapeParams {
+  return {
+    numArms: undefined,
+    armSpread: undefined,
+    minRadius: undefined,
+    twist: undefined,
+    interarmChance: undefined,
+  };
+}
+
+export const SpiralShapeParams: MessageFns<SpiralShapeParams> = {
+  encode(message: SpiralShapeParams, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.numArms !== undefined) {
+      writer.uint32(8).int32(message.numArms);
+    }
+    if (message.armSpread !== undefined) {
+      writer.uint32(17).double(message.armSpread);
+    }
+    if (message.minRadius !== undefined) {
+      writer.uint32(25).double(message.minRadius);
+    }
+    if (message.twist !== undefined) {
+      writer.uint32(33).double(message.twist);
+    }
+    if (message.interarmChance !== undefined) {
+      writer.uint32(41).double(message.interarmChance);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SpiralShapeParams {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSpiralShapeParams();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.numArms = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 17) {
+            break;
+          }
+
+          message.armSpread = reader.double();
+          continue;
+        }
+        case 3: {
+          if (tag !== 25) {
+            break;
+          }
+
+          message.minRadius = reader.double();
+          continue;
+        }
+        case 4: {
+          if (tag !== 33) {
+            break;
+          }
+
+          message.twist = reader.double();
+          continue;
+        }
+        case 5: {
+          if (tag !== 41) {
+            break;
+          }
+
+          message.interarmChance = reader.double();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SpiralShapeParams {
+    return {
+      numArms: isSet(object.numArms) ? globalThis.Number(object.numArms) : undefined,
+      armSpread: isSet(object.armSpread) ? globalThis.Number(object.armSpread) : undefined,
+      minRadius: isSet(object.minRadius) ? globalThis.Number(object.minRadius) : undefined,
+      twist: isSet(object.twist) ? globalThis.Number(object.twist) : undefined,
+      interarmChance: isSet(object.interarmChance) ? globalThis.Number(object.interarmChance) : undefined,
+    };
+  },
+
+  toJSON(message: SpiralShapeParams): unknown {
+    const obj: any = {};
+    if (message.numArms !== undefined) {
+      obj.numArms = Math.round(message.numArms);
+    }
+    if (message.armSpread !== undefined) {
+      obj.armSpread = message.armSpread;
+    }
+    if (message.minRadius !== undefined) {
+      obj.minRadius = message.minRadius;
+    }
+    if (message.twist !== undefined) {
+      obj.twist = message.twist;
+    }
+    if (message.interarmChance !== undefined) {
+      obj.interarmChance = message.interarmChance;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<SpiralShapeParams>, I>>(base?: I): SpiralShapeParams {
+    return SpiralShapeParams.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<SpiralShapeParams>, I>>(object: I): SpiralShapeParams {
+    const message = createBaseSpiralShapeParams();
+    message.numArms = object.numArms ?? undefined;
+    message.armSpread = object.armSpread ?? undefined;
+    message.minRadius = object.minRadius ?? undefined;
+    message.twist = object.twist ?? undefined;
+    message.interarmChance = object.interarmChance ?? undefined;
+    return message;
+  },
+};
+
+function createBaseEllipticalShapeParams(): EllipticalShapeParams {
+  return { axisRatio: undefined, concentration: undefined };
+}
+
+export const EllipticalShapeParams: MessageFns<EllipticalShapeParams> = {
+  encode(message: EllipticalShapeParams, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.axisRatio !== undefined) {
+      writer.uint32(9).double(message.axisRatio);
+    }
+    if (message.concentration !== undefined) {
+      writer.uint32(17).double(message.concentration);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): EllipticalShapeParams {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEllipticalShapeParams();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 9) {
+            break;
+          }
+
+          message.axisRatio = reader.double();
+          continue;
+        }
+        case 2: {
+          if (tag !== 17) {
+            break;
+          }
+
+          message.concentration = reader.double();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): EllipticalShapeParams {
+    return {
+      axisRatio: isSet(object.axisRatio) ? globalThis.Number(object.axisRatio) : undefined,
+      concentration: isSet(object.concentration) ? globalThis.Number(object.concentration) : undefined,
+    };
+  },
+
+  toJSON(message: EllipticalShapeParams): unknown {
+    const obj: any = {};
+    if (message.axisRatio !== undefined) {
+      obj.axisRatio = message.axisRatio;
+    }
+    if (message.concentration !== undefined) {
+      obj.concentration = message.concentration;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<EllipticalShapeParams>, I>>(base?: I): EllipticalShapeParams {
+    return EllipticalShapeParams.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<EllipticalShapeParams>, I>>(object: I): EllipticalShapeParams {
+    const message = createBaseEllipticalShapeParams();
+    message.axisRatio = object.axisRatio ?? undefined;
+    message.concentration = object.concentration ?? undefined;
+    return message;
+  },
+};
+
+function createBaseRingShapeParams(): RingShapeParams {
+  return { innerRadius: undefined };
+}
+
+export const RingShapeParams: MessageFns<RingShapeParams> = {
+  encode(message: RingShapeParams, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.innerRadius !== undefined) {
+      writer.uint32(9).double(message.innerRadius);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RingShapeParams {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRingShapeParams();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 9) {
+            break;
+          }
+
+          message.innerRadius = reader.double();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RingShapeParams {
+    return { innerRadius: isSet(object.innerRadius) ? globalThis.Number(object.innerRadius) : undefined };
+  },
+
+  toJSON(message: RingShapeParams): unknown {
+    const obj: any = {};
+    if (message.innerRadius !== undefined) {
+      obj.innerRadius = message.innerRadius;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<RingShapeParams>, I>>(base?: I): RingShapeParams {
+    return RingShapeParams.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<RingShapeParams>, I>>(object: I): RingShapeParams {
+    const message = createBaseRingShapeParams();
+    message.innerRadius = object.innerRadius ?? undefined;
+    return message;
+  },
+};
+
+function createBaseBarredSpiralShapeParams(): BarredSpiralShapeParams {
+  return {
+    numArms: undefined,
+    barLength: undefined,
+    barWidth: undefined,
+    barFraction: undefined,
+    armSpread: undefined,
+    twist: undefined,
+    diskFraction: undefined,
+  };
+}
+
+export const BarredSpiralShapeParams: MessageFns<BarredSpiralShapeParams> = {
+  encode(message: BarredSpiralShapeParams, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.numArms !== undefined) {
+      writer.uint32(8).int32(message.numArms);
+    }
+    if (message.barLength !== undefined) {
+      writer.uint32(17).double(message.barLength);
+    }
+    if (message.barWidth !== undefined) {
+      writer.uint32(25).double(message.barWidth);
+    }
+    if (message.barFraction !== undefined) {
+      writer.uint32(33).double(message.barFraction);
+    }
+    if (message.armSpread !== undefined) {
+      writer.uint32(41).double(message.armSpread);
+    }
+    if (message.twist !== undefined) {
+      writer.uint32(49).double(message.twist);
+    }
+    if (message.diskFraction !== undefined) {
+      writer.uint32(57).double(message.diskFraction);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): BarredSpiralShapeParams {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBarredSpiralShapeParams();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.numArms = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 17) {
+            break;
+          }
+
+          message.barLength = reader.double();
+          continue;
+        }
+        case 3: {
+          if (tag !== 25) {
             break;
           }
 
-          message.maxHyperlanes = reader.int32();
+          message.barWidth = reader.double();
           continue;
         }
         case 4: {
-          if (tag !== 32) {
+          if (tag !== 33) {
             break;
           }
 
-          message.hyperlaneConnectivity = reader.int32();
+          message.barFraction = reader.double();
+          continue;
+        }
+        case 5: {
+          if (tag !== 41) {
+            break;
+          }
+
+          message.armSpread = reader.double();
+          continue;
+        }
+        case 6: {
+          if (tag !== 49) {
+            break;
+          }
+
+          message.twist = reader.double();
+          continue;
+        }
+        case 7: {
+          if (tag !== 57) {
+            break;
+          }
+
+          message.diskFraction = reader.double();
           continue;
         }
       }
@@ -1472,41 +2950,298 @@ export const GalaxyGenerateSettings: MessageFns<GalaxyGenerateSettings> = {
     return message;
   },
 
-  fromJSON(object: any): GalaxyGenerateSettings {
+  fromJSON(object: any): BarredSpiralShapeParams {
     return {
-      numStars: isSet(object.numStars) ? globalThis.Number(object.numStars) : 0,
-      shape: isSet(object.shape) ? globalThis.String(object.shape) : "",
-      maxHyperlanes: isSet(object.maxHyperlanes) ? globalThis.Number(object.maxHyperlanes) : 0,
-      hyperlaneConnectivity: isSet(object.hyperlaneConnectivity) ? globalThis.Number(object.hyperlaneConnectivity) : 0,
+      numArms: isSet(object.numArms) ? globalThis.Number(object.numArms) : undefined,
+      barLength: isSet(object.barLength) ? globalThis.Number(object.barLength) : undefined,
+      barWidth: isSet(object.barWidth) ? globalThis.Number(object.barWidth) : undefined,
+      barFraction: isSet(object.barFraction) ? globalThis.Number(object.barFraction) : undefined,
+      armSpread: isSet(object.armSpread) ? globalThis.Number(object.armSpread) : undefined,
+      twist: isSet(object.twist) ? globalThis.Number(object.twist) : undefined,
+      diskFraction: isSet(object.diskFraction) ? globalThis.Number(object.diskFraction) : undefined,
     };
   },
 
-  toJSON(message: GalaxyGenerateSettings): unknown {
+  toJSON(message: BarredSpiralShapeParams): unknown {
     const obj: any = {};
-    if (message.numStars !== 0) {
-      obj.numStars = Math.round(message.numStars);
+    if (message.numArms !== undefined) {
+      obj.numArms = Math.round(message.numArms);
     }
-    if (message.shape !== "") {
-      obj.shape = message.shape;
+    if (message.barLength !== undefined) {
+      obj.barLength = message.barLength;
     }
-    if (message.maxHyperlanes !== 0) {
-      obj.maxHyperlanes = Math.round(message.maxHyperlanes);
+    if (message.barWidth !== undefined) {
+      obj.barWidth = message.barWidth;
     }
-    if (message.hyperlaneConnectivity !== 0) {
-      obj.hyperlaneConnectivity = Math.round(message.hyperlaneConnectivity);
+    if (message.barFraction !== undefined) {
+      obj.barFraction = message.barFraction;
+    }
+    if (message.armSpread !== undefined) {
+      obj.armSpread = message.armSpread;
+    }
+    if (message.twist !== undefined) {
+      obj.twist = message.twist;
+    }
+    if (message.diskFraction !== undefined) {
+      obj.diskFraction = message.diskFraction;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<GalaxyGenerateSettings>, I>>(base?: I): GalaxyGenerateSettings {
-    return GalaxyGenerateSettings.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<BarredSpiralShapeParams>, I>>(base?: I): BarredSpiralShapeParams {
+    return BarredSpiralShapeParams.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<GalaxyGenerateSettings>, I>>(object: I): GalaxyGenerateSettings {
-    const message = createBaseGalaxyGenerateSettings();
-    message.numStars = object.numStars ?? 0;
-    message.shape = object.shape ?? "";
-    message.maxHyperlanes = object.maxHyperlanes ?? 0;
-    message.hyperlaneConnectivity = object.hyperlaneConnectivity ?? 0;
+  fromPartial<I extends Exact<DeepPartial<BarredSpiralShapeParams>, I>>(object: I): BarredSpiralShapeParams {
+    const message = createBaseBarredSpiralShapeParams();
+    message.numArms = object.numArms ?? undefined;
+    message.barLength = object.barLength ?? undefined;
+    message.barWidth = object.barWidth ?? undefined;
+    message.barFraction = object.barFraction ?? undefined;
+    message.armSpread = object.armSpread ?? undefined;
+    message.twist = object.twist ?? undefined;
+    message.diskFraction = object.diskFraction ?? undefined;
+    return message;
+  },
+};
+
+function createBaseClusteredShapeParams(): ClusteredShapeParams {
+  return { numClusters: undefined, clusterRadius: undefined, bridgeSpacing: undefined };
+}
+
+export const ClusteredShapeParams: MessageFns<ClusteredShapeParams> = {
+  encode(message: ClusteredShapeParams, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.numClusters !== undefined) {
+      writer.uint32(8).int32(message.numClusters);
+    }
+    if (message.clusterRadius !== undefined) {
+      writer.uint32(17).double(message.clusterRadius);
+    }
+    if (message.bridgeSpacing !== undefined) {
+      writer.uint32(25).double(message.bridgeSpacing);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ClusteredShapeParams {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseClusteredShapeParams();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.numClusters = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 17) {
+            break;
+          }
+
+          message.clusterRadius = reader.double();
+          continue;
+        }
+        case 3: {
+          if (tag !== 25) {
+            break;
+          }
+
+          message.bridgeSpacing = reader.double();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ClusteredShapeParams {
+    return {
+      numClusters: isSet(object.numClusters) ? globalThis.Number(object.numClusters) : undefined,
+      clusterRadius: isSet(object.clusterRadius) ? globalThis.Number(object.clusterRadius) : undefined,
+      bridgeSpacing: isSet(object.bridgeSpacing) ? globalThis.Number(object.bridgeSpacing) : undefined,
+    };
+  },
+
+  toJSON(message: ClusteredShapeParams): unknown {
+    const obj: any = {};
+    if (message.numClusters !== undefined) {
+      obj.numClusters = Math.round(message.numClusters);
+    }
+    if (message.clusterRadius !== undefined) {
+      obj.clusterRadius = message.clusterRadius;
+    }
+    if (message.bridgeSpacing !== undefined) {
+      obj.bridgeSpacing = message.bridgeSpacing;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ClusteredShapeParams>, I>>(base?: I): ClusteredShapeParams {
+    return ClusteredShapeParams.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ClusteredShapeParams>, I>>(object: I): ClusteredShapeParams {
+    const message = createBaseClusteredShapeParams();
+    message.numClusters = object.numClusters ?? undefined;
+    message.clusterRadius = object.clusterRadius ?? undefined;
+    message.bridgeSpacing = object.bridgeSpacing ?? undefined;
+    return message;
+  },
+};
+
+function createBaseIrregularShapeParams(): IrregularShapeParams {
+  return { irregularity: undefined, numClumps: undefined, clumpRadius: undefined };
+}
+
+export const IrregularShapeParams: MessageFns<IrregularShapeParams> = {
+  encode(message: IrregularShapeParams, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.irregularity !== undefined) {
+      writer.uint32(9).double(message.irregularity);
+    }
+    if (message.numClumps !== undefined) {
+      writer.uint32(16).int32(message.numClumps);
+    }
+    if (message.clumpRadius !== undefined) {
+      writer.uint32(25).double(message.clumpRadius);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): IrregularShapeParams {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIrregularShapeParams();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 9) {
+            break;
+          }
+
+          message.irregularity = reader.double();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.numClumps = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 25) {
+            break;
+          }
+
+          message.clumpRadius = reader.double();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IrregularShapeParams {
+    return {
+      irregularity: isSet(object.irregularity) ? globalThis.Number(object.irregularity) : undefined,
+      numClumps: isSet(object.numClumps) ? globalThis.Number(object.numClumps) : undefined,
+      clumpRadius: isSet(object.clumpRadius) ? globalThis.Number(object.clumpRadius) : undefined,
+    };
+  },
+
+  toJSON(message: IrregularShapeParams): unknown {
+    const obj: any = {};
+    if (message.irregularity !== undefined) {
+      obj.irregularity = message.irregularity;
+    }
+    if (message.numClumps !== undefined) {
+      obj.numClumps = Math.round(message.numClumps);
+    }
+    if (message.clumpRadius !== undefined) {
+      obj.clumpRadius = message.clumpRadius;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<IrregularShapeParams>, I>>(base?: I): IrregularShapeParams {
+    return IrregularShapeParams.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<IrregularShapeParams>, I>>(object: I): IrregularShapeParams {
+    const message = createBaseIrregularShapeParams();
+    message.irregularity = object.irregularity ?? undefined;
+    message.numClumps = object.numClumps ?? undefined;
+    message.clumpRadius = object.clumpRadius ?? undefined;
+    return message;
+  },
+};
+
+function createBaseResyncCommand(): ResyncCommand {
+  return { lastSequence: 0 };
+}
+
+export const ResyncCommand: MessageFns<ResyncCommand> = {
+  encode(message: ResyncCommand, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.lastSequence !== 0) {
+      writer.uint32(8).uint64(message.lastSequence);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ResyncCommand {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResyncCommand();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.lastSequence = longToNumber(reader.uint64());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResyncCommand {
+    return { lastSequence: isSet(object.lastSequence) ? globalThis.Number(object.lastSequence) : 0 };
+  },
+
+  toJSON(message: ResyncCommand): unknown {
+    const obj: any = {};
+    if (message.lastSequence !== 0) {
+      obj.lastSequence = Math.round(message.lastSequence);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ResyncCommand>, I>>(base?: I): ResyncCommand {
+    return ResyncCommand.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ResyncCommand>, I>>(object: I): ResyncCommand {
+    const message = createBaseResyncCommand();
+    message.lastSequence = object.lastSequence ?? 0;
     return message;
   },
 };
@@ -59,6 +59,7 @@ export enum LobbyStateMessage_LobbyStatus {
   WAITING = 0,
   STARTING = 1,
   IN_GAME = 2,
+  ENDED = 3,
   UNRECOGNIZED = -1,
 }
 
@@ -73,6 +74,9 @@ export function lobbyStateMessage_LobbyStatusFromJSON(object: any): LobbyStateMe
     case 2:
     case "IN_GAME":
       return LobbyStateMessage_LobbyStatus.IN_GAME;
+    case 3:
+    case "ENDED":
+      return LobbyStateMessage_LobbyStatus.ENDED;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -88,6 +92,8 @@ export function lobbyStateMessage_LobbyStatusToJSON(object: LobbyStateMessage_Lo
       return "STARTING";
     case LobbyStateMessage_LobbyStatus.IN_GAME:
       return "IN_GAME";
+    case LobbyStateMessage_LobbyStatus.ENDED:
+      return "ENDED";
     case LobbyStateMessage_LobbyStatus.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -141,6 +147,9 @@ export interface GameMessage {
   gameState?: GameStateMessage | undefined;
   gameEvent?: GameEventMessage | undefined;
   turnUpdate?: TurnUpdateMessage | undefined;
+  snapshot?: GameSnapshotMessage | undefined;
+  delta?: GameDeltaMessage | undefined;
+  controlVote?: ControlVoteMessage | undefined;
 }
 
 export interface GameStateMessage {
@@ -156,12 +165,183 @@ export interface GameEventMessage {
   /** JSON event data */
   eventData: string;
   affectedPlayers: string[];
+  /** Position in the player's update stream */
+  sequence: number;
 }
 
+/**
+ * Everything a player can see, sent when they connect or ask for a resync.
+ * Deltas with a sequence number up to and including `sequence` are already
+ * applied; the next delta the client expects is sequence + 1.
+ */
+export interface GameSnapshotMessage {
+  sequence: number;
+  tick: number;
+  gameTime: number;
+  empire:
+    | EmpireSnapshot
+    | undefined;
+  /** Every system, contents only for systems seen */
+  systems: SystemSnapshot[];
+  /** Own fleets and fleets in or last seen in known systems */
+  fleets: FleetSnapshot[];
+  date:
+    | GameDate
+    | undefined;
+  /** In-game years per real hour */
+  yearsPerHour: number;
+  isPaused: boolean;
+}
+
+export interface EmpireSnapshot {
+  empireId: string;
+  name: string;
+  color: string;
+  homeSystemId: number;
+  resources:
+    | ResourceAmounts
+    | undefined;
+  /** Researched technologies */
+  technologies: string[];
+  researchQueue: string[];
+}
+
+export interface ResourceAmounts {
+  credits: number;
+  minerals: number;
+  energy: number;
+  research: number;
+  population: number;
+}
+
+export interface SystemSnapshot {
+  id: number;
+  name: string;
+  starType: string;
+  x: number;
+  y: number;
+  hyperlanes: number[];
+  planets: PlanetSnapshot[];
+  /** Empty if unowned or never seen */
+  ownerEmpireId: string;
+  /** In vision right now */
+  visible: boolean;
+  /** Unix seconds, 0 if never seen */
+  lastSeen: number;
+}
+
+export interface PlanetSnapshot {
+  id: number;
+  name: string;
+  type: string;
+  size: number;
+  population: number;
+  /** Only set for the player's own colonies */
+  colonyId: number;
+}
+
+export interface FleetSnapshot {
+  id: number;
+  ownerEmpireId: string;
+  name: string;
+  ships: { [key: string]: number };
+  locationId: number;
+  /** 0 if not travelling */
+  destinationId: number;
+  /** In-game day, see GameDate.elapsedDays */
+  arrivalTime: number;
+  /** Taken from intel on a system out of sight */
+  lastKnown: boolean;
+}
+
+export interface FleetSnapshot_ShipsEntry {
+  key: string;
+  value: number;
+}
+
+/** A single change to the state sent in the last snapshot */
+export interface GameDeltaMessage {
+  sequence: number;
+  tick: number;
+  fleetMoved?: FleetMovedDelta | undefined;
+  fleetArrived?: FleetArrivedDelta | undefined;
+  systemOwnerChanged?: SystemOwnerChangedDelta | undefined;
+  resourcesChanged?: ResourcesChangedDelta | undefined;
+  visionChanged?: VisionChangedDelta | undefined;
+}
+
+export interface FleetMovedDelta {
+  fleet: FleetSnapshot | undefined;
+  fromSystemId: number;
+  toSystemId: number;
+  /** In-game day */
+  arrivalTime: number;
+}
+
+export interface FleetArrivedDelta {
+  fleet: FleetSnapshot | undefined;
+  systemId: number;
+}
+
+export interface SystemOwnerChangedDelta {
+  systemId: number;
+  previousOwnerEmpireId: string;
+  ownerEmpireId: string;
+}
+
+export interface ResourcesChangedDelta {
+  resources:
+    | ResourceAmounts
+    | undefined;
+  /** Net income of the last economy update */
+  income: ResourceAmounts | undefined;
+}
+
+export interface VisionChangedDelta {
+  revealed: SystemSnapshot[];
+  /** Fleets in the revealed systems */
+  fleets: FleetSnapshot[];
+  /** Systems that went out of sight */
+  hidden: number[];
+}
+
+/** Sent to every player when a new in-game day starts */
 export interface TurnUpdateMessage {
   turnNumber: number;
   turnDeadline: number;
   isPaused: boolean;
+  date:
+    | GameDate
+    | undefined;
+  /** In-game years per real hour */
+  yearsPerHour: number;
+  /** "host", "vote" or "maintenance" while paused */
+  pauseReason: string;
+}
+
+/**
+ * Progress of a vote to pause, resume or change the speed of the game. Sent
+ * to every player when someone votes; the vote passes once votes reaches
+ * required.
+ */
+export interface ControlVoteMessage {
+  /** "pause", "resume" or "speed" */
+  action: string;
+  /** Proposed speed of a "speed" vote */
+  yearsPerHour: number;
+  votes: number;
+  required: number;
+  /** Player who just voted */
+  playerId: string;
+}
+
+/** A date on the in-game calendar of 12 months of 30 days */
+export interface GameDate {
+  year: number;
+  month: number;
+  day: number;
+  /** In-game days since the start of the game */
+  elapsedDays: number;
 }
 
 export interface ChatMessage {
@@ -197,6 +377,7 @@ export interface SystemMessage {
   connection?: ConnectionMessage | undefined;
   auth?: AuthMessage | undefined;
   serverStatus?: ServerStatusMessage | undefined;
+  notifications?: NotificationsMessage | undefined;
 }
 
 export interface ConnectionMessage {
@@ -217,6 +398,26 @@ export interface ServerStatusMessage {
   playerCount: number;
 }
 
+/** Unread inbox entries - sent when a player connects */
+export interface NotificationsMessage {
+  notifications: Notification[];
+  /** May exceed the number of notifications sent */
+  unreadCount: number;
+}
+
+export interface Notification {
+  id: string;
+  /** "battle", "build_complete", "system_lost", "private_chat" */
+  kind: string;
+  title: string;
+  body: string;
+  /** Empty for notifications outside of a game */
+  sessionId: string;
+  /** Unix seconds */
+  createdAt: number;
+  read: boolean;
+}
+
 export interface ErrorMessage {
   /** "LOBBY_FULL", "INVALID_COMMAND", etc. */
   errorCode: string;
@@ -1324,7 +1525,14 @@ export const GameLoadingMessage: MessageFns<GameLoadingMessage> = {
 };
 
 function createBaseGameMessage(): GameMessage {
-  return { gameState: undefined, gameEvent: undefined, turnUpdate: undefined };
+  return {
+    gameState: undefined,
+    gameEvent: undefined,
+    turnUpdate: undefined,
+    snapshot: undefined,
+    delta: undefined,
+    controlVote: undefined,
+  };
 }
 
 export const GameMessage: MessageFns<GameMessage> = {
@@ -1338,6 +1546,15 @@ export const GameMessage: MessageFns<GameMessage> = {
     if (message.turnUpdate !== undefined) {
       TurnUpdateMessage.encode(message.turnUpdate, writer.uint32(26).fork()).join();
     }
+    if (message.snapshot !== undefined) {
+      GameSnapshotMessage.encode(message.snapshot, writer.uint32(34).fork()).join();
+    }
+    if (message.delta !== undefined) {
+      GameDeltaMessage.encode(message.delta, writer.uint32(42).fork()).join();
+    }
+    if (message.controlVote !== undefined) {
+      ControlVoteMessage.encode(message.controlVote, writer.uint32(50).fork()).join();
+    }
     return writer;
   },
 
@@ -1372,6 +1589,30 @@ export const GameMessage: MessageFns<GameMessage> = {
           message.turnUpdate = TurnUpdateMessage.decode(reader, reader.uint32());
           continue;
         }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.snapshot = GameSnapshotMessage.decode(reader, reader.uint32());
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.delta = GameDeltaMessage.decode(reader, reader.uint32());
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.controlVote = ControlVoteMessage.decode(reader, reader.uint32());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1386,6 +1627,9 @@ export const GameMessage: MessageFns<GameMessage> = {
       gameState: isSet(object.gameState) ? GameStateMessage.fromJSON(object.gameState) : undefined,
       gameEvent: isSet(object.gameEvent) ? GameEventMessage.fromJSON(object.gameEvent) : undefined,
       turnUpdate: isSet(object.turnUpdate) ? TurnUpdateMessage.fromJSON(object.turnUpdate) : undefined,
+      snapshot: isSet(object.snapshot) ? GameSnapshotMessage.fromJSON(object.snapshot) : undefined,
+      delta: isSet(object.delta) ? GameDeltaMessage.fromJSON(object.delta) : undefined,
+      controlVote: isSet(object.controlVote) ? ControlVoteMessage.fromJSON(object.controlVote) : undefined,
     };
   },
 
@@ -1400,6 +1644,15 @@ export const GameMessage: MessageFns<GameMessage> = {
     if (message.turnUpdate !== undefined) {
       obj.turnUpdate = TurnUpdateMessage.toJSON(message.turnUpdate);
     }
+    if (message.snapshot !== undefined) {
+      obj.snapshot = GameSnapshotMessage.toJSON(message.snapshot);
+    }
+    if (message.delta !== undefined) {
+      obj.delta = GameDeltaMessage.toJSON(message.delta);
+    }
+    if (message.controlVote !== undefined) {
+      obj.controlVote = ControlVoteMessage.toJSON(message.controlVote);
+    }
     return obj;
   },
 
@@ -1417,6 +1670,15 @@ export const GameMessage: MessageFns<GameMessage> = {
     message.turnUpdate = (object.turnUpdate !== undefined && object.turnUpdate !== null)
       ? TurnUpdateMessage.fromPartial(object.turnUpdate)
       : undefined;
+    message.snapshot = (object.snapshot !== undefined && object.snapshot !== null)
+      ? GameSnapshotMessage.fromPartial(object.snapshot)
+      : undefined;
+    message.delta = (object.delta !== undefined && object.delta !== null)
+      ? GameDeltaMessage.fromPartial(object.delta)
+      : undefined;
+    message.controlVote = (object.controlVote !== undefined && object.controlVote !== null)
+      ? ControlVoteMessage.fromPartial(object.controlVote)
+      : undefined;
     return message;
   },
 };
@@ -1514,7 +1776,7 @@ export const GameStateMessage: MessageFns<GameStateMessage> = {
 };
 
 function createBaseGameEventMessage(): GameEventMessage {
-  return { eventType: "", eventData: "", affectedPlayers: [] };
+  return { eventType: "", eventData: "", affectedPlayers: [], sequence: 0 };
 }
 
 export const GameEventMessage: MessageFns<GameEventMessage> = {
@@ -1528,6 +1790,9 @@ export const GameEventMessage: MessageFns<GameEventMessage> = {
     for (const v of message.affectedPlayers) {
       writer.uint32(26).string(v!);
     }
+    if (message.sequence !== 0) {
+      writer.uint32(32).uint64(message.sequence);
+    }
     return writer;
   },
 
@@ -1562,6 +1827,14 @@ export const GameEventMessage: MessageFns<GameEventMessage> = {
           message.affectedPlayers.push(reader.string());
           continue;
         }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.sequence = longToNumber(reader.uint64());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1578,6 +1851,7 @@ export const GameEventMessage: MessageFns<GameEventMessage> = {
       affectedPlayers: globalThis.Array.isArray(object?.affectedPlayers)
         ? object.affectedPlayers.map((e: any) => globalThis.String(e))
         : [],
+      sequence: isSet(object.sequence) ? globalThis.Number(object.sequence) : 0,
     };
   },
 
@@ -1592,6 +1866,9 @@ export const GameEventMessage: MessageFns<GameEventMessage> = {
     if (message.affectedPlayers?.length) {
       obj.affectedPlayers = message.affectedPlayers;
     }
+    if (message.sequence !== 0) {
+      obj.sequence = Math.round(message.sequence);
+    }
     return obj;
   },
 
@@ -1603,32 +1880,61 @@ export const GameEventMessage: MessageFns<GameEventMessage> = {
     message.eventType = object.eventType ?? "";
     message.eventData = object.eventData ?? "";
     message.affectedPlayers = object.affectedPlayers?.map((e) => e) || [];
+    message.sequence = object.sequence ?? 0;
     return message;
   },
 };
 
-function createBaseTurnUpdateMessage(): TurnUpdateMessage {
-  return { turnNumber: 0, turnDeadline: 0, isPaused: false };
+function createBaseGameSnapshotMessage(): GameSnapshotMessage {
+  return {
+    sequence: 0,
+    tick: 0,
+    gameTime: 0,
+    empire: undefined,
+    systems: [],
+    fleets: [],
+    date: undefined,
+    yearsPerHour: 0,
+    isPaused: false,
+  };
 }
 
-export const TurnUpdateMessage: MessageFns<TurnUpdateMessage> = {
-  encode(message: TurnUpdateMessage, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.turnNumber !== 0) {
-      writer.uint32(8).int64(message.turnNumber);
+export const GameSnapshotMessage: MessageFns<GameSnapshotMessage> = {
+  encode(message: GameSnapshotMessage, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.sequence !== 0) {
+      writer.uint32(8).uint64(message.sequence);
     }
-    if (message.turnDeadline !== 0) {
-      writer.uint32(16).int64(message.turnDeadline);
+    if (message.tick !== 0) {
+      writer.uint32(16).int64(message.tick);
+    }
+    if (message.gameTime !== 0) {
+      writer.uint32(24).int64(message.gameTime);
+    }
+    if (message.empire !== undefined) {
+      EmpireSnapshot.encode(message.empire, writer.uint32(34).fork()).join();
+    }
+    for (const v of message.systems) {
+      SystemSnapshot.encode(v!, writer.uint32(42).fork()).join();
+    }
+    for (const v of message.fleets) {
+      FleetSnapshot.encode(v!, writer.uint32(50).fork()).join();
+    }
+    if (message.date !== undefined) {
+      GameDate.encode(message.date, writer.uint32(58).fork()).join();
+    }
+    if (message.yearsPerHour !== 0) {
+      writer.uint32(65).double(message.yearsPerHour);
     }
     if (message.isPaused !== false) {
-      writer.uint32(24).bool(message.isPaused);
+      writer.uint32(72).bool(message.isPaused);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): TurnUpdateMessage {
+  decode(input: BinaryReader | Uint8Array, length?: number): GameSnapshotMessage {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseTurnUpdateMessage();
+    const message = createBaseGameSnapshotMessage();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1637,7 +1943,7 @@ export const TurnUpdateMessage: MessageFns<TurnUpdateMessage> = {
             break;
           }
 
-          message.turnNumber = longToNumber(reader.int64());
+          message.sequence = longToNumber(reader.uint64());
           continue;
         }
         case 2: {
@@ -1645,7 +1951,7 @@ export const TurnUpdateMessage: MessageFns<TurnUpdateMessage> = {
             break;
           }
 
-          message.turnDeadline = longToNumber(reader.int64());
+          message.tick = longToNumber(reader.int64());
           continue;
         }
         case 3: {
@@ -1653,6 +1959,54 @@ export const TurnUpdateMessage: MessageFns<TurnUpdateMessage> = {
             break;
           }
 
+          message.gameTime = longToNumber(reader.int64());
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.empire = EmpireSnapshot.decode(reader, reader.uint32());
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.systems.push(SystemSnapshot.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.fleets.push(FleetSnapshot.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.date = GameDate.decode(reader, reader.uint32());
+          continue;
+        }
+        case 8: {
+          if (tag !== 65) {
+            break;
+          }
+
+          message.yearsPerHour = reader.double();
+          continue;
+        }
+        case 9: {
+          if (tag !== 72) {
+            break;
+          }
+
           message.isPaused = reader.bool();
           continue;
         }
@@ -1665,21 +2019,47 @@ export const TurnUpdateMessage: MessageFns<TurnUpdateMessage> = {
     return message;
   },
 
-  fromJSON(object: any): TurnUpdateMessage {
+  fromJSON(object: any): GameSnapshotMessage {
     return {
-      turnNumber: isSet(object.turnNumber) ? globalThis.Number(object.turnNumber) : 0,
-      turnDeadline: isSet(object.turnDeadline) ? globalThis.Number(object.turnDeadline) : 0,
+      sequence: isSet(object.sequence) ? globalThis.Number(object.sequence) : 0,
+      tick: isSet(object.tick) ? globalThis.Number(object.tick) : 0,
+      gameTime: isSet(object.gameTime) ? globalThis.Number(object.gameTime) : 0,
+      empire: isSet(object.empire) ? EmpireSnapshot.fromJSON(object.empire) : undefined,
+      systems: globalThis.Array.isArray(object?.systems)
+        ? object.systems.map((e: any) => SystemSnapshot.fromJSON(e))
+        : [],
+      fleets: globalThis.Array.isArray(object?.fleets) ? object.fleets.map((e: any) => FleetSnapshot.fromJSON(e)) : [],
+      date: isSet(object.date) ? GameDate.fromJSON(object.date) : undefined,
+      yearsPerHour: isSet(object.yearsPerHour) ? globalThis.Number(object.yearsPerHour) : 0,
       isPaused: isSet(object.isPaused) ? globalThis.Boolean(object.isPaused) : false,
     };
   },
 
-  toJSON(message: TurnUpdateMessage): unknown {
+  toJSON(message: GameSnapshotMessage): unknown {
     const obj: any = {};
-    if (message.turnNumber !== 0) {
-      obj.turnNumber = Math.round(message.turnNumber);
+    if (message.sequence !== 0) {
+      obj.sequence = Math.round(message.sequence);
     }
-    if (message.turnDeadline !== 0) {
-      obj.turnDeadline = Math.round(message.turnDeadline);
+    if (message.tick !== 0) {
+      obj.tick = Math.round(message.tick);
+    }
+    if (message.gameTime !== 0) {
+      obj.gameTime = Math.round(message.gameTime);
+    }
+    if (message.empire !== undefined) {
+      obj.empire = EmpireSnapshot.toJSON(message.empire);
+    }
+    if (message.systems?.length) {
+      obj.systems = message.systems.map((e) => SystemSnapshot.toJSON(e));
+    }
+    if (message.fleets?.length) {
+      obj.fleets = message.fleets.map((e) => FleetSnapshot.toJSON(e));
+    }
+    if (message.date !== undefined) {
+      obj.date = GameDate.toJSON(message.date);
+    }
+    if (message.yearsPerHour !== 0) {
+      obj.yearsPerHour = message.yearsPerHour;
     }
     if (message.isPaused !== false) {
       obj.isPaused = message.isPaused;
@@ -1687,60 +2067,70 @@ export const TurnUpdateMessage: MessageFns<TurnUpdateMessage> = {
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<TurnUpdateMessage>, I>>(base?: I): TurnUpdateMessage {
-    return TurnUpdateMessage.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<GameSnapshotMessage>, I>>(base?: I): GameSnapshotMessage {
+    return GameSnapshotMessage.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<TurnUpdateMessage>, I>>(object: I): TurnUpdateMessage {
-    const message = createBaseTurnUpdateMessage();
-    message.turnNumber = object.turnNumber ?? 0;
-    message.turnDeadline = object.turnDeadline ?? 0;
+  fromPartial<I extends Exact<DeepPartial<GameSnapshotMessage>, I>>(object: I): GameSnapshotMessage {
+    const message = createBaseGameSnapshotMessage();
+    message.sequence = object.sequence ?? 0;
+    message.tick = object.tick ?? 0;
+    message.gameTime = object.gameTime ?? 0;
+    message.empire = (object.empire !== undefined && object.empire !== null)
+      ? EmpireSnapshot.fromPartial(object.empire)
+      : undefined;
+    message.systems = object.systems?.map((e) => SystemSnapshot.fromPartial(e)) || [];
+    message.fleets = object.fleets?.map((e) => FleetSnapshot.fromPartial(e)) || [];
+    message.date = (object.date !== undefined && object.date !== null)
+      ? GameDate.fromPartial(object.date)
+      : undefined;
+    message.yearsPerHour = object.yearsPerHour ?? 0;
     message.isPaused = object.isPaused ?? false;
     return message;
   },
 };
 
-function createBaseChatMessage(): ChatMessage {
+function createBaseEmpireSnapshot(): EmpireSnapshot {
   return {
-    senderId: "",
-    senderDisplayName: "",
-    timestamp: 0,
-    global: undefined,
-    private: undefined,
-    lobby: undefined,
-    system: undefined,
+    empireId: "",
+    name: "",
+    color: "",
+    homeSystemId: 0,
+    resources: undefined,
+    technologies: [],
+    researchQueue: [],
   };
 }
 
-export const ChatMessage: MessageFns<ChatMessage> = {
-  encode(message: ChatMessage, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.senderId !== "") {
-      writer.uint32(10).string(message.senderId);
+export const EmpireSnapshot: MessageFns<EmpireSnapshot> = {
+  encode(message: EmpireSnapshot, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.empireId !== "") {
+      writer.uint32(10).string(message.empireId);
     }
-    if (message.senderDisplayName !== "") {
-      writer.uint32(18).string(message.senderDisplayName);
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
     }
-    if (message.timestamp !== 0) {
-      writer.uint32(24).int64(message.timestamp);
+    if (message.color !== "") {
+      writer.uint32(26).string(message.color);
     }
-    if (message.global !== undefined) {
-      GlobalChatMessage.encode(message.global, writer.uint32(82).fork()).join();
+    if (message.homeSystemId !== 0) {
+      writer.uint32(32).uint64(message.homeSystemId);
     }
-    if (message.private !== undefined) {
-      PrivateChatMessage.encode(message.private, writer.uint32(90).fork()).join();
+    if (message.resources !== undefined) {
+      ResourceAmounts.encode(message.resources, writer.uint32(42).fork()).join();
     }
-    if (message.lobby !== undefined) {
-      LobbyChatMessage.encode(message.lobby, writer.uint32(98).fork()).join();
+    for (const v of message.technologies) {
+      writer.uint32(50).string(v!);
     }
-    if (message.system !== undefined) {
-      SystemChatMessage.encode(message.system, writer.uint32(106).fork()).join();
+    for (const v of message.researchQueue) {
+      writer.uint32(58).string(v!);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ChatMessage {
+  decode(input: BinaryReader | Uint8Array, length?: number): EmpireSnapshot {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseChatMessage();
+    const message = createBaseEmpireSnapshot();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1749,7 +2139,7 @@ export const ChatMessage: MessageFns<ChatMessage> = {
             break;
           }
 
-          message.senderId = reader.string();
+          message.empireId = reader.string();
           continue;
         }
         case 2: {
@@ -1757,47 +2147,47 @@ export const ChatMessage: MessageFns<ChatMessage> = {
             break;
           }
 
-          message.senderDisplayName = reader.string();
+          message.name = reader.string();
           continue;
         }
         case 3: {
-          if (tag !== 24) {
+          if (tag !== 26) {
             break;
           }
 
-          message.timestamp = longToNumber(reader.int64());
+          message.color = reader.string();
           continue;
         }
-        case 10: {
-          if (tag !== 82) {
+        case 4: {
+          if (tag !== 32) {
             break;
           }
 
-          message.global = GlobalChatMessage.decode(reader, reader.uint32());
+          message.homeSystemId = longToNumber(reader.uint64());
           continue;
         }
-        case 11: {
-          if (tag !== 90) {
+        case 5: {
+          if (tag !== 42) {
             break;
           }
 
-          message.private = PrivateChatMessage.decode(reader, reader.uint32());
+          message.resources = ResourceAmounts.decode(reader, reader.uint32());
           continue;
         }
-        case 12: {
-          if (tag !== 98) {
+        case 6: {
+          if (tag !== 50) {
             break;
           }
 
-          message.lobby = LobbyChatMessage.decode(reader, reader.uint32());
+          message.technologies.push(reader.string());
           continue;
         }
-        case 13: {
-          if (tag !== 106) {
+        case 7: {
+          if (tag !== 58) {
             break;
           }
 
-          message.system = SystemChatMessage.decode(reader, reader.uint32());
+          message.researchQueue.push(reader.string());
           continue;
         }
       }
@@ -1809,45 +2199,2004 @@ export const ChatMessage: MessageFns<ChatMessage> = {
     return message;
   },
 
-  fromJSON(object: any): ChatMessage {
+  fromJSON(object: any): EmpireSnapshot {
     return {
-      senderId: isSet(object.senderId) ? globalThis.String(object.senderId) : "",
-      senderDisplayName: isSet(object.senderDisplayName) ? globalThis.String(object.senderDisplayName) : "",
-      timestamp: isSet(object.timestamp) ? globalThis.Number(object.timestamp) : 0,
-      global: isSet(object.global) ? GlobalChatMessage.fromJSON(object.global) : undefined,
-      private: isSet(object.private) ? PrivateChatMessage.fromJSON(object.private) : undefined,
-      lobby: isSet(object.lobby) ? LobbyChatMessage.fromJSON(object.lobby) : undefined,
-      system: isSet(object.system) ? SystemChatMessage.fromJSON(object.system) : undefined,
+      empireId: isSet(object.empireId) ? globalThis.String(object.empireId) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      color: isSet(object.color) ? globalThis.String(object.color) : "",
+      homeSystemId: isSet(object.homeSystemId) ? globalThis.Number(object.homeSystemId) : 0,
+      resources: isSet(object.resources) ? ResourceAmounts.fromJSON(object.resources) : undefined,
+      technologies: globalThis.Array.isArray(object?.technologies)
+        ? object.technologies.map((e: any) => globalThis.String(e))
+        : [],
+      researchQueue: globalThis.Array.isArray(object?.researchQueue)
+        ? object.researchQueue.map((e: any) => globalThis.String(e))
+        : [],
     };
   },
 
-  toJSON(message: ChatMessage): unknown {
+  toJSON(message: EmpireSnapshot): unknown {
     const obj: any = {};
-    if (message.senderId !== "") {
-      obj.senderId = message.senderId;
+    if (message.empireId !== "") {
+      obj.empireId = message.empireId;
     }
-    if (message.senderDisplayName !== "") {
-      obj.senderDisplayName = message.senderDisplayName;
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.timestamp !== 0) {
-      obj.timestamp = Math.round(message.timestamp);
+    if (message.color !== "") {
+      obj.color = message.color;
     }
-    if (message.global !== undefined) {
-      obj.global = GlobalChatMessage.toJSON(message.global);
+    if (message.homeSystemId !== 0) {
+      obj.homeSystemId = Math.round(message.homeSystemId);
     }
-    if (message.private !== undefined) {
-      obj.private = PrivateChatMessage.toJSON(message.private);
+    if (message.resources !== undefined) {
+      obj.resources = ResourceAmounts.toJSON(message.resources);
     }
-    if (message.lobby !== undefined) {
-      obj.lobby = LobbyChatMessage.toJSON(message.lobby);
+    if (message.technologies?.length) {
+      obj.technologies = message.technologies;
     }
-    if (message.system !== undefined) {
-      obj.system = SystemChatMessage.toJSON(message.system);
+    if (message.researchQueue?.length) {
+      obj.researchQueue = message.researchQueue;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ChatMessage>, I>>(base?: I): ChatMessage {
+  create<I extends Exact<DeepPartial<EmpireSnapshot>, I>>(base?: I): EmpireSnapshot {
+    return EmpireSnapshot.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<EmpireSnapshot>, I>>(object: I): EmpireSnapshot {
+    const message = createBaseEmpireSnapshot();
+    message.empireId = object.empireId ?? "";
+    message.name = object.name ?? "";
+    message.color = object.color ?? "";
+    message.homeSystemId = object.homeSystemId ?? 0;
+    message.resources = (object.resources !== undefined && object.resources !== null)
+      ? ResourceAmounts.fromPartial(object.resources)
+      : undefined;
+    message.technologies = object.technologies?.map((e) => e) || [];
+    message.researchQueue = object.researchQueue?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseResourceAmounts(): ResourceAmounts {
+  return { credits: 0, minerals: 0, energy: 0, research: 0, population: 0 };
+}
+
+export const ResourceAmounts: MessageFns<ResourceAmounts> = {
+  encode(message: ResourceAmounts, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.credits !== 0) {
+      writer.uint32(8).int64(message.credits);
+    }
+    if (message.minerals !== 0) {
+      writer.uint32(16).int64(message.minerals);
+    }
+    if (message.energy !== 0) {
+      writer.uint32(24).int64(message.energy);
+    }
+    if (message.research !== 0) {
+      writer.uint32(32).int64(message.research);
+    }
+    if (message.population !== 0) {
+      writer.uint32(40).int64(message.population);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ResourceAmounts {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResourceAmounts();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.credits = longToNumber(reader.int64());
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.minerals = longToNumber(reader.int64());
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.energy = longToNumber(reader.int64());
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.research = longToNumber(reader.int64());
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.population = longToNumber(reader.int64());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResourceAmounts {
+    return {
+      credits: isSet(object.credits) ? globalThis.Number(object.credits) : 0,
+      minerals: isSet(object.minerals) ? globalThis.Number(object.minerals) : 0,
+      energy: isSet(object.energy) ? globalThis.Number(object.energy) : 0,
+      research: isSet(object.research) ? globalThis.Number(object.research) : 0,
+      population: isSet(object.population) ? globalThis.Number(object.population) : 0,
+    };
+  },
+
+  toJSON(message: ResourceAmounts): unknown {
+    const obj: any = {};
+    if (message.credits !== 0) {
+      obj.credits = Math.round(message.credits);
+    }
+    if (message.minerals !== 0) {
+      obj.minerals = Math.round(message.minerals);
+    }
+    if (message.energy !== 0) {
+      obj.energy = Math.round(message.energy);
+    }
+    if (message.research !== 0) {
+      obj.research = Math.round(message.research);
+    }
+    if (message.population !== 0) {
+      obj.population = Math.round(message.population);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ResourceAmounts>, I>>(base?: I): ResourceAmounts {
+    return ResourceAmounts.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ResourceAmounts>, I>>(object: I): ResourceAmounts {
+    const message = createBaseResourceAmounts();
+    message.credits = object.credits ?? 0;
+    message.minerals = object.minerals ?? 0;
+    message.energy = object.energy ?? 0;
+    message.research = object.research ?? 0;
+    message.population = object.population ?? 0;
+    return message;
+  },
+};
+
+function createBaseSystemSnapshot(): SystemSnapshot {
+  return {
+    id: 0,
+    name: "",
+    starType: "",
+    x: 0,
+    y: 0,
+    hyperlanes: [],
+    planets: [],
+    ownerEmpireId: "",
+    visible: false,
+    lastSeen: 0,
+  };
+}
+
+export const SystemSnapshot: MessageFns<SystemSnapshot> = {
+  encode(message: SystemSnapshot, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint64(message.id);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.starType !== "") {
+      writer.uint32(26).string(message.starType);
+    }
+    if (message.x !== 0) {
+      writer.uint32(33).double(message.x);
+    }
+    if (message.y !== 0) {
+      writer.uint32(41).double(message.y);
+    }
+    writer.uint32(50).fork();
+    for (const v of message.hyperlanes) {
+      writer.uint64(v);
+    }
+    writer.join();
+    for (const v of message.planets) {
+      PlanetSnapshot.encode(v!, writer.uint32(58).fork()).join();
+    }
+    if (message.ownerEmpireId !== "") {
+      writer.uint32(66).string(message.ownerEmpireId);
+    }
+    if (message.visible !== false) {
+      writer.uint32(72).bool(message.visible);
+    }
+    if (message.lastSeen !== 0) {
+      writer.uint32(80).int64(message.lastSeen);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SystemSnapshot {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSystemSnapshot();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.uint64());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.starType = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 33) {
+            break;
+          }
+
+          message.x = reader.double();
+          continue;
+        }
+        case 5: {
+          if (tag !== 41) {
+            break;
+          }
+
+          message.y = reader.double();
+          continue;
+        }
+        case 6: {
+          if (tag === 48) {
+            message.hyperlanes.push(longToNumber(reader.uint64()));
+
+            continue;
+          }
+
+          if (tag === 50) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.hyperlanes.push(longToNumber(reader.uint64()));
+            }
+
+            continue;
+          }
+
+          break;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.planets.push(PlanetSnapshot.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.ownerEmpireId = reader.string();
+          continue;
+        }
+        case 9: {
+          if (tag !== 72) {
+            break;
+          }
+
+          message.visible = reader.bool();
+          continue;
+        }
+        case 10: {
+          if (tag !== 80) {
+            break;
+          }
+
+          message.lastSeen = longToNumber(reader.int64());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SystemSnapshot {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      starType: isSet(object.starType) ? globalThis.String(object.starType) : "",
+      x: isSet(object.x) ? globalThis.Number(object.x) : 0,
+      y: isSet(object.y) ? globalThis.Number(object.y) : 0,
+      hyperlanes: globalThis.Array.isArray(object?.hyperlanes)
+        ? object.hyperlanes.map((e: any) => globalThis.Number(e))
+        : [],
+      planets: globalThis.Array.isArray(object?.planets)
+        ? object.planets.map((e: any) => PlanetSnapshot.fromJSON(e))
+        : [],
+      ownerEmpireId: isSet(object.ownerEmpireId) ? globalThis.String(object.ownerEmpireId) : "",
+      visible: isSet(object.visible) ? globalThis.Boolean(object.visible) : false,
+      lastSeen: isSet(object.lastSeen) ? globalThis.Number(object.lastSeen) : 0,
+    };
+  },
+
+  toJSON(message: SystemSnapshot): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.starType !== "") {
+      obj.starType = message.starType;
+    }
+    if (message.x !== 0) {
+      obj.x = message.x;
+    }
+    if (message.y !== 0) {
+      obj.y = message.y;
+    }
+    if (message.hyperlanes?.length) {
+      obj.hyperlanes = message.hyperlanes.map((e) => Math.round(e));
+    }
+    if (message.planets?.length) {
+      obj.planets = message.planets.map((e) => PlanetSnapshot.toJSON(e));
+    }
+    if (message.ownerEmpireId !== "") {
+      obj.ownerEmpireId = message.ownerEmpireId;
+    }
+    if (message.visible !== false) {
+      obj.visible = message.visible;
+    }
+    if (message.lastSeen !== 0) {
+      obj.lastSeen = Math.round(message.lastSeen);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<SystemSnapshot>, I>>(base?: I): SystemSnapshot {
+    return SystemSnapshot.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<SystemSnapshot>, I>>(object: I): SystemSnapshot {
+    const message = createBaseSystemSnapshot();
+    message.id = object.id ?? 0;
+    message.name = object.name ?? "";
+    message.starType = object.starType ?? "";
+    message.x = object.x ?? 0;
+    message.y = object.y ?? 0;
+    message.hyperlanes = object.hyperlanes?.map((e) => e) || [];
+    message.planets = object.planets?.map((e) => PlanetSnapshot.fromPartial(e)) || [];
+    message.ownerEmpireId = object.ownerEmpireId ?? "";
+    message.visible = object.visible ?? false;
+    message.lastSeen = object.lastSeen ?? 0;
+    return message;
+  },
+};
+
+function createBasePlanetSnapshot(): PlanetSnapshot {
+  return { id: 0, name: "", type: "", size: 0, population: 0, colonyId: 0 };
+}
+
+export const PlanetSnapshot: MessageFns<PlanetSnapshot> = {
+  encode(message: PlanetSnapshot, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint64(message.id);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.type !== "") {
+      writer.uint32(26).string(message.type);
+    }
+    if (message.size !== 0) {
+      writer.uint32(32).int32(message.size);
+    }
+    if (message.population !== 0) {
+      writer.uint32(40).int64(message.population);
+    }
+    if (message.colonyId !== 0) {
+      writer.uint32(48).uint64(message.colonyId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): PlanetSnapshot {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlanetSnapshot();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.uint64());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.type = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.size = reader.int32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.population = longToNumber(reader.int64());
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.colonyId = longToNumber(reader.uint64());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlanetSnapshot {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      type: isSet(object.type) ? globalThis.String(object.type) : "",
+      size: isSet(object.size) ? globalThis.Number(object.size) : 0,
+      population: isSet(object.population) ? globalThis.Number(object.population) : 0,
+      colonyId: isSet(object.colonyId) ? globalThis.Number(object.colonyId) : 0,
+    };
+  },
+
+  toJSON(message: PlanetSnapshot): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.type !== "") {
+      obj.type = message.type;
+    }
+    if (message.size !== 0) {
+      obj.size = Math.round(message.size);
+    }
+    if (message.population !== 0) {
+      obj.population = Math.round(message.population);
+    }
+    if (message.colonyId !== 0) {
+      obj.colonyId = Math.round(message.colonyId);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PlanetSnapshot>, I>>(base?: I): PlanetSnapshot {
+    return PlanetSnapshot.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<PlanetSnapshot>, I>>(object: I): PlanetSnapshot {
+    const message = createBasePlanetSnapshot();
+    message.id = object.id ?? 0;
+    message.name = object.name ?? "";
+    message.type = object.type ?? "";
+    message.size = object.size ?? 0;
+    message.population = object.population ?? 0;
+    message.colonyId = object.colonyId ?? 0;
+    return message;
+  },
+};
+
+function createBaseFleetSnapshot(): FleetSnapshot {
+  return {
+    id: 0,
+    ownerEmpireId: "",
+    name: "",
+    ships: {},
+    locationId: 0,
+    destinationId: 0,
+    arrivalTime: 0,
+    lastKnown: false,
+  };
+}
+
+export const FleetSnapshot: MessageFns<FleetSnapshot> = {
+  encode(message: FleetSnapshot, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== 0) {
+      writer.uint32(8).uint64(message.id);
+    }
+    if (message.ownerEmpireId !== "") {
+      writer.uint32(18).string(message.ownerEmpireId);
+    }
+    if (message.name !== "") {
+      writer.uint32(26).string(message.name);
+    }
+    Object.entries(message.ships).forEach(([key, value]) => {
+      FleetSnapshot_ShipsEntry.encode({ key: key as any, value }, writer.uint32(34).fork()).join();
+    });
+    if (message.locationId !== 0) {
+      writer.uint32(40).uint64(message.locationId);
+    }
+    if (message.destinationId !== 0) {
+      writer.uint32(48).uint64(message.destinationId);
+    }
+    if (message.arrivalTime !== 0) {
+      writer.uint32(57).double(message.arrivalTime);
+    }
+    if (message.lastKnown !== false) {
+      writer.uint32(64).bool(message.lastKnown);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): FleetSnapshot {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFleetSnapshot();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.uint64());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.ownerEmpireId = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          const entry4 = FleetSnapshot_ShipsEntry.decode(reader, reader.uint32());
+          if (entry4.value !== undefined) {
+            message.ships[entry4.key] = entry4.value;
+          }
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.locationId = longToNumber(reader.uint64());
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.destinationId = longToNumber(reader.uint64());
+          continue;
+        }
+        case 7: {
+          if (tag !== 57) {
+            break;
+          }
+
+          message.arrivalTime = reader.double();
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.lastKnown = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): FleetSnapshot {
+    return {
+      id: isSet(object.id) ? globalThis.Number(object.id) : 0,
+      ownerEmpireId: isSet(object.ownerEmpireId) ? globalThis.String(object.ownerEmpireId) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      ships: isObject(object.ships)
+        ? Object.entries(object.ships).reduce<{ [key: string]: number }>((acc, [key, value]) => {
+          acc[key] = globalThis.Number(value);
+          return acc;
+        }, {})
+        : {},
+      locationId: isSet(object.locationId) ? globalThis.Number(object.locationId) : 0,
+      destinationId: isSet(object.destinationId) ? globalThis.Number(object.destinationId) : 0,
+      arrivalTime: isSet(object.arrivalTime) ? globalThis.Number(object.arrivalTime) : 0,
+      lastKnown: isSet(object.lastKnown) ? globalThis.Boolean(object.lastKnown) : false,
+    };
+  },
+
+  toJSON(message: FleetSnapshot): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.ownerEmpireId !== "") {
+      obj.ownerEmpireId = message.ownerEmpireId;
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.ships) {
+      const entries = Object.entries(message.ships);
+      if (entries.length > 0) {
+        obj.ships = {};
+        entries.forEach(([k, v]) => {
+          obj.ships[k] = Math.round(v);
+        });
+      }
+    }
+    if (message.locationId !== 0) {
+      obj.locationId = Math.round(message.locationId);
+    }
+    if (message.destinationId !== 0) {
+      obj.destinationId = Math.round(message.destinationId);
+    }
+    if (message.arrivalTime !== 0) {
+      obj.arrivalTime = message.arrivalTime;
+    }
+    if (message.lastKnown !== false) {
+      obj.lastKnown = message.lastKnown;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<FleetSnapshot>, I>>(base?: I): FleetSnapshot {
+    return FleetSnapshot.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<FleetSnapshot>, I>>(object: I): FleetSnapshot {
+    const message = createBaseFleetSnapshot();
+    message.id = object.id ?? 0;
+    message.ownerEmpireId = object.ownerEmpireId ?? "";
+    message.name = object.name ?? "";
+    message.ships = Object.entries(object.ships ?? {}).reduce<{ [key: string]: number }>((acc, [key, value]) => {
+      if (value !== undefined) {
+        acc[key] = globalThis.Number(value);
+      }
+      return acc;
+    }, {});
+    message.locationId = object.locationId ?? 0;
+    message.destinationId = object.destinationId ?? 0;
+    message.arrivalTime = object.arrivalTime ?? 0;
+    message.lastKnown = object.lastKnown ?? false;
+    return message;
+  },
+};
+
+function createBaseFleetSnapshot_ShipsEntry(): FleetSnapshot_ShipsEntry {
+  return { key: "", value: 0 };
+}
+
+export const FleetSnapshot_ShipsEntry: MessageFns<FleetSnapshot_ShipsEntry> = {
+  encode(message: FleetSnapshot_ShipsEntry, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== 0) {
+      writer.uint32(16).int32(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): FleetSnapshot_ShipsEntry {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFleetSnapshot_ShipsEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.value = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): FleetSnapshot_ShipsEntry {
+    return {
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      value: isSet(object.value) ? globalThis.Number(object.value) : 0,
+    };
+  },
+
+  toJSON(message: FleetSnapshot_ShipsEntry): unknown {
+    const obj: any = {};
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.value !== 0) {
+      obj.value = Math.round(message.value);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<FleetSnapshot_ShipsEntry>, I>>(base?: I): FleetSnapshot_ShipsEntry {
+    return FleetSnapshot_ShipsEntry.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<FleetSnapshot_ShipsEntry>, I>>(object: I): FleetSnapshot_ShipsEntry {
+    const message = createBaseFleetSnapshot_ShipsEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? 0;
+    return message;
+  },
+};
+
+function createBaseGameDeltaMessage(): GameDeltaMessage {
+  return {
+    sequence: 0,
+    tick: 0,
+    fleetMoved: undefined,
+    fleetArrived: undefined,
+    systemOwnerChanged: undefined,
+    resourcesChanged: undefined,
+    visionChanged: undefined,
+  };
+}
+
+export const GameDeltaMessage: MessageFns<GameDeltaMessage> = {
+  encode(message: GameDeltaMessage, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.sequence !== 0) {
+      writer.uint32(8).uint64(message.sequence);
+    }
+    if (message.tick !== 0) {
+      writer.uint32(16).int64(message.tick);
+    }
+    if (message.fleetMoved !== undefined) {
+      FleetMovedDelta.encode(message.fleetMoved, writer.uint32(82).fork()).join();
+    }
+    if (message.fleetArrived !== undefined) {
+      FleetArrivedDelta.encode(message.fleetArrived, writer.uint32(90).fork()).join();
+    }
+    if (message.systemOwnerChanged !== undefined) {
+      SystemOwnerChangedDelta.encode(message.systemOwnerChanged, writer.uint32(98).fork()).join();
+    }
+    if (message.resourcesChanged !== undefined) {
+      ResourcesChangedDelta.encode(message.resourcesChanged, writer.uint32(106).fork()).join();
+    }
+    if (message.visionChanged !== undefined) {
+      VisionChangedDelta.encode(message.visionChanged, writer.uint32(114).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GameDeltaMessage {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGameDeltaMessage();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.sequence = longToNumber(reader.uint64());
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.tick = longToNumber(reader.int64());
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.fleetMoved = FleetMovedDelta.decode(reader, reader.uint32());
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.fleetArrived = FleetArrivedDelta.decode(reader, reader.uint32());
+          continue;
+        }
+        case 12: {
+          if (tag !== 98) {
+            break;
+          }
+
+          message.systemOwnerChanged = SystemOwnerChangedDelta.decode(reader, reader.uint32());
+          continue;
+        }
+        case 13: {
+          if (tag !== 106) {
+            break;
+          }
+
+          message.resourcesChanged = ResourcesChangedDelta.decode(reader, reader.uint32());
+          continue;
+        }
+        case 14: {
+          if (tag !== 114) {
+            break;
+          }
+
+          message.visionChanged = VisionChangedDelta.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GameDeltaMessage {
+    return {
+      sequence: isSet(object.sequence) ? globalThis.Number(object.sequence) : 0,
+      tick: isSet(object.tick) ? globalThis.Number(object.tick) : 0,
+      fleetMoved: isSet(object.fleetMoved) ? FleetMovedDelta.fromJSON(object.fleetMoved) : undefined,
+      fleetArrived: isSet(object.fleetArrived) ? FleetArrivedDelta.fromJSON(object.fleetArrived) : undefined,
+      systemOwnerChanged: isSet(object.systemOwnerChanged)
+        ? SystemOwnerChangedDelta.fromJSON(object.systemOwnerChanged)
+        : undefined,
+      resourcesChanged: isSet(object.resourcesChanged)
+        ? ResourcesChangedDelta.fromJSON(object.resourcesChanged)
+        : undefined,
+      visionChanged: isSet(object.visionChanged) ? VisionChangedDelta.fromJSON(object.visionChanged) : undefined,
+    };
+  },
+
+  toJSON(message: GameDeltaMessage): unknown {
+    const obj: any = {};
+    if (message.sequence !== 0) {
+      obj.sequence = Math.round(message.sequence);
+    }
+    if (message.tick !== 0) {
+      obj.tick = Math.round(message.tick);
+    }
+    if (message.fleetMoved !== undefined) {
+      obj.fleetMoved = FleetMovedDelta.toJSON(message.fleetMoved);
+    }
+    if (message.fleetArrived !== undefined) {
+      obj.fleetArrived = FleetArrivedDelta.toJSON(message.fleetArrived);
+    }
+    if (message.systemOwnerChanged !== undefined) {
+      obj.systemOwnerChanged = SystemOwnerChangedDelta.toJSON(message.systemOwnerChanged);
+    }
+    if (message.resourcesChanged !== undefined) {
+      obj.resourcesChanged = ResourcesChangedDelta.toJSON(message.resourcesChanged);
+    }
+    if (message.visionChanged !== undefined) {
+      obj.visionChanged = VisionChangedDelta.toJSON(message.visionChanged);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<GameDeltaMessage>, I>>(base?: I): GameDeltaMessage {
+    return GameDeltaMessage.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<GameDeltaMessage>, I>>(object: I): GameDeltaMessage {
+    const message = createBaseGameDeltaMessage();
+    message.sequence = object.sequence ?? 0;
+    message.tick = object.tick ?? 0;
+    message.fleetMoved = (object.fleetMoved !== undefined && object.fleetMoved !== null)
+      ? FleetMovedDelta.fromPartial(object.fleetMoved)
+      : undefined;
+    message.fleetArrived = (object.fleetArrived !== undefined && object.fleetArrived !== null)
+      ? FleetArrivedDelta.fromPartial(object.fleetArrived)
+      : undefined;
+    message.systemOwnerChanged = (object.systemOwnerChanged !== undefined && object.systemOwnerChanged !== null)
+      ? SystemOwnerChangedDelta.fromPartial(object.systemOwnerChanged)
+      : undefined;
+    message.resourcesChanged = (object.resourcesChanged !== undefined && object.resourcesChanged !== null)
+      ? ResourcesChangedDelta.fromPartial(object.resourcesChanged)
+      : undefined;
+    message.visionChanged = (object.visionChanged !== undefined && object.visionChanged !== null)
+      ? VisionChangedDelta.fromPartial(object.visionChanged)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseFleetMovedDelta(): FleetMovedDelta {
+  return { fleet: undefined, fromSystemId: 0, toSystemId: 0, arrivalTime: 0 };
+}
+
+export const FleetMovedDelta: MessageFns<FleetMovedDelta> = {
+  encode(message: FleetMovedDelta, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.fleet !== undefined) {
+      FleetSnapshot.encode(message.fleet, writer.uint32(10).fork()).join();
+    }
+    if (message.fromSystemId !== 0) {
+      writer.uint32(16).uint64(message.fromSystemId);
+    }
+    if (message.toSystemId !== 0) {
+      writer.uint32(24).uint64(message.toSystemId);
+    }
+    if (message.arrivalTime !== 0) {
+      writer.uint32(33).double(message.arrivalTime);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): FleetMovedDelta {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFleetMovedDelta();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.fleet = FleetSnapshot.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.fromSystemId = longToNumber(reader.uint64());
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.toSystemId = longToNumber(reader.uint64());
+          continue;
+        }
+        case 4: {
+          if (tag !== 33) {
+            break;
+          }
+
+          message.arrivalTime = reader.double();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): FleetMovedDelta {
+    return {
+      fleet: isSet(object.fleet) ? FleetSnapshot.fromJSON(object.fleet) : undefined,
+      fromSystemId: isSet(object.fromSystemId) ? globalThis.Number(object.fromSystemId) : 0,
+      toSystemId: isSet(object.toSystemId) ? globalThis.Number(object.toSystemId) : 0,
+      arrivalTime: isSet(object.arrivalTime) ? globalThis.Number(object.arrivalTime) : 0,
+    };
+  },
+
+  toJSON(message: FleetMovedDelta): unknown {
+    const obj: any = {};
+    if (message.fleet !== undefined) {
+      obj.fleet = FleetSnapshot.toJSON(message.fleet);
+    }
+    if (message.fromSystemId !== 0) {
+      obj.fromSystemId = Math.round(message.fromSystemId);
+    }
+    if (message.toSystemId !== 0) {
+      obj.toSystemId = Math.round(message.toSystemId);
+    }
+    if (message.arrivalTime !== 0) {
+      obj.arrivalTime = message.arrivalTime;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<FleetMovedDelta>, I>>(base?: I): FleetMovedDelta {
+    return FleetMovedDelta.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<FleetMovedDelta>, I>>(object: I): FleetMovedDelta {
+    const message = createBaseFleetMovedDelta();
+    message.fleet = (object.fleet !== undefined && object.fleet !== null)
+      ? FleetSnapshot.fromPartial(object.fleet)
+      : undefined;
+    message.fromSystemId = object.fromSystemId ?? 0;
+    message.toSystemId = object.toSystemId ?? 0;
+    message.arrivalTime = object.arrivalTime ?? 0;
+    return message;
+  },
+};
+
+function createBaseFleetArrivedDelta(): FleetArrivedDelta {
+  return { fleet: undefined, systemId: 0 };
+}
+
+export const FleetArrivedDelta: MessageFns<FleetArrivedDelta> = {
+  encode(message: FleetArrivedDelta, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.fleet !== undefined) {
+      FleetSnapshot.encode(message.fleet, writer.uint32(10).fork()).join();
+    }
+    if (message.systemId !== 0) {
+      writer.uint32(16).uint64(message.systemId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): FleetArrivedDelta {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFleetArrivedDelta();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.fleet = FleetSnapshot.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.systemId = longToNumber(reader.uint64());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): FleetArrivedDelta {
+    return {
+      fleet: isSet(object.fleet) ? FleetSnapshot.fromJSON(object.fleet) : undefined,
+      systemId: isSet(object.systemId) ? globalThis.Number(object.systemId) : 0,
+    };
+  },
+
+  toJSON(message: FleetArrivedDelta): unknown {
+    const obj: any = {};
+    if (message.fleet !== undefined) {
+      obj.fleet = FleetSnapshot.toJSON(message.fleet);
+    }
+    if (message.systemId !== 0) {
+      obj.systemId = Math.round(message.systemId);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<FleetArrivedDelta>, I>>(base?: I): FleetArrivedDelta {
+    return FleetArrivedDelta.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<FleetArrivedDelta>, I>>(object: I): FleetArrivedDelta {
+    const message = createBaseFleetArrivedDelta();
+    message.fleet = (object.fleet !== undefined && object.fleet !== null)
+      ? FleetSnapshot.fromPartial(object.fleet)
+      : undefined;
+    message.systemId = object.systemId ?? 0;
+    return message;
+  },
+};
+
+function createBaseSystemOwnerChangedDelta(): SystemOwnerChangedDelta {
+  return { systemId: 0, previousOwnerEmpireId: "", ownerEmpireId: "" };
+}
+
+export const SystemOwnerChangedDelta: MessageFns<SystemOwnerChangedDelta> = {
+  encode(message: SystemOwnerChangedDelta, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.systemId !== 0) {
+      writer.uint32(8).uint64(message.systemId);
+    }
+    if (message.previousOwnerEmpireId !== "") {
+      writer.uint32(18).string(message.previousOwnerEmpireId);
+    }
+    if (message.ownerEmpireId !== "") {
+      writer.uint32(26).string(message.ownerEmpireId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SystemOwnerChangedDelta {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSystemOwnerChangedDelta();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.systemId = longToNumber(reader.uint64());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.previousOwnerEmpireId = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.ownerEmpireId = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SystemOwnerChangedDelta {
+    return {
+      systemId: isSet(object.systemId) ? globalThis.Number(object.systemId) : 0,
+      previousOwnerEmpireId: isSet(object.previousOwnerEmpireId) ? globalThis.String(object.previousOwnerEmpireId) : "",
+      ownerEmpireId: isSet(object.ownerEmpireId) ? globalThis.String(object.ownerEmpireId) : "",
+    };
+  },
+
+  toJSON(message: SystemOwnerChangedDelta): unknown {
+    const obj: any = {};
+    if (message.systemId !== 0) {
+      obj.systemId = Math.round(message.systemId);
+    }
+    if (message.previousOwnerEmpireId !== "") {
+      obj.previousOwnerEmpireId = message.previousOwnerEmpireId;
+    }
+    if (message.ownerEmpireId !== "") {
+      obj.ownerEmpireId = message.ownerEmpireId;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<SystemOwnerChangedDelta>, I>>(base?: I): SystemOwnerChangedDelta {
+    return SystemOwnerChangedDelta.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<SystemOwnerChangedDelta>, I>>(object: I): SystemOwnerChangedDelta {
+    const message = createBaseSystemOwnerChangedDelta();
+    message.systemId = object.systemId ?? 0;
+    message.previousOwnerEmpireId = object.previousOwnerEmpireId ?? "";
+    message.ownerEmpireId = object.ownerEmpireId ?? "";
+    return message;
+  },
+};
+
+function createBaseResourcesChangedDelta(): ResourcesChangedDelta {
+  return { resources: undefined, income: undefined };
+}
+
+export const ResourcesChangedDelta: MessageFns<ResourcesChangedDelta> = {
+  encode(message: ResourcesChangedDelta, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.resources !== undefined) {
+      ResourceAmounts.encode(message.resources, writer.uint32(10).fork()).join();
+    }
+    if (message.income !== undefined) {
+      ResourceAmounts.encode(message.income, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ResourcesChangedDelta {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResourcesChangedDelta();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.resources = ResourceAmounts.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.income = ResourceAmounts.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResourcesChangedDelta {
+    return {
+      resources: isSet(object.resources) ? ResourceAmounts.fromJSON(object.resources) : undefined,
+      income: isSet(object.income) ? ResourceAmounts.fromJSON(object.income) : undefined,
+    };
+  },
+
+  toJSON(message: ResourcesChangedDelta): unknown {
+    const obj: any = {};
+    if (message.resources !== undefined) {
+      obj.resources = ResourceAmounts.toJSON(message.resources);
+    }
+    if (message.income !== undefined) {
+      obj.income = ResourceAmounts.toJSON(message.income);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ResourcesChangedDelta>, I>>(base?: I): ResourcesChangedDelta {
+    return ResourcesChangedDelta.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ResourcesChangedDelta>, I>>(object: I): ResourcesChangedDelta {
+    const message = createBaseResourcesChangedDelta();
+    message.resources = (object.resources !== undefined && object.resources !== null)
+      ? ResourceAmounts.fromPartial(object.resources)
+      : undefined;
+    message.income = (object.income !== undefined && object.income !== null)
+      ? ResourceAmounts.fromPartial(object.income)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseVisionChangedDelta(): VisionChangedDelta {
+  return { revealed: [], fleets: [], hidden: [] };
+}
+
+export const VisionChangedDelta: MessageFns<VisionChangedDelta> = {
+  encode(message: VisionChangedDelta, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.revealed) {
+      SystemSnapshot.encode(v!, writer.uint32(10).fork()).join();
+    }
+    for (const v of message.fleets) {
+      FleetSnapshot.encode(v!, writer.uint32(18).fork()).join();
+    }
+    writer.uint32(26).fork();
+    for (const v of message.hidden) {
+      writer.uint64(v);
+    }
+    writer.join();
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): VisionChangedDelta {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseVisionChangedDelta();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.revealed.push(SystemSnapshot.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.fleets.push(FleetSnapshot.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 3: {
+          if (tag === 24) {
+            message.hidden.push(longToNumber(reader.uint64()));
+
+            continue;
+          }
+
+          if (tag === 26) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.hidden.push(longToNumber(reader.uint64()));
+            }
+
+            continue;
+          }
+
+          break;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): VisionChangedDelta {
+    return {
+      revealed: globalThis.Array.isArray(object?.revealed)
+        ? object.revealed.map((e: any) => SystemSnapshot.fromJSON(e))
+        : [],
+      fleets: globalThis.Array.isArray(object?.fleets) ? object.fleets.map((e: any) => FleetSnapshot.fromJSON(e)) : [],
+      hidden: globalThis.Array.isArray(object?.hidden) ? object.hidden.map((e: any) => globalThis.Number(e)) : [],
+    };
+  },
+
+  toJSON(message: VisionChangedDelta): unknown {
+    const obj: any = {};
+    if (message.revealed?.length) {
+      obj.revealed = message.revealed.map((e) => SystemSnapshot.toJSON(e));
+    }
+    if (message.fleets?.length) {
+      obj.fleets = message.fleets.map((e) => FleetSnapshot.toJSON(e));
+    }
+    if (message.hidden?.length) {
+      obj.hidden = message.hidden.map((e) => Math.round(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<VisionChangedDelta>, I>>(base?: I): VisionChangedDelta {
+    return VisionChangedDelta.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<VisionChangedDelta>, I>>(object: I): VisionChangedDelta {
+    const message = createBaseVisionChangedDelta();
+    message.revealed = object.revealed?.map((e) => SystemSnapshot.fromPartial(e)) || [];
+    message.fleets = object.fleets?.map((e) => FleetSnapshot.fromPartial(e)) || [];
+    message.hidden = object.hidden?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseTurnUpdateMessage(): TurnUpdateMessage {
+  return { turnNumber: 0, turnDeadline: 0, isPaused: false, date: undefined, yearsPerHour: 0, pauseReason: "" };
+}
+
+export const TurnUpdateMessage: MessageFns<TurnUpdateMessage> = {
+  encode(message: TurnUpdateMessage, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.turnNumber !== 0) {
+      writer.uint32(8).int64(message.turnNumber);
+    }
+    if (message.turnDeadline !== 0) {
+      writer.uint32(16).int64(message.turnDeadline);
+    }
+    if (message.isPaused !== false) {
+      writer.uint32(24).bool(message.isPaused);
+    }
+    if (message.date !== undefined) {
+      GameDate.encode(message.date, writer.uint32(34).fork()).join();
+    }
+    if (message.yearsPerHour !== 0) {
+      writer.uint32(41).double(message.yearsPerHour);
+    }
+    if (message.pauseReason !== "") {
+      writer.uint32(50).string(message.pauseReason);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TurnUpdateMessage {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTurnUpdateMessage();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.turnNumber = longToNumber(reader.int64());
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.turnDeadline = longToNumber(reader.int64());
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.isPaused = reader.bool();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.date = GameDate.decode(reader, reader.uint32());
+          continue;
+        }
+        case 5: {
+          if (tag !== 41) {
+            break;
+          }
+
+          message.yearsPerHour = reader.double();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.pauseReason = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TurnUpdateMessage {
+    return {
+      turnNumber: isSet(object.turnNumber) ? globalThis.Number(object.turnNumber) : 0,
+      turnDeadline: isSet(object.turnDeadline) ? globalThis.Number(object.turnDeadline) : 0,
+      isPaused: isSet(object.isPaused) ? globalThis.Boolean(object.isPaused) : false,
+      date: isSet(object.date) ? GameDate.fromJSON(object.date) : undefined,
+      yearsPerHour: isSet(object.yearsPerHour) ? globalThis.Number(object.yearsPerHour) : 0,
+      pauseReason: isSet(object.pauseReason) ? globalThis.String(object.pauseReason) : "",
+    };
+  },
+
+  toJSON(message: TurnUpdateMessage): unknown {
+    const obj: any = {};
+    if (message.turnNumber !== 0) {
+      obj.turnNumber = Math.round(message.turnNumber);
+    }
+    if (message.turnDeadline !== 0) {
+      obj.turnDeadline = Math.round(message.turnDeadline);
+    }
+    if (message.isPaused !== false) {
+      obj.isPaused = message.isPaused;
+    }
+    if (message.date !== undefined) {
+      obj.date = GameDate.toJSON(message.date);
+    }
+    if (message.yearsPerHour !== 0) {
+      obj.yearsPerHour = message.yearsPerHour;
+    }
+    if (message.pauseReason !== "") {
+      obj.pauseReason = message.pauseReason;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TurnUpdateMessage>, I>>(base?: I): TurnUpdateMessage {
+    return TurnUpdateMessage.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TurnUpdateMessage>, I>>(object: I): TurnUpdateMessage {
+    const message = createBaseTurnUpdateMessage();
+    message.turnNumber = object.turnNumber ?? 0;
+    message.turnDeadline = object.turnDeadline ?? 0;
+    message.isPaused = object.isPaused ?? false;
+    message.date = (object.date !== undefined && object.date !== null)
+      ? GameDate.fromPartial(object.date)
+      : undefined;
+    message.yearsPerHour = object.yearsPerHour ?? 0;
+    message.pauseReason = object.pauseReason ?? "";
+    return message;
+  },
+};
+
+function createBaseControlVoteMessage(): ControlVoteMessage {
+  return { action: "", yearsPerHour: 0, votes: 0, required: 0, playerId: "" };
+}
+
+export const ControlVoteMessage: MessageFns<ControlVoteMessage> = {
+  encode(message: ControlVoteMessage, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.action !== "") {
+      writer.uint32(10).string(message.action);
+    }
+    if (message.yearsPerHour !== 0) {
+      writer.uint32(17).double(message.yearsPerHour);
+    }
+    if (message.votes !== 0) {
+      writer.uint32(24).int32(message.votes);
+    }
+    if (message.required !== 0) {
+      writer.uint32(32).int32(message.required);
+    }
+    if (message.playerId !== "") {
+      writer.uint32(42).string(message.playerId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ControlVoteMessage {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseControlVoteMessage();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.action = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 17) {
+            break;
+          }
+
+          message.yearsPerHour = reader.double();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.votes = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.required = reader.int32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.playerId = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ControlVoteMessage {
+    return {
+      action: isSet(object.action) ? globalThis.String(object.action) : "",
+      yearsPerHour: isSet(object.yearsPerHour) ? globalThis.Number(object.yearsPerHour) : 0,
+      votes: isSet(object.votes) ? globalThis.Number(object.votes) : 0,
+      required: isSet(object.required) ? globalThis.Number(object.required) : 0,
+      playerId: isSet(object.playerId) ? globalThis.String(object.playerId) : "",
+    };
+  },
+
+  toJSON(message: ControlVoteMessage): unknown {
+    const obj: any = {};
+    if (message.action !== "") {
+      obj.action = message.action;
+    }
+    if (message.yearsPerHour !== 0) {
+      obj.yearsPerHour = message.yearsPerHour;
+    }
+    if (message.votes !== 0) {
+      obj.votes = Math.round(message.votes);
+    }
+    if (message.required !== 0) {
+      obj.required = Math.round(message.required);
+    }
+    if (message.playerId !== "") {
+      obj.playerId = message.playerId;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ControlVoteMessage>, I>>(base?: I): ControlVoteMessage {
+    return ControlVoteMessage.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ControlVoteMessage>, I>>(object: I): ControlVoteMessage {
+    const message = createBaseControlVoteMessage();
+    message.action = object.action ?? "";
+    message.yearsPerHour = object.yearsPerHour ?? 0;
+    message.votes = object.votes ?? 0;
+    message.required = object.required ?? 0;
+    message.playerId = object.playerId ?? "";
+    return message;
+  },
+};
+
+function createBaseGameDate(): GameDate {
+  return { year: 0, month: 0, day: 0, elapsedDays: 0 };
+}
+
+export const GameDate: MessageFns<GameDate> = {
+  encode(message: GameDate, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.year !== 0) {
+      writer.uint32(8).int32(message.year);
+    }
+    if (message.month !== 0) {
+      writer.uint32(16).int32(message.month);
+    }
+    if (message.day !== 0) {
+      writer.uint32(24).int32(message.day);
+    }
+    if (message.elapsedDays !== 0) {
+      writer.uint32(33).double(message.elapsedDays);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GameDate {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGameDate();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.year = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.month = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.day = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 33) {
+            break;
+          }
+
+          message.elapsedDays = reader.double();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GameDate {
+    return {
+      year: isSet(object.year) ? globalThis.Number(object.year) : 0,
+      month: isSet(object.month) ? globalThis.Number(object.month) : 0,
+      day: isSet(object.day) ? globalThis.Number(object.day) : 0,
+      elapsedDays: isSet(object.elapsedDays) ? globalThis.Number(object.elapsedDays) : 0,
+    };
+  },
+
+  toJSON(message: GameDate): unknown {
+    const obj: any = {};
+    if (message.year !== 0) {
+      obj.year = Math.round(message.year);
+    }
+    if (message.month !== 0) {
+      obj.month = Math.round(message.month);
+    }
+    if (message.day !== 0) {
+      obj.day = Math.round(message.day);
+    }
+    if (message.elapsedDays !== 0) {
+      obj.elapsedDays = message.elapsedDays;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<GameDate>, I>>(base?: I): GameDate {
+    return GameDate.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<GameDate>, I>>(object: I): GameDate {
+    const message = createBaseGameDate();
+    message.year = object.year ?? 0;
+    message.month = object.month ?? 0;
+    message.day = object.day ?? 0;
+    message.elapsedDays = object.elapsedDays ?? 0;
+    return message;
+  },
+};
+
+function createBaseChatMessage(): ChatMessage {
+  return {
+    senderId: "",
+    senderDisplayName: "",
+    timestamp: 0,
+    global: undefined,
+    private: undefined,
+    lobby: undefined,
+    system: undefined,
+  };
+}
+
+export const ChatMessage: MessageFns<ChatMessage> = {
+  encode(message: ChatMessage, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.senderId !== "") {
+      writer.uint32(10).string(message.senderId);
+    }
+    if (message.senderDisplayName !== "") {
+      writer.uint32(18).string(message.senderDisplayName);
+    }
+    if (message.timestamp !== 0) {
+      writer.uint32(24).int64(message.timestamp);
+    }
+    if (message.global !== undefined) {
+      GlobalChatMessage.encode(message.global, writer.uint32(82).fork()).join();
+    }
+    if (message.private !== undefined) {
+      PrivateChatMessage.encode(message.private, writer.uint32(90).fork()).join();
+    }
+    if (message.lobby !== undefined) {
+      LobbyChatMessage.encode(message.lobby, writer.uint32(98).fork()).join();
+    }
+    if (message.system !== undefined) {
+      SystemChatMessage.encode(message.system, writer.uint32(106).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ChatMessage {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseChatMessage();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.senderId = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.senderDisplayName = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.timestamp = longToNumber(reader.int64());
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.global = GlobalChatMessage.decode(reader, reader.uint32());
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.private = PrivateChatMessage.decode(reader, reader.uint32());
+          continue;
+        }
+        case 12: {
+          if (tag !== 98) {
+            break;
+          }
+
+          message.lobby = LobbyChatMessage.decode(reader, reader.uint32());
+          continue;
+        }
+        case 13: {
+          if (tag !== 106) {
+            break;
+          }
+
+          message.system = SystemChatMessage.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ChatMessage {
+    return {
+      senderId: isSet(object.senderId) ? globalThis.String(object.senderId) : "",
+      senderDisplayName: isSet(object.senderDisplayName) ? globalThis.String(object.senderDisplayName) : "",
+      timestamp: isSet(object.timestamp) ? globalThis.Number(object.timestamp) : 0,
+      global: isSet(object.global) ? GlobalChatMessage.fromJSON(object.global) : undefined,
+      private: isSet(object.private) ? PrivateChatMessage.fromJSON(object.private) : undefined,
+      lobby: isSet(object.lobby) ? LobbyChatMessage.fromJSON(object.lobby) : undefined,
+      system: isSet(object.system) ? SystemChatMessage.fromJSON(object.system) : undefined,
+    };
+  },
+
+  toJSON(message: ChatMessage): unknown {
+    const obj: any = {};
+    if (message.senderId !== "") {
+      obj.senderId = message.senderId;
+    }
+    if (message.senderDisplayName !== "") {
+      obj.senderDisplayName = message.senderDisplayName;
+    }
+    if (message.timestamp !== 0) {
+      obj.timestamp = Math.round(message.timestamp);
+    }
+    if (message.global !== undefined) {
+      obj.global = GlobalChatMessage.toJSON(message.global);
+    }
+    if (message.private !== undefined) {
+      obj.private = PrivateChatMessage.toJSON(message.private);
+    }
+    if (message.lobby !== undefined) {
+      obj.lobby = LobbyChatMessage.toJSON(message.lobby);
+    }
+    if (message.system !== undefined) {
+      obj.system = SystemChatMessage.toJSON(message.system);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ChatMessage>, I>>(base?: I): ChatMessage {
     return ChatMessage.fromPartial(base ?? ({} as any));
   },
   fromPartial<I extends Exact<DeepPartial<ChatMessage>, I>>(object: I): ChatMessage {
@@ -2140,7 +4489,7 @@ export const SystemChatMessage: MessageFns<SystemChatMessage> = {
 };
 
 function createBaseSystemMessage(): SystemMessage {
-  return { connection: undefined, auth: undefined, serverStatus: undefined };
+  return { connection: undefined, auth: undefined, serverStatus: undefined, notifications: undefined };
 }
 
 export const SystemMessage: MessageFns<SystemMessage> = {
@@ -2154,6 +4503,9 @@ export const SystemMessage: MessageFns<SystemMessage> = {
     if (message.serverStatus !== undefined) {
       ServerStatusMessage.encode(message.serverStatus, writer.uint32(26).fork()).join();
     }
+    if (message.notifications !== undefined) {
+      NotificationsMessage.encode(message.notifications, writer.uint32(34).fork()).join();
+    }
     return writer;
   },
 
@@ -2188,6 +4540,14 @@ export const SystemMessage: MessageFns<SystemMessage> = {
           message.serverStatus = ServerStatusMessage.decode(reader, reader.uint32());
           continue;
         }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.notifications = NotificationsMessage.decode(reader, reader.uint32());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2202,6 +4562,7 @@ export const SystemMessage: MessageFns<SystemMessage> = {
       connection: isSet(object.connection) ? ConnectionMessage.fromJSON(object.connection) : undefined,
       auth: isSet(object.auth) ? AuthMessage.fromJSON(object.auth) : undefined,
       serverStatus: isSet(object.serverStatus) ? ServerStatusMessage.fromJSON(object.serverStatus) : undefined,
+      notifications: isSet(object.notifications) ? NotificationsMessage.fromJSON(object.notifications) : undefined,
     };
   },
 
@@ -2216,6 +4577,9 @@ export const SystemMessage: MessageFns<SystemMessage> = {
     if (message.serverStatus !== undefined) {
       obj.serverStatus = ServerStatusMessage.toJSON(message.serverStatus);
     }
+    if (message.notifications !== undefined) {
+      obj.notifications = NotificationsMessage.toJSON(message.notifications);
+    }
     return obj;
   },
 
@@ -2233,6 +4597,9 @@ export const SystemMessage: MessageFns<SystemMessage> = {
     message.serverStatus = (object.serverStatus !== undefined && object.serverStatus !== null)
       ? ServerStatusMessage.fromPartial(object.serverStatus)
       : undefined;
+    message.notifications = (object.notifications !== undefined && object.notifications !== null)
+      ? NotificationsMessage.fromPartial(object.notifications)
+      : undefined;
     return message;
   },
 };
@@ -2481,6 +4848,240 @@ export const ServerStatusMessage: MessageFns<ServerStatusMessage> = {
   },
 };
 
+function createBaseNotificationsMessage(): NotificationsMessage {
+  return { notifications: [], unreadCount: 0 };
+}
+
+export const NotificationsMessage: MessageFns<NotificationsMessage> = {
+  encode(message: NotificationsMessage, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.notifications) {
+      Notification.encode(v!, writer.uint32(10).fork()).join();
+    }
+    if (message.unreadCount !== 0) {
+      writer.uint32(16).int32(message.unreadCount);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): NotificationsMessage {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseNotificationsMessage();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.notifications.push(Notification.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.unreadCount = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): NotificationsMessage {
+    return {
+      notifications: globalThis.Array.isArray(object?.notifications)
+        ? object.notifications.map((e: any) => Notification.fromJSON(e))
+        : [],
+      unreadCount: isSet(object.unreadCount) ? globalThis.Number(object.unreadCount) : 0,
+    };
+  },
+
+  toJSON(message: NotificationsMessage): unknown {
+    const obj: any = {};
+    if (message.notifications?.length) {
+      obj.notifications = message.notifications.map((e) => Notification.toJSON(e));
+    }
+    if (message.unreadCount !== 0) {
+      obj.unreadCount = Math.round(message.unreadCount);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<NotificationsMessage>, I>>(base?: I): NotificationsMessage {
+    return NotificationsMessage.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<NotificationsMessage>, I>>(object: I): NotificationsMessage {
+    const message = createBaseNotificationsMessage();
+    message.notifications = object.notifications?.map((e) => Notification.fromPartial(e)) || [];
+    message.unreadCount = object.unreadCount ?? 0;
+    return message;
+  },
+};
+
+function createBaseNotification(): Notification {
+  return { id: "", kind: "", title: "", body: "", sessionId: "", createdAt: 0, read: false };
+}
+
+export const Notification: MessageFns<Notification> = {
+  encode(message: Notification, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.kind !== "") {
+      writer.uint32(18).string(message.kind);
+    }
+    if (message.title !== "") {
+      writer.uint32(26).string(message.title);
+    }
+    if (message.body !== "") {
+      writer.uint32(34).string(message.body);
+    }
+    if (message.sessionId !== "") {
+      writer.uint32(42).string(message.sessionId);
+    }
+    if (message.createdAt !== 0) {
+      writer.uint32(48).int64(message.createdAt);
+    }
+    if (message.read !== false) {
+      writer.uint32(56).bool(message.read);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Notification {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseNotification();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.kind = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.body = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.sessionId = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.createdAt = longToNumber(reader.int64());
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.read = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Notification {
+    return {
+      id: isSet(object.id) ? globalThis.String(object.id) : "",
+      kind: isSet(object.kind) ? globalThis.String(object.kind) : "",
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+      body: isSet(object.body) ? globalThis.String(object.body) : "",
+      sessionId: isSet(object.sessionId) ? globalThis.String(object.sessionId) : "",
+      createdAt: isSet(object.createdAt) ? globalThis.Number(object.createdAt) : 0,
+      read: isSet(object.read) ? globalThis.Boolean(object.read) : false,
+    };
+  },
+
+  toJSON(message: Notification): unknown {
+    const obj: any = {};
+    if (message.id !== "") {
+      obj.id = message.id;
+    }
+    if (message.kind !== "") {
+      obj.kind = message.kind;
+    }
+    if (message.title !== "") {
+      obj.title = message.title;
+    }
+    if (message.body !== "") {
+      obj.body = message.body;
+    }
+    if (message.sessionId !== "") {
+      obj.sessionId = message.sessionId;
+    }
+    if (message.createdAt !== 0) {
+      obj.createdAt = Math.round(message.createdAt);
+    }
+    if (message.read !== false) {
+      obj.read = message.read;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Notification>, I>>(base?: I): Notification {
+    return Notification.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Notification>, I>>(object: I): Notification {
+    const message = createBaseNotification();
+    message.id = object.id ?? "";
+    message.kind = object.kind ?? "";
+    message.title = object.title ?? "";
+    message.body = object.body ?? "";
+    message.sessionId = object.sessionId ?? "";
+    message.createdAt = object.createdAt ?? 0;
+    message.read = object.read ?? false;
+    return message;
+  },
+};
+
 function createBaseErrorMessage(): ErrorMessage {
   return { errorCode: "", errorMessage: "", context: "", details: [] };
 }
@@ -2612,6 +5213,10 @@ function longToNumber(int64: { toString(): string }): number {
   return num;
 }
 
+function isObject(value: any): boolean {
+  return typeof value === "object" && value !== null;
+}
+
 function isSet(value: any): boolean {
   return value !== null && value !== undefined;
 }
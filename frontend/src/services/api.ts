@@ -14,7 +14,7 @@ export async function getHealth(): Promise<{ ok: boolean }> {
   return res.json();
 }
 
-export type GalaxyShape = "spiral" | "elliptical" | "ring" | "irregular";
+export type GalaxyShape = "spiral" | "barred_spiral" | "elliptical" | "ring" | "clustered" | "irregular";
 
 export type GalaxyGenerationConfig = {
   numStarSystems: number;